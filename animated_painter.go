@@ -0,0 +1,125 @@
+package charts
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"time"
+
+	"github.com/golang/freetype/truetype"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+const defaultAnimationFrameDelay = 500 * time.Millisecond
+
+// AnimatedPainterOptions contains parameters for creating a new AnimatedPainter.
+type AnimatedPainterOptions struct {
+	// Width is the width of each frame.
+	Width int
+	// Height is the height of each frame.
+	Height int
+	// FrameDelay is the time each frame is displayed. Default is 500ms. GIF delays have a 10ms resolution.
+	FrameDelay time.Duration
+	// LoopCount is the number of times the animation repeats after the first play. Zero loops forever, -1 plays the
+	// animation once.
+	LoopCount int
+	// Font is the default font for rendering text.
+	Font *truetype.Font
+	// Theme is the default theme used when frame charts don't specify one.
+	Theme ColorPalette
+}
+
+// AnimatedPainter renders a sequence of chart frames and encodes them as an animated GIF. Each frame is rendered
+// through the normal chart render path, allowing a series to be replayed as it evolves over time.
+type AnimatedPainter struct {
+	opts   AnimatedPainterOptions
+	frames []*image.Paletted
+	delays []int
+}
+
+// NewAnimatedPainter creates a painter for rendering animated charts.
+func NewAnimatedPainter(opts AnimatedPainterOptions) *AnimatedPainter {
+	if opts.Width <= 0 {
+		opts.Width = defaultChartWidth
+	}
+	if opts.Height <= 0 {
+		opts.Height = defaultChartHeight
+	}
+	if opts.FrameDelay <= 0 {
+		opts.FrameDelay = defaultAnimationFrameDelay
+	}
+	return &AnimatedPainter{opts: opts}
+}
+
+// AddFrame appends a frame drawn by the provided function. The function is given a new Painter sized to the
+// animation, for example calling Painter.CandlestickChart with the data available at that point in time.
+func (a *AnimatedPainter) AddFrame(drawFrame func(p *Painter) error) error {
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputPNG,
+		Width:        a.opts.Width,
+		Height:       a.opts.Height,
+		Font:         a.opts.Font,
+		Theme:        a.opts.Theme,
+	})
+	if err := drawFrame(p); err != nil {
+		return err
+	}
+	return a.addPainterFrame(p)
+}
+
+// AddChartFrame appends a frame rendered from the provided chart option snapshot. The output format and
+// dimensions of the option are replaced with those of the animation. The animation Theme is used when the option
+// does not specify one.
+func (a *AnimatedPainter) AddChartFrame(opt ChartOption) error {
+	if opt.Theme == nil && a.opts.Theme != nil {
+		opt.Theme = a.opts.Theme
+	}
+	p, err := Render(opt, PNGOutputOptionFunc(), DimensionsOptionFunc(a.opts.Width, a.opts.Height))
+	if err != nil {
+		return err
+	}
+	return a.addPainterFrame(p)
+}
+
+// addPainterFrame rasterizes the painter and appends it as a paletted frame.
+func (a *AnimatedPainter) addPainterFrame(p *Painter) error {
+	writer := &chartdraw.ImageWriter{}
+	if err := p.save(writer); err != nil {
+		return err
+	}
+	img, err := writer.Image()
+	if err != nil {
+		return err
+	}
+	bounds := img.Bounds()
+	frame := image.NewPaletted(bounds, palette.Plan9)
+	draw.Draw(frame, bounds, img, bounds.Min, draw.Src)
+	a.frames = append(a.frames, frame)
+	a.delays = append(a.delays, max(int(a.opts.FrameDelay/(10*time.Millisecond)), 1))
+	return nil
+}
+
+// FrameCount returns the number of frames added to the animation.
+func (a *AnimatedPainter) FrameCount() int {
+	return len(a.frames)
+}
+
+// Bytes returns the animation encoded as a GIF.
+func (a *AnimatedPainter) Bytes() ([]byte, error) {
+	if len(a.frames) == 0 {
+		return nil, errors.New("no frames to encode")
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, &gif.GIF{
+		Image:     a.frames,
+		Delay:     a.delays,
+		LoopCount: a.opts.LoopCount,
+	}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
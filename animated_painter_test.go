@@ -0,0 +1,58 @@
+package charts
+
+import (
+	"bytes"
+	"errors"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnimatedPainter(t *testing.T) {
+	t.Parallel()
+
+	data := makeBasicCandlestickData()
+	ap := NewAnimatedPainter(AnimatedPainterOptions{
+		Width:      400,
+		Height:     300,
+		FrameDelay: 250 * time.Millisecond,
+	})
+	require.NoError(t, ap.AddFrame(func(p *Painter) error {
+		return p.CandlestickChart(NewCandlestickOptionWithData(data[:len(data)/2]))
+	}))
+	require.NoError(t, ap.AddChartFrame(ChartOption{
+		SeriesList: NewSeriesListCandlestick([][]OHLCData{data}).ToGenericSeriesList(),
+	}))
+	assert.Equal(t, 2, ap.FrameCount())
+
+	encoded, err := ap.Bytes()
+	require.NoError(t, err)
+	decoded, err := gif.DecodeAll(bytes.NewReader(encoded))
+	require.NoError(t, err)
+	require.Len(t, decoded.Image, 2)
+	assert.Equal(t, []int{25, 25}, decoded.Delay)
+	for _, frame := range decoded.Image {
+		assert.Equal(t, 400, frame.Bounds().Dx())
+		assert.Equal(t, 300, frame.Bounds().Dy())
+	}
+}
+
+func TestAnimatedPainterErrors(t *testing.T) {
+	t.Parallel()
+
+	ap := NewAnimatedPainter(AnimatedPainterOptions{})
+	_, err := ap.Bytes()
+	require.Error(t, err)
+
+	require.Error(t, ap.AddFrame(func(p *Painter) error {
+		return errors.New("draw failure")
+	}))
+	require.Error(t, ap.AddChartFrame(ChartOption{ // pie can not mix other charts
+		SeriesList: append(NewSeriesListPie([]float64{1, 2}).ToGenericSeriesList(),
+			NewSeriesListLine([][]float64{{1, 2}}).ToGenericSeriesList()...),
+	}))
+	assert.Zero(t, ap.FrameCount())
+}
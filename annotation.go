@@ -0,0 +1,113 @@
+package charts
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// annotationPointerLength is the length of the line between the annotated point and the callout box.
+	annotationPointerLength = 20
+	// annotationBoxPadding matches the background padding applied by drawLabelWithBackground.
+	annotationBoxPadding = 4
+)
+
+// ChartAnnotation marks a location on the chart with a text callout, connected to the location by a pointer line.
+// Annotations are useful for marking events such as news releases or trade entry and exit points.
+type ChartAnnotation struct {
+	// Index is the data index the annotation is placed at.
+	Index int
+	// Time places the annotation at a time when the x-axis is a time axis, taking precedence over Index. The
+	// annotation uses the candle closest to the time when Value is not set.
+	Time time.Time
+	// Value is the y-axis value the annotation points to. When nil, the annotation points to the candle high when
+	// positioned above, or the candle low when positioned below.
+	Value *float64
+	// SeriesIndex selects the series whose candle and y-axis the annotation is anchored to.
+	SeriesIndex int
+	// Text is the callout text, multiple lines can be separated by '\n'.
+	Text string
+	// Position places the callout PositionTop (default) or PositionBottom of the annotated point.
+	Position string
+	// Style overrides the callout style, unset fields use the defaults.
+	Style LabelStyle
+}
+
+// annotationRenderOption is a resolved annotation ready to be drawn.
+type annotationRenderOption struct {
+	point Point
+	text  string
+	below bool
+	style LabelStyle
+}
+
+type annotationPainter struct {
+	p       *Painter
+	options []annotationRenderOption
+}
+
+func newAnnotationPainter(p *Painter) *annotationPainter {
+	return &annotationPainter{p: p}
+}
+
+func (a *annotationPainter) add(opt annotationRenderOption) {
+	if opt.text == "" || opt.point.Y == math.MaxInt32 {
+		return
+	}
+	a.options = append(a.options, opt)
+}
+
+// defaultAnnotationStyle returns the callout style used for fields not set on an annotation.
+func defaultAnnotationStyle(theme ColorPalette, color Color) LabelStyle {
+	return LabelStyle{
+		FontStyle: FontStyle{
+			FontColor: theme.GetLabelTextColor(),
+			FontSize:  10,
+		},
+		BackgroundColor: theme.GetBackgroundColor().WithAlpha(220),
+		CornerRadius:    4,
+		BorderColor:     color,
+		BorderWidth:     1,
+	}
+}
+
+func (a *annotationPainter) Render() (Box, error) {
+	for _, opt := range a.options {
+		style := opt.style
+		fontStyle := fillFontStyleDefaults(style.FontStyle, defaultLabelFontSize, defaultLightFontColor, a.p.font)
+		var textWidth, textHeight int
+		for _, line := range splitLabelText(opt.text) {
+			lineBox := a.p.MeasureText(line, 0, fontStyle)
+			textWidth = max(textWidth, lineBox.Width())
+			textHeight += lineBox.Height()
+		}
+
+		tipY := opt.point.Y - annotationPointerLength
+		textY := tipY - annotationBoxPadding // text is drawn up from the baseline
+		if opt.below {
+			tipY = opt.point.Y + annotationPointerLength
+			textY = tipY + textHeight + annotationBoxPadding
+		}
+		a.p.LineStroke([]Point{opt.point, {X: opt.point.X, Y: tipY}}, style.BorderColor, 1)
+		a.p.Circle(2, opt.point.X, opt.point.Y, style.BorderColor, style.BorderColor, 1)
+		drawLabelWithBackground(a.p, opt.text, opt.point.X-textWidth/2, textY, 0, fontStyle,
+			style.BackgroundColor, style.CornerRadius, style.BorderColor, style.BorderWidth)
+	}
+	return BoxZero, nil
+}
+
+// closestTimeIndex returns the index of the time value nearest to t.
+func closestTimeIndex(times []time.Time, t time.Time) int {
+	best := 0
+	var bestDiff time.Duration = math.MaxInt64
+	for i, v := range times {
+		diff := v.Sub(t)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff < bestDiff {
+			best, bestDiff = i, diff
+		}
+	}
+	return best
+}
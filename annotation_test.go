@@ -0,0 +1,29 @@
+package charts
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosestTimeIndex(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	times := []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 3)}
+
+	assert.Equal(t, 0, closestTimeIndex(times, start.Add(-time.Hour)))
+	assert.Equal(t, 1, closestTimeIndex(times, start.Add(30*time.Hour)))
+	assert.Equal(t, 2, closestTimeIndex(times, start.AddDate(0, 0, 5)))
+}
+
+func TestAnnotationPainterSkipsEmpty(t *testing.T) {
+	t.Parallel()
+
+	a := newAnnotationPainter(NewPainter(PainterOptions{Width: 100, Height: 100}))
+	a.add(annotationRenderOption{point: Point{X: 10, Y: 10}})
+	a.add(annotationRenderOption{point: Point{X: 10, Y: math.MaxInt32}, text: "null"})
+	assert.Empty(t, a.options)
+}
@@ -3,6 +3,9 @@ package charts
 import (
 	"math"
 	"slices"
+	"time"
+
+	"github.com/golang/freetype/truetype"
 )
 
 type CategoryAxisOption struct {
@@ -16,6 +19,18 @@ type CategoryAxisOption struct {
 	TitleFontStyle FontStyle
 	// Labels provides labels for each value on the axis. Indices must match series data indices.
 	Labels []string
+	// TimeValues provides a time for each data index as an alternative to Labels. When set, the axis labels round
+	// time intervals (minutes through years depending on the span), and data points are positioned proportionally to
+	// their time rather than evenly. Supported by line, scatter, and candlestick charts on a bottom axis.
+	TimeValues []time.Time
+	// CompressGaps when true positions TimeValues samples at equal spacing, removing calendar gaps such as weekends
+	// and holidays (a trading session axis). Tick labels still show real sample dates. TimeValues must be ascending.
+	CompressGaps bool
+	// TimeLayout is the Go time layout used to format TimeValues tick labels. Default is chosen from the tick interval.
+	TimeLayout string
+	// AlwaysShowEnds when true labels the first and last TimeValues in addition to the round time ticks, dropping
+	// ticks too close to the ends to fit. Label thinning on Labels based axes always keeps the first and last labels.
+	AlwaysShowEnds bool
 	// Position controls the physical axis placement. All four position constants are accepted.
 	// TODO - top-positioned category axis rendering for vertical bars is not yet supported.
 	Position string
@@ -38,26 +53,32 @@ type CategoryAxisOption struct {
 	// LabelCountAdjustment specifies a relative influence on how many labels should be rendered.
 	// Typically, this is negative to result in cleaner graphs, positive values may result in text collisions.
 	LabelCountAdjustment int
+	// SplitLineShow when set to *true draws split lines across the plot at each axis tick, vertical grid lines for
+	// an x-axis. Hidden by default.
+	SplitLineShow *bool
+	// SplitLineStyle sets the color, width, and dash pattern of the split lines.
+	SplitLineStyle SplitLineStyle
 }
 
 // XAxisOption is an alias for CategoryAxisOption. Use whatever the chart type accepts.
 type XAxisOption = CategoryAxisOption
 
 // prepAxisStyles resolves theme, label, and title font styles for either axis option type.
-func prepAxisStyles(theme *ColorPalette, fallbackTheme ColorPalette, isVertical bool,
+func prepAxisStyles(theme *ColorPalette, fallbackTheme ColorPalette, fallbackFont *truetype.Font, isVertical bool,
 	labelFontStyle *FontStyle, titleFontStyle *FontStyle) {
 	*theme = getPreferredTheme(*theme, fallbackTheme)
 	textColor := (*theme).GetXAxisTextColor()
 	if isVertical {
 		textColor = (*theme).GetYAxisTextColor()
 	}
-	*labelFontStyle = fillFontStyleDefaults(*labelFontStyle, defaultFontSize, textColor)
+	*labelFontStyle = fillFontStyleDefaults(*labelFontStyle, defaultFontSize, textColor, fallbackFont)
 	*titleFontStyle = fillFontStyleDefaults(*titleFontStyle, math.Max(labelFontStyle.FontSize, defaultFontSize),
 		labelFontStyle.FontColor, labelFontStyle.Font)
 }
 
-func (opt *CategoryAxisOption) prep(fallbackTheme ColorPalette, isVertical bool) *CategoryAxisOption {
-	prepAxisStyles(&opt.Theme, fallbackTheme, isVertical, &opt.LabelFontStyle, &opt.TitleFontStyle)
+func (opt *CategoryAxisOption) prep(fallbackTheme ColorPalette, fallbackFont *truetype.Font,
+	isVertical bool) *CategoryAxisOption {
+	prepAxisStyles(&opt.Theme, fallbackTheme, fallbackFont, isVertical, &opt.LabelFontStyle, &opt.TitleFontStyle)
 	return opt
 }
 
@@ -72,9 +93,44 @@ func (opt *CategoryAxisOption) toAxisOption(xAxisRange axisRange) axisOption {
 		boundaryGap:    opt.BoundaryGap,
 		position:       opt.Position,
 		labelOffset:    opt.LabelOffset,
+		splitLineShow:  opt.SplitLineShow,
+		splitLineStyle: opt.SplitLineStyle,
 	}
 }
 
+// SplitLineStyle configures the lines drawn across the plot from an axis, also known as grid lines.
+type SplitLineStyle struct {
+	// Color is the line color, defaults to the theme axis split line color.
+	Color Color
+	// Width is the line stroke width (default 1.0).
+	Width float64
+	// DashArray sets a dash pattern for the lines, for example []float64{4, 2}. Solid lines are drawn when empty.
+	DashArray []float64
+}
+
+// AxisType selects how values are mapped to positions along a value axis.
+type AxisType string
+
+const (
+	// AxisTypeLinear maps values linearly across the axis. This is the default.
+	AxisTypeLinear AxisType = "linear"
+	// AxisTypeLog maps values by their base 10 logarithm, placing labels at powers of ten. Only positive values can
+	// be represented; zero and negative values are clamped to the axis minimum.
+	AxisTypeLog AxisType = "log"
+)
+
+// AxisMode selects how series values are presented on a value axis.
+type AxisMode string
+
+const (
+	// AxisModeValue plots the series values unchanged. This is the default.
+	AxisModeValue AxisMode = "value"
+	// AxisModePercentChange rebases each series on the axis to the percentage change from its first valid value, so
+	// the first point is 0% and series at different levels can be compared. Labels are formatted as percentages
+	// unless a ValueFormatter is set.
+	AxisModePercentChange AxisMode = "percent_change"
+)
+
 // ValueAxisOption configures the value (numeric / range) axis.
 type ValueAxisOption struct {
 	// Show specifies if the axis should be rendered. Set to *false (via Ptr(false)) to hide the axis.
@@ -89,8 +145,16 @@ type ValueAxisOption struct {
 	Min *float64
 	// Max forces the maximum value of the axis when set (Use Ptr(float64)).
 	Max *float64
+	// RangeRounding selects how the axis range is derived from the data. Defaults to RoundNice, other options are
+	// RoundToData and RoundToMultiple. Explicit Min and Max values take precedence.
+	RangeRounding AxisRangeRounding
 	// RangeValuePaddingScale suggests a padding scale to apply to the max and min values.
 	RangeValuePaddingScale *float64
+	// RangePaddingPercent when greater than zero sets the range to the data extent expanded by this percent of the
+	// data span below the min and above the max, replacing the RoundNice padding. Padding does not extend the range
+	// across zero. With RoundToMultiple the padded range is then rounded outward. Explicit Min and Max values are
+	// not padded.
+	RangePaddingPercent float64
 	// Labels provides labels for each value on the axis.
 	Labels []string
 	// Position controls the physical axis placement. All four position constants are accepted.
@@ -114,21 +178,121 @@ type ValueAxisOption struct {
 	LabelSkipCount int
 	// SplitLineShow when set to *true shows horizontal axis split lines.
 	SplitLineShow *bool
+	// SplitLineStyle sets the color, width, and dash pattern of the split lines.
+	SplitLineStyle SplitLineStyle
+	// SplitNumber when greater than zero divides the axis into this many evenly spaced split line intervals,
+	// independent of the tick labels. By default a split line is drawn at each label tick.
+	SplitNumber int
 	// SpineLineShow controls whether the vertical spine line is shown.
 	// Default is hidden unless it's a category axis.
 	SpineLineShow *bool
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
+	// Type selects the axis scale, AxisTypeLinear (default) or AxisTypeLog. Log axes are currently supported only
+	// for vertical value axes.
+	Type AxisType
+	// Mode selects how series values are presented, AxisModeValue (default) or AxisModePercentChange. Currently
+	// applied to line series, candlestick charts instead offer PercentAxis.
+	Mode AxisMode
+	// MinorSplitLineShow when set to *true on a log axis draws faint split lines at 2 through 9 times each power
+	// of ten.
+	MinorSplitLineShow *bool
+	// MarkLines draws horizontal reference lines across the plot at fixed values of this axis, for example support
+	// and resistance levels or a target price. Only rendered for vertical value axes.
+	MarkLines []MarkLine
 	// TODO - isCategoryAxis is a hack used only by heat map so its Y-position axis
 	// renders with category styling. Remove when defaultRender supports dual category axes.
 	isCategoryAxis bool
 }
 
+// MarkLine configures a reference line drawn across the full plot width at a value axis value. Lines with a value
+// outside the axis range are not drawn.
+type MarkLine struct {
+	// Value is the axis value the line is drawn at.
+	Value float64
+	// Label is optional text drawn above the line at the right edge of the plot.
+	Label string
+	// Color is the line and label color, defaults to the theme mark text color.
+	Color Color
+	// Width is the line stroke width (default 1.0).
+	Width float64
+	// DashArray sets a dash pattern for the line, for example []float64{4, 2}. A solid line is drawn when empty.
+	DashArray []float64
+}
+
+type axisRangeRoundingMode int
+
+const (
+	axisRangeRoundNice axisRangeRoundingMode = iota
+	axisRangeRoundData
+	axisRangeRoundMultiple
+)
+
+// AxisRangeRounding configures how a value axis range is computed from the data extent.
+// Use RoundNice, RoundToData, or RoundToMultiple.
+type AxisRangeRounding struct {
+	mode     axisRangeRoundingMode
+	multiple float64
+}
+
+var (
+	// RoundNice pads the data range and rounds it to produce human-friendly axis intervals. This is the default.
+	RoundNice = AxisRangeRounding{mode: axisRangeRoundNice}
+	// RoundToData sets the axis range to the exact data min and max, with no padding.
+	RoundToData = AxisRangeRounding{mode: axisRangeRoundData}
+)
+
+// RoundToMultiple returns an AxisRangeRounding which extends the data min and max outward to the nearest multiple
+// of m. Values of m less than or equal to zero result in RoundNice.
+func RoundToMultiple(m float64) AxisRangeRounding {
+	if m <= 0 || math.IsInf(m, 0) || math.IsNaN(m) {
+		return RoundNice
+	}
+	return AxisRangeRounding{mode: axisRangeRoundMultiple, multiple: m}
+}
+
 // YAxisOption is an alias for ValueAxisOption. Use whatever the chart type accepts.
 type YAxisOption = ValueAxisOption
 
-func (opt *ValueAxisOption) prep(fallbackTheme ColorPalette, isVertical bool) *ValueAxisOption {
-	prepAxisStyles(&opt.Theme, fallbackTheme, isVertical, &opt.LabelFontStyle, &opt.TitleFontStyle)
+// percentChangeAxes returns a copy of the axes with the percent formatter set on those in AxisModePercentChange, and
+// true if any axis uses the mode. The axes are returned unchanged when no axis uses the mode.
+func percentChangeAxes(axes []ValueAxisOption) ([]ValueAxisOption, bool) {
+	if !slices.ContainsFunc(axes, func(a ValueAxisOption) bool { return a.Mode == AxisModePercentChange }) {
+		return axes, false
+	}
+	result := slices.Clone(axes)
+	for i := range result {
+		if result[i].Mode == AxisModePercentChange && result[i].ValueFormatter == nil {
+			result[i].ValueFormatter = formatPercentChange
+		}
+	}
+	return result, true
+}
+
+// isPercentChangeAxis returns true if the axis at the index is in AxisModePercentChange.
+func isPercentChangeAxis(axes []ValueAxisOption, index int) bool {
+	return index >= 0 && index < len(axes) && axes[index].Mode == AxisModePercentChange
+}
+
+// percentChangeValues returns the values converted to the percentage change from the first valid non-zero value.
+func percentChangeValues(values []float64) []float64 {
+	var base float64
+	for _, v := range values {
+		if isValidExtent(v) && v != 0 {
+			base = v
+			break
+		}
+	}
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = percentChange(v, base)
+	}
+	return result
+}
+
+func (opt *ValueAxisOption) prep(fallbackTheme ColorPalette, fallbackFont *truetype.Font,
+	isVertical bool) *ValueAxisOption {
+	prepAxisStyles(&opt.Theme, fallbackTheme, fallbackFont, isVertical, &opt.LabelFontStyle, &opt.TitleFontStyle)
 	return opt
 }
 
@@ -142,9 +306,12 @@ func (opt *ValueAxisOption) toAxisOption(yAxisRange axisRange) axisOption {
 		titleFontStyle: opt.TitleFontStyle,
 		position:       opt.Position,
 		splitLineShow:  opt.SplitLineShow,
+		splitLineStyle: opt.SplitLineStyle,
+		splitNumber:    opt.SplitNumber,
 		spineLineShow:  opt.SpineLineShow,
 		isCategoryAxis: opt.isCategoryAxis,
 		labelSkipCount: opt.LabelSkipCount,
+		minorSplitLine: flagIs(true, opt.MinorSplitLineShow),
 	}
 }
 
@@ -152,6 +319,7 @@ const axisMargin = 4
 const minimumAxisLabels = 2            // 2 labels so range is fully shown
 const minimumHorizontalAxisHeight = 24 // too small looks too crowded to the chart data, notable for horizontal bar charts
 const boundaryGapDefaultThreshold = 40
+const minorSplitLineAlpha = 96
 
 type axisPainter struct {
 	p   *Painter
@@ -178,7 +346,13 @@ type axisOption struct {
 	// graph. Specify a *bool to enforce a spacing.
 	boundaryGap   *bool
 	splitLineShow *bool // nil = painter decides based on isCategory
-	spineLineShow *bool // nil = painter decides based on isCategory
+	// splitLineStyle overrides the split line color, width, and dash pattern.
+	splitLineStyle SplitLineStyle
+	// splitNumber sets the count of split line intervals, when zero lines are drawn at the ticks.
+	splitNumber int
+	// minorSplitLine draws split lines between the decades of a log axis.
+	minorSplitLine bool
+	spineLineShow  *bool // nil = painter decides based on isCategory
 	// TODO - isCategoryAxis is a hack used only by heat map so its Y-position axis
 	// renders with category styling. Remove when defaultRender supports dual category axes.
 	isCategoryAxis       bool
@@ -201,7 +375,21 @@ func (a *axisPainter) Render() (Box, error) {
 
 	// rendering defaults derived from physical position, theme, and axis data type
 	axisTheme := getPreferredTheme(opt.theme, top.theme)
-	axisSplitLineColor := axisTheme.GetAxisSplitLineColor()
+	axisSplitLineColor := opt.splitLineStyle.Color
+	if axisSplitLineColor.IsZero() {
+		axisSplitLineColor = axisTheme.GetAxisSplitLineColor()
+	}
+	splitLineWidth := opt.splitLineStyle.Width
+	if splitLineWidth <= 0 {
+		splitLineWidth = 1
+	}
+	splitLine := func(points []Point, color Color) {
+		if len(opt.splitLineStyle.DashArray) > 0 {
+			top.DashedLineStroke(points, color, splitLineWidth, opt.splitLineStyle.DashArray)
+		} else {
+			top.LineStroke(points, color, splitLineWidth)
+		}
+	}
 	var axisColor Color
 	var minimumAxisHeight int
 	if isVertical {
@@ -365,6 +553,16 @@ func (a *axisPainter) Render() (Box, error) {
 		tickSpaces--
 	}
 
+	// time axes place ticks and labels at their proportional time positions
+	var timePositions []int
+	if opt.aRange.isTimeAxis() && !isVertical {
+		timePositions = make([]int, len(opt.aRange.timeTicks))
+		start, end := timeRange(opt.aRange.timeValues)
+		for i, t := range opt.aRange.timeTicks {
+			timePositions[i] = opt.aRange.timePositionIn(t, start, end, child.Width())
+		}
+	}
+
 	// draw tick marks
 	if strokeWidth > 0 {
 		var tickPaddingBox Box
@@ -387,6 +585,7 @@ func (a *axisPainter) Render() (Box, error) {
 			vertical:    isVertical,
 			strokeWidth: strokeWidth,
 			strokeColor: axisColor,
+			positions:   timePositions,
 		})
 	}
 
@@ -421,17 +620,27 @@ func (a *axisPainter) Render() (Box, error) {
 			alignSide = AlignLeft
 		}
 	}
-	labelPainter.multiText(multiTextOption{
-		textList:       rangeLabels,
-		vertical:       isVertical,
-		centerLabels:   centerLabels,
-		align:          alignSide,
-		textRotation:   opt.aRange.labelRotation,
-		offset:         opt.labelOffset,
-		labelCount:     opt.aRange.labelCount,
-		labelSkipCount: opt.labelSkipCount,
-		fontStyle:      opt.aRange.labelFontStyle,
-	})
+	if timePositions != nil {
+		for i, label := range opt.aRange.labels {
+			box := labelPainter.MeasureText(label, opt.aRange.labelRotation, opt.aRange.labelFontStyle)
+			// center the label on its tick, keeping it within the axis bounds
+			x := min(max(timePositions[i]-box.Width()>>1, 0), labelPainter.Width()-box.Width())
+			labelPainter.Text(label, x+opt.labelOffset.Left, opt.labelOffset.Top,
+				opt.aRange.labelRotation, opt.aRange.labelFontStyle)
+		}
+	} else {
+		labelPainter.multiText(multiTextOption{
+			textList:       rangeLabels,
+			vertical:       isVertical,
+			centerLabels:   centerLabels,
+			align:          alignSide,
+			textRotation:   opt.aRange.labelRotation,
+			offset:         opt.labelOffset,
+			labelCount:     opt.aRange.labelCount,
+			labelSkipCount: opt.labelSkipCount,
+			fontStyle:      opt.aRange.labelFontStyle,
+		})
+	}
 
 	if splitLineShow { // show auxiliary lines
 		if isVertical {
@@ -444,15 +653,30 @@ func (a *axisPainter) Render() (Box, error) {
 				x1Split = top.Width() - child.Width()
 			}
 			yValues := autoDivide(child.Height(), tickSpaces)
+			if opt.splitNumber > 0 {
+				yValues = autoDivide(child.Height(), opt.splitNumber)
+			}
 			// Skip the last one to avoid re-drawing the axis line
 			if len(yValues) > 0 {
 				yValues = yValues[:len(yValues)-1]
 			}
 			for _, yy := range yValues {
-				top.LineStroke([]Point{
+				splitLine([]Point{
 					{X: x0Split, Y: yy},
 					{X: x1Split, Y: yy},
-				}, axisSplitLineColor, 1)
+				}, axisSplitLineColor)
+			}
+			if opt.minorSplitLine && opt.aRange.logScale {
+				minorColor := axisSplitLineColor.WithAlpha(minorSplitLineAlpha)
+				r := opt.aRange
+				r.size = child.Height()
+				for _, v := range logMinorValues(r.min, r.max) {
+					yy := r.getRestHeight(v)
+					splitLine([]Point{
+						{X: x0Split, Y: yy},
+						{X: x1Split, Y: yy},
+					}, minorColor)
+				}
 			}
 		} else {
 			var y0Split, y1Split int
@@ -464,14 +688,19 @@ func (a *axisPainter) Render() (Box, error) {
 				y1Split = top.Height() - child.Height()
 			}
 			xValues := autoDivide(child.Width(), tickSpaces)
+			if opt.splitNumber > 0 {
+				xValues = autoDivide(child.Width(), opt.splitNumber)
+			} else if timePositions != nil {
+				xValues = timePositions
+			}
 			for i, xx := range xValues {
-				if i == 0 {
+				if i == 0 && xx == 0 {
 					continue // skip the first, so we don't overlap the axis line
 				}
-				top.LineStroke([]Point{
+				splitLine([]Point{
 					{X: xx, Y: y0Split},
 					{X: xx, Y: y1Split},
-				}, axisSplitLineColor, 1)
+				}, axisSplitLineColor)
 			}
 		}
 	}
@@ -1,9 +1,12 @@
 package charts
 
 import (
+	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,7 +35,7 @@ func TestAxisRender(t *testing.T) {
 					BoundaryGap:    Ptr(true),
 					LabelFontStyle: fs,
 				}
-				return opt.prep(axisTheme, false).toAxisOption(newTestRangeForLabels(dayLabels, 0, fs))
+				return opt.prep(axisTheme, nil, false).toAxisOption(newTestRangeForLabels(dayLabels, 0, fs))
 			},
 		},
 		{
@@ -42,7 +45,7 @@ func TestAxisRender(t *testing.T) {
 					BoundaryGap:    Ptr(true),
 					LabelFontStyle: fs,
 				}
-				return opt.prep(axisTheme, false).toAxisOption(newTestRangeForLabels(dayLabels, DegreesToRadians(45), fs))
+				return opt.prep(axisTheme, nil, false).toAxisOption(newTestRangeForLabels(dayLabels, DegreesToRadians(45), fs))
 			},
 		},
 		{
@@ -53,7 +56,7 @@ func TestAxisRender(t *testing.T) {
 					BoundaryGap:    Ptr(true),
 					LabelFontStyle: fs,
 				}
-				return opt.prep(axisTheme, false).toAxisOption(newTestRangeForLabels(dayLabels, DegreesToRadians(90), fs))
+				return opt.prep(axisTheme, nil, false).toAxisOption(newTestRangeForLabels(dayLabels, DegreesToRadians(90), fs))
 			},
 		},
 		{
@@ -73,7 +76,7 @@ func TestAxisRender(t *testing.T) {
 					Position:       PositionLeft,
 					isCategoryAxis: true,
 				}
-				return opt.prep(axisTheme, true).toAxisOption(newTestRangeForLabels(dayLabels, 0, fs))
+				return opt.prep(axisTheme, nil, true).toAxisOption(newTestRangeForLabels(dayLabels, 0, fs))
 			},
 		},
 		{
@@ -241,7 +244,7 @@ func TestBottomXAxis(t *testing.T) {
 			}, PainterThemeOption(theme), PainterPaddingOption(NewBoxEqual(100)))
 
 			xAxisOpt := tt.makeOption()
-			xAxisOpt = *xAxisOpt.prep(theme, false)
+			xAxisOpt = *xAxisOpt.prep(theme, nil, false)
 			aRange := newTestRangeForLabels(xAxisOpt.Labels, xAxisOpt.LabelRotation,
 				fillFontStyleDefaults(xAxisOpt.LabelFontStyle, defaultFontSize, theme.GetXAxisTextColor()))
 			aRange.isCategory = !tt.makeValue
@@ -254,6 +257,84 @@ func TestBottomXAxis(t *testing.T) {
 	}
 }
 
+func TestXAxisLabelThinningKeepsEnds(t *testing.T) {
+	t.Parallel()
+
+	labels := make([]string, 97)
+	values := make([]float64, len(labels))
+	for i := range labels {
+		labels[i] = "L" + strconv.Itoa(i)
+		values[i] = float64(i % 7)
+	}
+	labelRegex := regexp.MustCompile(`>L\d+<`)
+
+	for i, labelCount := range []int{0, 2, 3, 7} {
+		for _, boundaryGap := range []bool{true, false} {
+			t.Run(strconv.Itoa(i)+"-"+strconv.FormatBool(boundaryGap), func(t *testing.T) {
+				opt := NewLineChartOptionWithData([][]float64{values})
+				opt.XAxis.Labels = labels
+				opt.XAxis.LabelCount = labelCount
+				opt.XAxis.BoundaryGap = Ptr(boundaryGap)
+				p := NewPainter(PainterOptions{
+					OutputFormat: ChartOutputSVG,
+					Width:        600,
+					Height:       400,
+				})
+				require.NoError(t, p.LineChart(opt))
+				data, err := p.Bytes()
+				require.NoError(t, err)
+
+				rendered := labelRegex.FindAllString(string(data), -1)
+				require.Less(t, len(rendered), len(labels)) // labels were thinned
+				assert.Equal(t, ">L0<", rendered[0])
+				assert.Equal(t, ">L96<", rendered[len(rendered)-1])
+			})
+		}
+	}
+}
+
+func TestXAxisAlwaysShowEnds(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC)
+	times := make([]time.Time, 60)
+	values := make([]float64, len(times))
+	for i := range times {
+		times[i] = start.AddDate(0, 0, i)
+		values[i] = float64(i % 7)
+	}
+	render := func(t *testing.T, alwaysShowEnds bool) []string {
+		t.Helper()
+
+		opt := NewLineChartOptionWithData([][]float64{values})
+		opt.XAxis.TimeValues = times
+		opt.XAxis.TimeLayout = "Jan 2"
+		opt.XAxis.AlwaysShowEnds = alwaysShowEnds
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.LineChart(opt))
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		var labels []string
+		for _, m := range regexp.MustCompile(`>([A-Z][a-z]{2} \d+)<`).FindAllStringSubmatch(string(data), -1) {
+			labels = append(labels, m[1])
+		}
+		return labels
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		labels := render(t, false)
+		require.NotEmpty(t, labels)
+		assert.NotContains(t, labels, "Mar 4")
+		assert.NotContains(t, labels, "May 2")
+	})
+	t.Run("set", func(t *testing.T) {
+		labels := render(t, true)
+		require.Greater(t, len(labels), 2)
+		assert.Equal(t, "Mar 4", labels[0])
+		assert.Equal(t, "May 2", labels[len(labels)-1])
+	})
+}
+
 func TestYAxis(t *testing.T) {
 	t.Parallel()
 
@@ -348,7 +429,7 @@ func TestYAxis(t *testing.T) {
 				PainterPaddingOption(NewBoxEqual(100)))
 
 			yAxisOpt := tt.makeOption()
-			yAxisOpt = yAxisOpt.prep(theme, true)
+			yAxisOpt = yAxisOpt.prep(theme, nil, true)
 			aRange := newTestRangeForLabels(yAxisOpt.Labels, yAxisOpt.LabelRotation,
 				fillFontStyleDefaults(yAxisOpt.LabelFontStyle, defaultFontSize, theme.GetYAxisTextColor()))
 			aRange.isCategory = tt.makeCategory
@@ -364,3 +445,53 @@ func TestYAxis(t *testing.T) {
 		})
 	}
 }
+
+func TestPercentChangeValues(t *testing.T) {
+	t.Parallel()
+
+	null := GetNullValue()
+	tests := []struct {
+		name     string
+		values   []float64
+		expected []float64
+	}{
+		{name: "rebase_first", values: []float64{100, 110, 90, 125}, expected: []float64{0, 10, -10, 25}},
+		{name: "leading_null_and_zero", values: []float64{null, 0, 50, 75}, expected: []float64{null, -100, 0, 50}},
+		{name: "null_preserved", values: []float64{200, null, 100}, expected: []float64{0, null, -50}},
+		{name: "no_base", values: []float64{0, null}, expected: []float64{null, null}},
+		{name: "empty", values: []float64{}, expected: []float64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDeltaSlice(t, tt.expected, percentChangeValues(tt.values), 1e-9)
+		})
+	}
+}
+
+func TestPercentChangeAxes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unchanged", func(t *testing.T) {
+		axes := []YAxisOption{{Mode: AxisModeValue}, {}}
+		result, percent := percentChangeAxes(axes)
+		assert.False(t, percent)
+		assert.Nil(t, result[0].ValueFormatter)
+		assert.False(t, isPercentChangeAxis(result, 0))
+	})
+	t.Run("formatter_set", func(t *testing.T) {
+		axes := []YAxisOption{{}, {Mode: AxisModePercentChange}}
+		result, percent := percentChangeAxes(axes)
+		require.True(t, percent)
+		assert.Nil(t, axes[1].ValueFormatter) // input is not mutated
+		assert.Nil(t, result[0].ValueFormatter)
+		require.NotNil(t, result[1].ValueFormatter)
+		assert.Equal(t, "12.5%", result[1].ValueFormatter(12.5))
+		assert.True(t, isPercentChangeAxis(result, 1))
+		assert.False(t, isPercentChangeAxis(result, 2))
+	})
+	t.Run("custom_formatter_retained", func(t *testing.T) {
+		formatter := func(v float64) string { return "custom" }
+		result, _ := percentChangeAxes([]YAxisOption{{Mode: AxisModePercentChange, ValueFormatter: formatter}})
+		assert.Equal(t, "custom", result[0].ValueFormatter(1))
+	})
+}
@@ -279,12 +279,14 @@ func (b *barChart) renderVerticalBars(result *defaultRenderResult) (Box, error)
 
 			if labelPainter != nil {
 				labelY := top
-				var radians float64
+				radians := series.Label.Rotation
 				fontStyle := series.Label.FontStyle
 				labelBottom := opt.SeriesLabelPosition == PositionBottom && !stackSeries
 				if labelBottom {
 					labelY = barMaxHeight
-					radians = -math.Pi / 2 // Rotated label at the bottom
+					if radians == 0 {
+						radians = -math.Pi / 2 // Rotated label at the bottom
+					}
 				}
 				if fontStyle.FontColor.IsZero() {
 					var testColor Color
@@ -296,11 +298,7 @@ func (b *barChart) renderVerticalBars(result *defaultRenderResult) (Box, error)
 						}
 					}
 					if !testColor.IsZero() {
-						if isLightColor(testColor) {
-							fontStyle.FontColor = defaultLightFontColor
-						} else {
-							fontStyle.FontColor = defaultDarkFontColor
-						}
+						fontStyle.FontColor = contrastFontColor(testColor)
 					}
 				}
 				labelPainter.Add(labelValue{
@@ -562,11 +560,7 @@ func (b *barChart) renderHorizontalBars(result *defaultRenderResult) (Box, error
 						testColor = opt.Theme.GetSeriesColor(index + 1)
 					}
 					if !testColor.IsZero() {
-						if isLightColor(testColor) {
-							fontStyle.FontColor = defaultLightFontColor
-						} else {
-							fontStyle.FontColor = defaultDarkFontColor
-						}
+						fontStyle.FontColor = contrastFontColor(testColor)
 					}
 				}
 				labelPainter.Add(labelValue{
@@ -1,6 +1,8 @@
 package charts
 
 import (
+	"math"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -501,6 +503,39 @@ func TestCalculateGroupMarginsAndSize(t *testing.T) {
 	}
 }
 
+func TestBarChartLabelRotation(t *testing.T) {
+	t.Parallel()
+
+	values := make([]float64, 24)
+	for i := range values {
+		values[i] = float64(1000 + i*125)
+	}
+	opt := NewBarChartOptionWithData([][]float64{values})
+	opt.SeriesList[0].Label.Show = Ptr(true)
+	opt.SeriesList[0].Label.Rotation = -math.Pi / 2
+	opt.Padding = NewBoxEqual(0)
+	opt.CategoryAxis.Show = Ptr(false)
+	opt.ValueAxis = []ValueAxisOption{{Show: Ptr(false)}}
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.BarChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(buf)
+	assertTestdataSVG(t, buf)
+
+	matches := regexp.MustCompile(`<text x="(\d+)" y="\d+" style="[^"]*" transform="rotate\(270\.00,`).FindAllStringSubmatch(svg, -1)
+	require.Len(t, matches, len(values))
+	bandWidth := 600 / len(values)
+	for i, m := range matches {
+		x, err := strconv.Atoi(m[1])
+		require.NoError(t, err)
+		// rotated text extends left of its anchor, the anchor must remain inside the band of its bar
+		assert.Greater(t, x, i*bandWidth, "label %d", i)
+		assert.LessOrEqual(t, x, (i+1)*bandWidth, "label %d", i)
+	}
+}
+
 func TestBarChartError(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,83 @@
+package charts
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// BatchSpec describes a single chart to render as part of RenderBatch.
+type BatchSpec struct {
+	// ID identifies the chart in the returned results, it must be unique within the batch.
+	ID string
+	// OutputFormat overrides the output format of the Option when set: "svg", "png", or "jpg".
+	OutputFormat string
+	// Option is the chart configuration to render.
+	Option ChartOption
+}
+
+// BatchResult contains the encoded output of a single BatchSpec.
+type BatchResult struct {
+	// ID matches the ID of the BatchSpec that produced this result.
+	ID string
+	// Bytes is the encoded chart, nil if rendering failed.
+	Bytes []byte
+	// Err is set if the chart failed to render or encode.
+	Err error
+}
+
+// RenderBatch renders many independent charts concurrently using a worker pool bounded by GOMAXPROCS. Results are
+// returned in the same order as the specs. Charts that fail to render have their Err set and are also included in
+// the returned error, allowing the successfully rendered charts to still be used.
+func RenderBatch(specs []BatchSpec) ([]BatchResult, error) {
+	ids := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		if _, ok := ids[spec.ID]; ok {
+			return nil, fmt.Errorf("duplicate batch id: %q", spec.ID)
+		}
+		ids[spec.ID] = struct{}{}
+	}
+
+	results := make([]BatchResult, len(specs))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(specs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = renderBatchSpec(specs[i])
+			}
+		}()
+	}
+	for i := range specs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("chart %q: %w", r.ID, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// renderBatchSpec renders and encodes a single chart of the batch.
+func renderBatchSpec(spec BatchSpec) BatchResult {
+	result := BatchResult{ID: spec.ID}
+	opt := spec.Option
+	if spec.OutputFormat != "" {
+		opt.OutputFormat = spec.OutputFormat
+	}
+	p, err := Render(opt)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Bytes, result.Err = p.Bytes()
+	return result
+}
@@ -0,0 +1,96 @@
+package charts
+
+import (
+	"bytes"
+	"image/png"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderBatch(t *testing.T) {
+	t.Parallel()
+
+	var specs []BatchSpec
+	for i := 0; i < 8; i++ {
+		specs = append(specs,
+			BatchSpec{
+				ID:           "line-" + strconv.Itoa(i),
+				OutputFormat: ChartOutputSVG,
+				Option: ChartOption{
+					SeriesList: NewSeriesListLine([][]float64{{1, 2, float64(i)}}).ToGenericSeriesList(),
+				},
+			},
+			BatchSpec{
+				ID:           "bar-" + strconv.Itoa(i),
+				OutputFormat: ChartOutputPNG,
+				Option: ChartOption{
+					SeriesList: NewSeriesListBar([][]float64{{3, float64(i), 1}}).ToGenericSeriesList(),
+				},
+			},
+			BatchSpec{
+				ID: "candlestick-" + strconv.Itoa(i),
+				Option: ChartOption{
+					OutputFormat: ChartOutputSVG,
+					SeriesList:   NewSeriesListCandlestick([][]OHLCData{makeBasicCandlestickData()}).ToGenericSeriesList(),
+				},
+			},
+			BatchSpec{
+				ID:           "pie-" + strconv.Itoa(i),
+				OutputFormat: ChartOutputSVG,
+				Option: ChartOption{
+					SeriesList: NewSeriesListPie([]float64{1, 2, float64(i + 1)}).ToGenericSeriesList(),
+				},
+			},
+		)
+	}
+
+	results, err := RenderBatch(specs)
+	require.NoError(t, err)
+	require.Len(t, results, len(specs))
+	for i, r := range results {
+		assert.Equal(t, specs[i].ID, r.ID)
+		require.NoError(t, r.Err)
+		if specs[i].OutputFormat == ChartOutputPNG {
+			_, err := png.Decode(bytes.NewReader(r.Bytes))
+			assert.NoError(t, err)
+		} else {
+			assert.True(t, bytes.HasPrefix(r.Bytes, []byte("<svg")))
+		}
+	}
+}
+
+func TestRenderBatchErrors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("duplicate_id", func(t *testing.T) {
+		_, err := RenderBatch([]BatchSpec{
+			{ID: "a", Option: ChartOption{SeriesList: NewSeriesListLine([][]float64{{1}}).ToGenericSeriesList()}},
+			{ID: "a", Option: ChartOption{SeriesList: NewSeriesListLine([][]float64{{1}}).ToGenericSeriesList()}},
+		})
+		require.Error(t, err)
+	})
+	t.Run("partial_failure", func(t *testing.T) {
+		results, err := RenderBatch([]BatchSpec{
+			{ID: "ok", Option: ChartOption{SeriesList: NewSeriesListLine([][]float64{{1, 2}}).ToGenericSeriesList()}},
+			{ID: "bad", Option: ChartOption{ // pie can not mix other charts
+				SeriesList: append(NewSeriesListPie([]float64{1, 2}).ToGenericSeriesList(),
+					NewSeriesListLine([][]float64{{1, 2}}).ToGenericSeriesList()...),
+			}},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"bad"`)
+		require.Len(t, results, 2)
+		require.NoError(t, results[0].Err)
+		assert.NotEmpty(t, results[0].Bytes)
+		require.Error(t, results[1].Err)
+		assert.Nil(t, results[1].Bytes)
+	})
+	t.Run("empty", func(t *testing.T) {
+		results, err := RenderBatch(nil)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
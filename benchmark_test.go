@@ -3,6 +3,7 @@ package charts
 import (
 	"errors"
 	"testing"
+	"time"
 )
 
 func makeDefaultMultiChartOptions() ChartOption {
@@ -541,3 +542,28 @@ func renderPainterRadar(painter *Painter) {
 		panic(errors.New("data is nil"))
 	}
 }
+
+func BenchmarkPainterLineChartTimeAxisSVGRender(b *testing.B) {
+	const count = 40_000
+	values := make([]float64, count)
+	times := make([]time.Time, count)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range values {
+		values[i] = float64(i % 100)
+		times[i] = start.Add(time.Duration(i) * time.Minute)
+	}
+	opt := NewLineChartOptionWithData([][]float64{values})
+	opt.XAxis.TimeValues = times
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		painter := NewPainter(PainterOptions{
+			OutputFormat: ChartOutputSVG,
+		})
+		if err := painter.LineChart(opt); err != nil {
+			panic(err)
+		} else if _, err := painter.Bytes(); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -2,13 +2,21 @@ package charts
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"slices"
+	"strconv"
+	"strings"
 )
 
 type candlestickChart struct {
 	p   *Painter
 	opt *CandlestickChartOption
+	// volumeReserve, atrReserve, rsiReserve, and macdReserve are the fractions of the plot height reserved for the
+	// indicator panes.
+	volumeReserve, atrReserve, rsiReserve, macdReserve float64
+	// profileSlots is the number of candle slots reserved on the right for the volume profile.
+	profileSlots int
 }
 
 // newCandlestickChart returns a candlestick chart renderer.
@@ -33,10 +41,19 @@ type CandlestickChartOption struct {
 	YAxis []YAxisOption
 	// Title contains options for rendering the chart title.
 	Title TitleOption
+	// Footer contains options for a note rendered beneath the chart, such as a data source.
+	Footer FooterOption
 	// Legend contains options for the data legend.
 	Legend LegendOption
 	// CandleWidth sets body width ratio (0.0–1.0, default 0.8).
 	CandleWidth float64
+	// CandleMinWidth sets the minimum candle body width in pixels, keeping candles legible on dense charts.
+	CandleMinWidth int
+	// CandleMaxWidth sets the maximum candle body width in pixels, preventing overly wide candles on sparse charts.
+	CandleMaxWidth int
+	// HollowUpCandles when true draws bullish candle bodies as an outline only while bearish bodies remain filled.
+	// This sets the default for series without a CandleStyle, equivalent to CandleStyleTraditional.
+	HollowUpCandles *bool
 	// ShowWicks controls whether high-low wicks are displayed by default. When nil, wicks are shown.
 	// Individual series can override this setting.
 	ShowWicks *bool
@@ -45,8 +62,389 @@ type CandlestickChartOption struct {
 	// CandleMargin sets inter-series spacing ratio (0.0–1.0, auto by default).
 	// Only applies with multiple candlestick series.
 	CandleMargin *float64
+	// FlatBarMarker when true draws bars where open, high, low, and close are all equal as a horizontal tick
+	// spanning the candle width, ensuring the bar remains visible for illiquid or unchanged periods.
+	FlatBarMarker *bool
+	// InvertColors when true swaps the theme up and down colors, for markets where rising prices are shown in red
+	// and falling prices in green. This applies to candle bodies, the legend, and pattern labels.
+	InvertColors *bool
+	// UpColor overrides the theme color of rising candles for every series, leaving the theme axis and grid colors
+	// unchanged. Like InvertColors this applies to candle bodies, the legend, and pattern labels, and it is not
+	// swapped by InvertColors.
+	UpColor Color
+	// DownColor overrides the theme color of falling candles for every series, see UpColor.
+	DownColor Color
+	// UpWickColor sets the wick color of rising candles, defaulting to the theme wick color or the body color.
+	UpWickColor Color
+	// DownWickColor sets the wick color of falling candles, defaulting to the theme wick color or the body color.
+	DownWickColor Color
+	// VisibleRange limits rendering to a window of candles, for example to show the most recent bars of a long series.
+	// Candles before the window are not drawn but remain available as look back for pattern detection.
+	VisibleRange *IndexRange
+	// RightMarginBars reserves empty space equal to this many candle slots on the right side of the chart, leaving
+	// room to project future price movement. On time axes the axis is extended past the last time value by this many
+	// median sample intervals.
+	RightMarginBars int
+	// PercentAxis when true rebases all OHLC values to the percentage change from a reference price, labeling the
+	// y-axis as percentages. This allows comparing instruments trading at different price levels.
+	PercentAxis *bool
+	// PercentReference is the price PercentAxis values are relative to. When zero, each series is rebased against
+	// the close of its first bar.
+	PercentReference float64
+	// VolumePane when true draws a volume histogram in a strip below the candles, sharing the x-axis. Bars use the
+	// up or down color of the matching candle. The pane is skipped when no series has a positive Volume.
+	VolumePane *bool
+	// VolumePaneHeight sets the fraction (0.0–1.0) of the plot height used by the volume pane (default 0.2).
+	VolumePaneHeight float64
+	// VolumeProfile when true draws a horizontal histogram along the right edge of the plot showing the volume of the
+	// first series traded at each price level, stacking up and down candle volume in their candle colors. Candle
+	// slots are reserved for the profile in addition to RightMarginBars, on time axes the profile is drawn behind the
+	// candles. The profile is skipped when the first series has no positive Volume. See ComputeVolumeProfile.
+	VolumeProfile *bool
+	// VolumeProfileBuckets sets the number of price levels in the volume profile (default 24).
+	VolumeProfileBuckets int
+	// VolumeProfileWidth sets the fraction (0.0–1.0) of the plot width used by the longest volume profile bar
+	// (default 0.2).
+	VolumeProfileWidth float64
+	// ATRPane draws the Average True Range of the first series as a line in a strip below the candles, above the
+	// volume pane when both are shown. See ComputeATR.
+	ATRPane *ATRPaneOption
+	// RSIPane draws the Relative Strength Index of the first series closes in a strip below the candles, with guide
+	// lines at 30 and 70. It is stacked above the ATR and volume panes. See ComputeRSI.
+	RSIPane *RSIPaneOption
+	// MACDPane draws the MACD and signal lines of the first series closes in a strip below the candles, over a
+	// histogram centered on zero. It is stacked below the RSI pane and above the ATR and volume panes. See ComputeMACD.
+	MACDPane *MACDPaneOption
+	// ShowPatternLegend when set to *true renders a key beside the plot listing each pattern enabled by the series
+	// PatternConfig with its glyph, so the short on-candle pattern labels can be decoded. Patterns excluded by the
+	// DirectionFilter are not listed. Space is reserved to the right of the plot for the key.
+	ShowPatternLegend *bool
+	// Overlays draws a moving average line over the close prices of each series.
+	Overlays []MovingAverage
+	// Annotations places text callouts on the chart, for example to mark news events or trade entries.
+	Annotations []ChartAnnotation
+	// Crosshair draws a static crosshair through a candle, with dashed guide lines and labels at the plot edges.
+	Crosshair *CrosshairOption
+	// HighlightGaps when true shades price gaps between consecutive candles, where the low is above the prior high
+	// (gap up) or the high is below the prior low (gap down). Gaps use a translucent up or down color.
+	HighlightGaps *bool
+	// GapMinPercent is the minimum gap size, as a percentage of the prior close, for a gap to be highlighted.
+	GapMinPercent float64
 	// ValueFormatter formats numeric values.
 	ValueFormatter ValueFormatter
+	// LayoutCallback when set is invoked after rendering with the position of each candle and the y-axis mapping,
+	// for drawing custom shapes aligned to the candles.
+	LayoutCallback CandlestickLayoutCallback
+
+	// ohlcBars draws each bar as a high-low line with open and close ticks instead of a candle, see OHLCBarChartOption.
+	ohlcBars bool
+}
+
+// ATRPaneOption configures the Average True Range pane drawn beneath the candles.
+type ATRPaneOption struct {
+	// Period is the number of bars averaged (default 14).
+	Period int
+	// Height sets the fraction (0.0–1.0) of the plot height used by the pane (default 0.2).
+	Height float64
+	// Color sets the line color, defaulting to the theme series color following any moving average overlays.
+	Color Color
+}
+
+// RSIPaneOption configures the Relative Strength Index pane drawn beneath the candles.
+type RSIPaneOption struct {
+	// Period is the number of close changes averaged (default 14).
+	Period int
+	// Height sets the fraction (0.0–1.0) of the plot height used by the pane (default 0.2).
+	Height float64
+	// Color sets the line color, defaulting to the theme series color following any overlays and the ATR line.
+	Color Color
+}
+
+// MACDPaneOption configures the Moving Average Convergence Divergence pane drawn beneath the candles.
+type MACDPaneOption struct {
+	// Fast is the period of the fast EMA (default 12).
+	Fast int
+	// Slow is the period of the slow EMA (default 26).
+	Slow int
+	// Signal is the period of the EMA of the MACD line used as the signal line (default 9).
+	Signal int
+	// Height sets the fraction (0.0–1.0) of the plot height used by the pane (default 0.2).
+	Height float64
+	// MACDColor sets the MACD line color, defaulting to the theme series color following the other indicator lines.
+	MACDColor Color
+	// SignalColor sets the signal line color, defaulting to the theme series color following the MACD line.
+	SignalColor Color
+}
+
+// IndexRange specifies a half-open range of data indexes, including Start and excluding End.
+type IndexRange struct {
+	// Start is the first index included in the range.
+	Start int
+	// End is the index after the last included index, values less than or equal to zero extend to the end of the data.
+	End int
+}
+
+// bounds returns the range clamped to the provided data length.
+func (r IndexRange) bounds(length int) (int, int) {
+	end := r.End
+	if end <= 0 || end > length {
+		end = length
+	}
+	start := min(max(r.Start, 0), end)
+	return start, end
+}
+
+// MAType identifies the moving average calculation used by a MovingAverage overlay.
+type MAType string
+
+const (
+	// MATypeSMA computes a simple moving average, the mean of the trailing Period closes.
+	MATypeSMA MAType = "sma"
+	// MATypeEMA computes an exponential moving average, weighting recent closes more heavily.
+	MATypeEMA MAType = "ema"
+)
+
+// MovingAverage configures a moving average line drawn over candlestick closes. The line starts once Period closes
+// are available.
+type MovingAverage struct {
+	// Period is the number of closes averaged, values less than 2 disable the overlay.
+	Period int
+	// Type selects the moving average calculation, MATypeSMA (default) or MATypeEMA.
+	Type MAType
+	// Color is the line color, defaults to the theme series color following the candlestick series.
+	Color Color
+}
+
+// transformSeriesList returns a copy of the series list with each series Transform applied to its data. The original
+// data is retained for pattern detection when configured to use the raw values.
+func transformSeriesList(seriesList CandlestickSeriesList) CandlestickSeriesList {
+	result := slices.Clone(seriesList)
+	for i := range result {
+		if result[i].Transform == CandlestickTransformHeikinAshi {
+			result[i].rawData = result[i].Data
+			result[i].Data = HeikinAshi(result[i].Data)
+		}
+	}
+	return result
+}
+
+// windowCandlestickOption limits the series data, axis values, and annotations to the VisibleRange. Data preceding
+// the window is added to each series PatternConfig.PreHistory so patterns at the window start are still detected.
+func windowCandlestickOption(opt *CandlestickChartOption) {
+	if opt.VisibleRange == nil {
+		return
+	}
+	length := max(getSeriesMaxDataCount(opt.SeriesList), len(opt.XAxis.Labels), len(opt.XAxis.TimeValues))
+	start, end := opt.VisibleRange.bounds(length)
+	if start == 0 && end == length {
+		return
+	}
+	window := func(n int) (int, int) {
+		return min(start, n), min(end, n)
+	}
+
+	seriesList := slices.Clone(opt.SeriesList)
+	for i := range seriesList {
+		series := &seriesList[i]
+		if series.PatternConfig != nil {
+			priorData := series.Data
+			if series.PatternConfig.DetectOnRawData && series.rawData != nil {
+				priorData = series.rawData
+			}
+			cfg := *series.PatternConfig
+			cfg.PreHistory = slices.Concat(cfg.PreHistory, priorData[:min(start, len(priorData))])
+			series.PatternConfig = &cfg
+		}
+		s, e := window(len(series.Data))
+		series.priorData = series.Data[:s]
+		series.Data = series.Data[s:e]
+		if series.rawData != nil {
+			s, e = window(len(series.rawData))
+			series.rawData = series.rawData[s:e]
+		}
+		if series.CandleMetadata != nil {
+			s, e = window(len(series.CandleMetadata))
+			series.CandleMetadata = series.CandleMetadata[s:e]
+		}
+	}
+	opt.SeriesList = seriesList
+
+	if opt.XAxis.Labels != nil {
+		s, e := window(len(opt.XAxis.Labels))
+		opt.XAxis.Labels = opt.XAxis.Labels[s:e]
+	}
+	if opt.XAxis.TimeValues != nil {
+		s, e := window(len(opt.XAxis.TimeValues))
+		opt.XAxis.TimeValues = opt.XAxis.TimeValues[s:e]
+	}
+
+	annotations := make([]ChartAnnotation, 0, len(opt.Annotations))
+	for _, annotation := range opt.Annotations {
+		if annotation.Time.IsZero() {
+			if annotation.Index < start || annotation.Index >= end {
+				continue
+			}
+			annotation.Index -= start
+		}
+		annotations = append(annotations, annotation)
+	}
+	opt.Annotations = annotations
+
+	if opt.Crosshair != nil {
+		if opt.Crosshair.Index < start || opt.Crosshair.Index >= end {
+			opt.Crosshair = nil
+		} else {
+			crosshair := *opt.Crosshair
+			crosshair.Index -= start
+			opt.Crosshair = &crosshair
+		}
+	}
+}
+
+// detectSeriesPatterns returns a copy of the series list with the patterns enabled by each series PatternConfig
+// detected. Detection runs before PercentAxis rebasing so that price tolerances apply to the original prices.
+func detectSeriesPatterns(seriesList CandlestickSeriesList) CandlestickSeriesList {
+	result := slices.Clone(seriesList)
+	for i := range result {
+		series := &result[i]
+		if series.PatternConfig == nil {
+			continue
+		}
+		patternData := series.Data
+		if series.PatternConfig.DetectOnRawData && series.rawData != nil {
+			patternData = series.rawData
+		}
+		series.patterns = scanForCandlestickPatterns(patternData, *series.PatternConfig)
+	}
+	return result
+}
+
+// percentChangeSeriesList returns a copy of the series list with all OHLC values converted to the percentage change
+// from the reference price, or from the first valid close of each series when reference is zero. The data preceding
+// the VisibleRange is rebased against the same price so overlays see consistent values. Detected patterns keep the
+// results found on the original prices, only their anchors are converted.
+func percentChangeSeriesList(seriesList CandlestickSeriesList, reference float64) CandlestickSeriesList {
+	result := slices.Clone(seriesList)
+	for i := range result {
+		series := &result[i]
+		base := reference
+		if base == 0 {
+			for _, ohlc := range series.Data {
+				if isValidExtent(ohlc.Close) && ohlc.Close != 0 {
+					base = ohlc.Close
+					break
+				}
+			}
+		}
+		series.Data = percentChangeOHLC(series.Data, base)
+		series.priorData = percentChangeOHLC(series.priorData, base)
+		series.percentBase = base
+		series.patterns = percentChangePatterns(series.patterns, base)
+	}
+	return result
+}
+
+// percentChangePatterns returns a copy of the detected patterns with the anchor prices converted to the percentage
+// change from base.
+func percentChangePatterns(patternMap map[int][]PatternDetectionResult, base float64) map[int][]PatternDetectionResult {
+	if patternMap == nil {
+		return nil
+	}
+	result := make(map[int][]PatternDetectionResult, len(patternMap))
+	for index, patterns := range patternMap {
+		patterns = slices.Clone(patterns)
+		for i := range patterns {
+			patterns[i].Anchor.Price = percentChange(patterns[i].Anchor.Price, base)
+		}
+		result[index] = patterns
+	}
+	return result
+}
+
+// percentChangeOHLC returns a copy of the data with the prices converted to the percentage change from base.
+func percentChangeOHLC(data []OHLCData, base float64) []OHLCData {
+	if data == nil {
+		return nil
+	}
+	result := make([]OHLCData, len(data))
+	for i, ohlc := range data {
+		result[i] = OHLCData{
+			Open:   percentChange(ohlc.Open, base),
+			High:   percentChange(ohlc.High, base),
+			Low:    percentChange(ohlc.Low, base),
+			Close:  percentChange(ohlc.Close, base),
+			Volume: ohlc.Volume,
+		}
+	}
+	return result
+}
+
+// percentChange returns the percentage difference of value from base, preserving null values.
+func percentChange(value, base float64) float64 {
+	if !isValidExtent(value) || base == 0 {
+		return GetNullValue()
+	}
+	return (value/base - 1) * 100
+}
+
+// gapFillAlpha is the opacity of highlighted price gaps.
+const gapFillAlpha = 64
+
+// priceGap returns the price range left unfilled between the prior and current candle, and if the gap is upward.
+// Gaps smaller than minPercent of the prior close are ignored. When the candles were rebased by PercentAxis,
+// percentBase is the price they are relative to so the threshold is measured against the original prices.
+func priceGap(prev, current OHLCData, minPercent, percentBase float64) (float64, float64, bool, bool) {
+	var low, high float64
+	var up bool
+	if current.Low > prev.High {
+		low, high, up = prev.High, current.Low, true
+	} else if current.High < prev.Low {
+		low, high = current.High, prev.Low
+	} else {
+		return 0, 0, false, false
+	}
+	prevClose := prev.Close
+	if percentBase != 0 {
+		// rebased values are (price/base - 1) * 100, offsetting the close by 100 scales it like the gap
+		prevClose += 100
+	}
+	if minPercent > 0 && (prevClose == 0 || (high-low)/math.Abs(prevClose)*100 < minPercent) {
+		return 0, 0, false, false
+	}
+	return low, high, up, true
+}
+
+// candleAttributes returns the metadata for the candle at the index as data-* attributes.
+func candleAttributes(metadata []map[string]string, index int) map[string]string {
+	if index >= len(metadata) || len(metadata[index]) == 0 {
+		return nil
+	}
+	attrs := make(map[string]string, len(metadata[index]))
+	for k, v := range metadata[index] {
+		if !strings.HasPrefix(k, "data-") {
+			k = "data-" + k
+		}
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// maxSeriesVolume returns the largest volume across all valid bars in the series list.
+func maxSeriesVolume(seriesList CandlestickSeriesList) float64 {
+	var result float64
+	for _, series := range seriesList {
+		for _, ohlc := range series.Data {
+			if validateOHLCData(ohlc) && isValidExtent(ohlc.Volume) && ohlc.Volume > result {
+				result = ohlc.Volume
+			}
+		}
+	}
+	return result
+}
+
+// formatPercentChange formats a percentage change value for the y-axis.
+func formatPercentChange(value float64) string {
+	return FormatValueHumanizeShort(value, 2, false) + "%"
 }
 
 // NewCandlestickOptionWithData creates a CandlestickChartOption from OHLC data slices.
@@ -94,7 +492,7 @@ func createPatternAwareLabelFormatter(originalSeries *CandlestickSeries, seriesI
 		if originalSeries.PatternConfig.PatternFormatter != nil {
 			patternText, patternStyle = originalSeries.PatternConfig.PatternFormatter(patterns, originalSeries.Name, val)
 		} else {
-			patternText, patternStyle = formatPatternsDefault(patterns, seriesIndex, theme)
+			patternText, patternStyle = formatPatternsDefault(patterns, seriesIndex, theme, *originalSeries.PatternConfig)
 		}
 		if patternText != "" {
 			// either no user input, or configured to replace and we have a matching pattern
@@ -113,6 +511,138 @@ func createPatternAwareLabelFormatter(originalSeries *CandlestickSeries, seriesI
 	}
 }
 
+// patternLabelClearance is the extra space between the candle and a label placed above or below it, clearing the
+// label background padding.
+const patternLabelClearance = 4
+
+// patternLabelPlacement returns where the label of a candle with the provided patterns is placed, resolving
+// PatternLabelAuto from the pattern directions. Candles without patterns use PatternLabelBeside.
+func patternLabelPlacement(config *CandlestickPatternConfig, patterns []PatternDetectionResult) PatternLabelPlacement {
+	if config == nil || len(patterns) == 0 {
+		return PatternLabelBeside
+	} else if config.LabelPlacement != PatternLabelAuto {
+		return config.LabelPlacement
+	}
+	var bullishCount, bearishCount int
+	for _, pattern := range patterns {
+		switch patternDetectors[pattern.PatternType].direction {
+		case patternDirectionBullish:
+			bullishCount++
+		case patternDirectionBearish:
+			bearishCount++
+		}
+	}
+	if bullishCount > bearishCount {
+		return PatternLabelBelow
+	}
+	return PatternLabelAbove
+}
+
+// upDownPalette wraps a ColorPalette, exchanging the up and down series colors when swap is set and then replacing
+// the up and down colors which are set. The With* and Invert methods rewrap the derived palette so these adjustments
+// are kept through further customization.
+type upDownPalette struct {
+	ColorPalette
+	swap     bool
+	up, down Color
+}
+
+func (u upDownPalette) GetSeriesUpDownColors(index int) (Color, Color) {
+	up, down := u.ColorPalette.GetSeriesUpDownColors(index)
+	if u.swap {
+		up, down = down, up
+	}
+	if !u.up.IsZero() {
+		up = u.up
+	}
+	if !u.down.IsZero() {
+		down = u.down
+	}
+	return up, down
+}
+
+// rewrap applies the same up and down adjustments to a palette derived from the wrapped one.
+func (u upDownPalette) rewrap(cp ColorPalette) ColorPalette {
+	u.ColorPalette = cp
+	return u
+}
+
+func (u upDownPalette) WithXAxisColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithXAxisColor(c))
+}
+
+func (u upDownPalette) WithYAxisColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithYAxisColor(c))
+}
+
+func (u upDownPalette) WithYAxisSeriesColor(series int) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithYAxisSeriesColor(series))
+}
+
+func (u upDownPalette) WithTitleTextColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithTitleTextColor(c))
+}
+
+func (u upDownPalette) WithMarkTextColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithMarkTextColor(c))
+}
+
+func (u upDownPalette) WithLabelTextColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithLabelTextColor(c))
+}
+
+func (u upDownPalette) WithLegendTextColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithLegendTextColor(c))
+}
+
+func (u upDownPalette) WithTextColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithTextColor(c))
+}
+
+func (u upDownPalette) WithAxisSplitLineColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithAxisSplitLineColor(c))
+}
+
+func (u upDownPalette) WithXAxisTextColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithXAxisTextColor(c))
+}
+
+func (u upDownPalette) WithYAxisTextColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithYAxisTextColor(c))
+}
+
+func (u upDownPalette) WithSeriesColors(colors []Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithSeriesColors(colors))
+}
+
+func (u upDownPalette) WithSeriesTrendColors(colors []Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithSeriesTrendColors(colors))
+}
+
+func (u upDownPalette) WithBackgroundColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithBackgroundColor(c))
+}
+
+func (u upDownPalette) WithTitleBorderColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithTitleBorderColor(c))
+}
+
+func (u upDownPalette) WithLegendBorderColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithLegendBorderColor(c))
+}
+
+func (u upDownPalette) WithCandleWickColor(c Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithCandleWickColor(c))
+}
+
+func (u upDownPalette) WithSeriesUpDownColors(colors [][2]Color) ColorPalette {
+	return u.rewrap(u.ColorPalette.WithSeriesUpDownColors(colors))
+}
+
+func (u upDownPalette) Invert() ColorPalette {
+	return u.rewrap(u.ColorPalette.Invert())
+}
+
 func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error) {
 	p := k.p
 	opt := k.opt
@@ -142,13 +672,28 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 	} else if candleWidthRatio > 1 {
 		candleWidthRatio = 1
 	}
-	groupCandleWidth := int(float64(width) * candleWidthRatio / float64(maxDataCount))
+	slotCount := maxDataCount + max(opt.RightMarginBars, 0) + k.profileSlots
+	groupCandleWidth := int(float64(width) * candleWidthRatio / float64(slotCount))
+	// time axes position candles proportionally, sized to the closest spacing between candles
+	var timePositions []int
+	var timeSection int
+	if result.categoryAxisRange.isTimeAxis() {
+		timePositions = result.categoryAxisRange.timeDataPositions(width)
+		timeSection = timeSectionWidth(timePositions, width)
+		groupCandleWidth = int(float64(timeSection) * candleWidthRatio)
+	}
 	if groupCandleWidth < 1 {
 		groupCandleWidth = 1
 	}
 
 	// Calculate candleWidthPerSeries for body rendering
 	candleWidthPerSeries := groupCandleWidth / seriesCount
+	if opt.CandleMaxWidth > 0 {
+		candleWidthPerSeries = min(candleWidthPerSeries, opt.CandleMaxWidth)
+	}
+	if opt.CandleMinWidth > 0 {
+		candleWidthPerSeries = max(candleWidthPerSeries, opt.CandleMinWidth)
+	}
 	if candleWidthPerSeries < 1 {
 		candleWidthPerSeries = 1
 	}
@@ -156,8 +701,72 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 	// Use autoDivide for positioning
 	divideValues := result.categoryAxisRange.autoDivide()
 
+	// indicator panes occupy the reserved strip at the bottom of the series painter, stacked from the top as RSI,
+	// MACD, ATR, then volume, with the last shown pane taking any rounding remainder
+	var maxVolume float64
+	var volumePaneHeight int
+	var atrPane, rsiPane, macdPane Box
+	if result.bottomReserveHeight > 0 {
+		reserves := []float64{k.rsiReserve, k.macdReserve, k.atrReserve, k.volumeReserve}
+		var reserveSum float64
+		lastPane := -1
+		for i, r := range reserves {
+			if r > 0 {
+				reserveSum += r
+				lastPane = i
+			}
+		}
+		paneTop := seriesPainter.Height() - result.bottomReserveHeight
+		remaining := result.bottomReserveHeight
+		for i, r := range reserves {
+			if r <= 0 {
+				continue
+			}
+			paneHeight := remaining
+			if i != lastPane {
+				paneHeight = int(float64(result.bottomReserveHeight) * r / reserveSum)
+			}
+			remaining -= paneHeight
+			seriesPainter.LineStroke([]Point{
+				{X: 0, Y: paneTop},
+				{X: width, Y: paneTop},
+			}, opt.Theme.GetAxisSplitLineColor(), 1)
+			pane := Box{
+				Left: 0, Right: width, IsSet: true,
+				Top:    paneTop + paneHeight/10, // leave a gap below the divider
+				Bottom: paneTop + paneHeight*9/10,
+			}
+			switch i {
+			case 0:
+				rsiPane = pane
+			case 1:
+				macdPane = pane
+			case 2:
+				atrPane = pane
+			case 3:
+				maxVolume = maxSeriesVolume(seriesList)
+				volumePaneHeight = paneHeight * 9 / 10 // leave a gap below the candles
+			}
+			paneTop += paneHeight
+		}
+	}
+
+	if k.profileSlots > 0 {
+		// drawn first so candles overlapping the profile on time axes remain visible
+		series := seriesList.getSeries(0).(*CandlestickSeries)
+		k.renderVolumeProfile(seriesPainter, series, result.valueAxisRanges[series.YAxisIndex])
+	}
+
 	// Center positions for each series index
 	seriesCenterValues := make([][]int, seriesList.len())
+	var layout CandlestickLayout
+	if opt.LayoutCallback != nil {
+		layout = CandlestickLayout{
+			PlotBox: seriesPainter.box,
+			Candles: make([][]CandleGeometry, seriesList.len()),
+			yRanges: result.valueAxisRanges,
+		}
+	}
 
 	// render list must start with the markPointPainter, as it can influence label painters (if enabled)
 	markPointPainter := newMarkPointPainter(seriesPainter)
@@ -180,15 +789,11 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 
 		// Bounds check for Y axis index to prevent panic
 		if series.YAxisIndex >= len(result.valueAxisRanges) {
-			return BoxZero, errors.New("candlestick series YAxisIndex out of bounds")
+			return BoxZero, fmt.Errorf("candlestick %w", ErrInvalidYAxisIndex)
 		}
 		yRange := result.valueAxisRanges[series.YAxisIndex]
 
-		// pre-compute patterns for this series
-		var patternMap map[int][]PatternDetectionResult
-		if series.PatternConfig != nil {
-			patternMap = scanForCandlestickPatterns(series.Data, *series.PatternConfig)
-		}
+		patternMap := series.patterns
 
 		// Create labelPainter only when labels are enabled or patterns were detected
 		var labelPainter *seriesLabelPainter
@@ -198,6 +803,7 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 				labelCopy.LabelFormatter = createPatternAwareLabelFormatter(series, seriesIndex, opt.Theme, patternMap)
 				labelCopy.Show = Ptr(true) // Enable labels when patterns are detected, even if user labels are disabled
 				labelPainter = newSeriesLabelPainter(seriesPainter, seriesNames, labelCopy, opt.Theme, opt.Padding.Right)
+				labelPainter.stackOverlapping = true // keep nearby pattern labels legible
 			} else {
 				labelPainter = newSeriesLabelPainter(seriesPainter, seriesNames, series.Label, opt.Theme, opt.Padding.Right)
 			}
@@ -217,13 +823,24 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 		seriesHighPoints[seriesIndex] = make([]Point, len(series.Data))
 		seriesLowPoints[seriesIndex] = make([]Point, len(series.Data))
 		seriesCenterValues[seriesIndex] = make([]int, len(series.Data))
+		if opt.LayoutCallback != nil {
+			layout.Candles[seriesIndex] = make([]CandleGeometry, len(series.Data))
+		}
+		highlightGaps := flagIs(true, opt.HighlightGaps)
+		prevIndex := -1 // index of the prior valid candle, used for gap highlighting
 		// Render each candlestick in this series
 		for j, ohlc := range series.Data {
-			if j >= len(divideValues)-1 {
+			var sectionStart, sectionWidth int
+			if timePositions != nil {
+				sectionStart = timePositions[j] - timeSection/2
+				sectionWidth = timeSection
+			} else if j < len(divideValues)-1 {
+				// center candlesticks in each time period section
+				sectionStart = divideValues[j]
+				sectionWidth = divideValues[j+1] - divideValues[j]
+			} else {
 				continue
 			}
-			// center candlesticks in each time period section
-			sectionWidth := divideValues[j+1] - divideValues[j]
 
 			// Calculate margins and positioning exactly like bar charts
 			var groupMargin, candleMargin, candleWidth int
@@ -242,11 +859,11 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 			var centerX int
 			if seriesList.len() == 1 {
 				// Single series: center in the time period section
-				centerX = divideValues[j] + sectionWidth/2
+				centerX = sectionStart + sectionWidth/2
 			} else {
 				// Multiple series: use exact bar chart positioning formula
-				// x = divideValues[j] + margin + index*(barWidth+barMargin)
-				x := divideValues[j] + groupMargin + seriesIndex*(candleWidth+candleMargin)
+				// x = sectionStart + margin + index*(barWidth+barMargin)
+				x := sectionStart + groupMargin + seriesIndex*(candleWidth+candleMargin)
 				centerX = x + candleWidth/2
 			}
 			seriesCenterValues[seriesIndex][j] = centerX
@@ -271,12 +888,28 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 
 			bodyTop := min(openY, closeY)
 			bodyBottom := max(openY, closeY)
+			if opt.LayoutCallback != nil {
+				left, top := seriesPainter.box.Left, seriesPainter.box.Top
+				layout.Candles[seriesIndex][j] = CandleGeometry{
+					Valid:   true,
+					CenterX: left + centerX,
+					Body: Box{
+						Left: left + leftX, Top: top + bodyTop, Right: left + rightX, Bottom: top + bodyBottom, IsSet: true,
+					},
+					High: Point{X: left + centerX, Y: top + highY},
+					Low:  Point{X: left + centerX, Y: top + lowY},
+				}
+			}
 
 			// Determine colors and style
 			isBullish := ohlc.Close >= ohlc.Open
 			candleStyle := series.CandleStyle
 			if candleStyle == "" {
-				candleStyle = CandleStyleFilled
+				if flagIs(true, opt.HollowUpCandles) {
+					candleStyle = CandleStyleTraditional
+				} else {
+					candleStyle = CandleStyleFilled
+				}
 			}
 
 			var bodyColor, wickColor Color
@@ -286,79 +919,118 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 				bodyColor = downColor
 			}
 
-			wickColor = opt.Theme.GetCandleWickColor()
+			if isBullish && !opt.UpWickColor.IsZero() {
+				wickColor = opt.UpWickColor
+			} else if !isBullish && !opt.DownWickColor.IsZero() {
+				wickColor = opt.DownWickColor
+			} else {
+				wickColor = opt.Theme.GetCandleWickColor()
+			}
 			if wickColor.IsZero() {
 				wickColor = bodyColor
 			}
 
-			// Draw high-low wick (if enabled)
-			showWicks := !flagIs(false, opt.ShowWicks)
-			if series.ShowWicks != nil {
-				showWicks = *series.ShowWicks
-			}
-			wickWidth := opt.WickWidth
-			if wickWidth <= 0 {
-				wickWidth = 1.0
+			if highlightGaps && prevIndex >= 0 {
+				prev := series.Data[prevIndex]
+				if gapLow, gapHigh, gapUp, ok := priceGap(prev, ohlc, opt.GapMinPercent, series.percentBase); ok {
+					gapColor := downColor
+					if gapUp {
+						gapColor = upColor
+					}
+					gapColor = gapColor.WithAlpha(gapFillAlpha)
+					seriesPainter.FilledRect(seriesCenterValues[seriesIndex][prevIndex], yRange.getRestHeight(gapHigh),
+						centerX, yRange.getRestHeight(gapLow), gapColor, gapColor, 0.0)
+				}
 			}
-			if showWicks {
-				if highY < bodyTop {
+			prevIndex = j
+
+			seriesPainter.withElementMetadata(candleAttributes(series.CandleMetadata, j), "", func() {
+				// Draw high-low wick (if enabled)
+				showWicks := !flagIs(false, opt.ShowWicks)
+				if series.ShowWicks != nil {
+					showWicks = *series.ShowWicks
+				}
+				wickWidth := opt.WickWidth
+				if wickWidth <= 0 {
+					wickWidth = 1.0
+				}
+				if opt.ohlcBars {
+					drawOHLCBar(seriesPainter, leftX, centerX, rightX, highY, lowY, openY, closeY, bodyColor, wickWidth)
+					return
+				}
+				flatBar := flagIs(true, opt.FlatBarMarker) && isFlatOHLC(ohlc)
+				if showWicks && !flatBar {
+					if highY < bodyTop {
+						seriesPainter.LineStroke([]Point{
+							{X: centerX, Y: highY},
+							{X: centerX, Y: bodyTop},
+						}, wickColor, wickWidth)
+					}
+					if lowY > bodyBottom {
+						seriesPainter.LineStroke([]Point{
+							{X: centerX, Y: bodyBottom},
+							{X: centerX, Y: lowY},
+						}, wickColor, wickWidth)
+					}
+
+					// Calculate cap width (based on series candle width)
+					capWidth := candleWidthPerSeries / 4
+					if capWidth < 1 {
+						capWidth = 1
+					}
+
+					// Draw horizontal cap at high point
 					seriesPainter.LineStroke([]Point{
-						{X: centerX, Y: highY},
-						{X: centerX, Y: bodyTop},
+						{X: centerX - capWidth, Y: highY},
+						{X: centerX + capWidth, Y: highY},
 					}, wickColor, wickWidth)
-				}
-				if lowY > bodyBottom {
+
+					// Draw horizontal cap at low point
 					seriesPainter.LineStroke([]Point{
-						{X: centerX, Y: bodyBottom},
-						{X: centerX, Y: lowY},
+						{X: centerX - capWidth, Y: lowY},
+						{X: centerX + capWidth, Y: lowY},
 					}, wickColor, wickWidth)
 				}
 
-				// Calculate cap width (based on series candle width)
-				capWidth := candleWidthPerSeries / 4
-				if capWidth < 1 {
-					capWidth = 1
-				}
+				// Draw open-close body based on style
+				if flatBar { // wick and body have no height, draw a visible tick instead
+					halfWidth := max(candleWidth/2, 2)
+					seriesPainter.LineStroke([]Point{
+						{X: centerX - halfWidth, Y: closeY},
+						{X: centerX + halfWidth, Y: closeY},
+					}, bodyColor, max(wickWidth, 2.0))
+				} else if bodyTop == bodyBottom { // Doji (open == close)
+					// Draw thin line instead of rectangle
+					seriesPainter.LineStroke([]Point{
+						{X: leftX, Y: bodyTop},
+						{X: rightX, Y: bodyTop},
+					}, bodyColor, 1.0)
+				} else {
+					switch candleStyle {
+					case CandleStyleFilled:
+						seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
+							bodyColor, bodyColor, 0.0)
 
-				// Draw horizontal cap at high point
-				seriesPainter.LineStroke([]Point{
-					{X: centerX - capWidth, Y: highY},
-					{X: centerX + capWidth, Y: highY},
-				}, wickColor, wickWidth)
-
-				// Draw horizontal cap at low point
-				seriesPainter.LineStroke([]Point{
-					{X: centerX - capWidth, Y: lowY},
-					{X: centerX + capWidth, Y: lowY},
-				}, wickColor, wickWidth)
-			}
-
-			// Draw open-close body based on style
-			if bodyTop == bodyBottom { // Doji (open == close)
-				// Draw thin line instead of rectangle
-				seriesPainter.LineStroke([]Point{
-					{X: leftX, Y: bodyTop},
-					{X: rightX, Y: bodyTop},
-				}, bodyColor, 1.0)
-			} else {
-				switch candleStyle {
-				case CandleStyleFilled:
-					seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
-						bodyColor, bodyColor, 0.0)
+					case CandleStyleTraditional:
+						if isBullish { // Hollow body for bullish
+							seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
+								ColorTransparent, bodyColor, wickWidth)
+						} else { // Filled body for bearish
+							seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
+								bodyColor, bodyColor, 0.0)
+						}
 
-				case CandleStyleTraditional:
-					if isBullish { // Hollow body for bullish
+					case CandleStyleOutline:
 						seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
 							ColorTransparent, bodyColor, wickWidth)
-					} else { // Filled body for bearish
-						seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
-							bodyColor, bodyColor, 0.0)
 					}
-
-				case CandleStyleOutline:
-					seriesPainter.FilledRect(leftX, bodyTop, rightX, bodyBottom,
-						ColorTransparent, bodyColor, wickWidth)
 				}
+			})
+
+			if volumePaneHeight > 0 && ohlc.Volume > 0 {
+				barHeight := max(int(ohlc.Volume/maxVolume*float64(volumePaneHeight)), 1)
+				seriesPainter.FilledRect(leftX, seriesPainter.Height()-barHeight, rightX, seriesPainter.Height(),
+					bodyColor, bodyColor, 0.0)
 			}
 
 			// Store points for all OHLC values for mark points
@@ -369,7 +1041,7 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 
 			// Add label if enabled (pattern logic is now handled in the label formatter)
 			if labelPainter != nil {
-				labelPainter.Add(labelValue{
+				label := labelValue{
 					index:     j, // Data point index (candlestick position), not series index
 					dataIndex: j,
 					value:     ohlc.Close, // Use close price for label
@@ -377,11 +1049,42 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 					y:         closeY,
 					fontStyle: series.Label.FontStyle,
 					offset:    series.Label.Offset,
-				})
+				}
+				switch patternLabelPlacement(series.PatternConfig, patternMap[j]) {
+				case PatternLabelAbove:
+					label.vertical = true
+					label.y = highY - patternLabelClearance
+				case PatternLabelBelow:
+					label.vertical, label.below = true, true
+					label.y = lowY + patternLabelClearance
+				}
+				labelPainter.Add(label)
 			}
 		}
 	}
 
+	// moving average overlays are drawn over the candles
+	for seriesIndex := 0; seriesIndex < seriesList.len(); seriesIndex++ {
+		series := seriesList.getSeries(seriesIndex).(*CandlestickSeries)
+		if series.YAxisIndex >= len(result.valueAxisRanges) {
+			continue
+		}
+		k.renderOverlays(seriesPainter, series, seriesCenterValues[seriesIndex],
+			result.valueAxisRanges[series.YAxisIndex], seriesList.len())
+	}
+	if atrPane.IsSet {
+		k.renderATRPane(seriesPainter, seriesList.getSeries(0).(*CandlestickSeries), seriesCenterValues[0], atrPane,
+			seriesList.len())
+	}
+	if rsiPane.IsSet {
+		k.renderRSIPane(seriesPainter, seriesList.getSeries(0).(*CandlestickSeries), seriesCenterValues[0], rsiPane,
+			seriesList.len())
+	}
+	if macdPane.IsSet {
+		k.renderMACDPane(seriesPainter, seriesList.getSeries(0).(*CandlestickSeries), seriesCenterValues[0], macdPane,
+			candleWidthPerSeries, seriesList.len())
+	}
+
 	// Handle mark lines, mark points, and trend lines for each series and OHLC component
 	for seriesIndex := 0; seriesIndex < seriesList.len(); seriesIndex++ {
 		series := seriesList.getSeries(seriesIndex).(*CandlestickSeries)
@@ -448,7 +1151,7 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 					fillColor:      seriesColor,
 					fontColor:      opt.Theme.GetMarkTextColor(),
 					strokeColor:    seriesColor,
-					font:           getPreferredFont(series.Label.FontStyle.Font),
+					font:           series.Label.FontStyle.Font,
 					marklines:      seriesMarks,
 					seriesValues:   values,
 					axisRange:      yRange,
@@ -465,7 +1168,7 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 					series.Label.ValueFormatter, opt.ValueFormatter)
 				markPointPainter.add(markPointRenderOption{
 					fillColor:          seriesColor,
-					font:               getPreferredFont(series.Label.FontStyle.Font),
+					font:               series.Label.FontStyle.Font,
 					symbolSize:         component.markPoint.SymbolSize,
 					points:             component.points,
 					markpoints:         seriesMarks,
@@ -492,34 +1195,595 @@ func (k *candlestickChart) renderChart(result *defaultRenderResult) (Box, error)
 		}
 	}
 
+	if crosshair := k.crosshairPainter(seriesPainter, result, seriesCenterValues); crosshair != nil {
+		rendererList = append(rendererList, crosshair)
+	}
+	if len(opt.Annotations) > 0 {
+		rendererList = append(rendererList, k.annotationPainter(seriesPainter, result,
+			seriesHighPoints, seriesLowPoints, seriesCenterValues))
+	}
+
 	if err := doRender(rendererList...); err != nil {
 		return BoxZero, err
 	}
+	if opt.LayoutCallback != nil {
+		opt.LayoutCallback(layout)
+	}
 	return p.box, nil
 }
 
+// annotationPainter resolves the chart annotations against the rendered candle positions.
+func (k *candlestickChart) annotationPainter(seriesPainter *Painter, result *defaultRenderResult,
+	highPoints, lowPoints [][]Point, centerValues [][]int) *annotationPainter {
+	opt := k.opt
+	painter := newAnnotationPainter(seriesPainter)
+	timeAxis := result.categoryAxisRange.isTimeAxis()
+	for _, annotation := range opt.Annotations {
+		seriesIndex := annotation.SeriesIndex
+		if seriesIndex < 0 || seriesIndex >= len(centerValues) {
+			continue
+		}
+		series := opt.SeriesList[seriesIndex]
+		index := annotation.Index
+		var x int
+		if timeAxis && !annotation.Time.IsZero() {
+			index = closestTimeIndex(result.categoryAxisRange.timeValues, annotation.Time)
+			x = result.categoryAxisRange.timePosition(annotation.Time, seriesPainter.Width())
+		} else if index >= 0 && index < len(centerValues[seriesIndex]) {
+			x = centerValues[seriesIndex][index]
+		}
+		if index < 0 || index >= len(centerValues[seriesIndex]) {
+			continue
+		}
+
+		below := annotation.Position == PositionBottom
+		y := highPoints[seriesIndex][index].Y
+		if below {
+			y = lowPoints[seriesIndex][index].Y
+		}
+		if annotation.Value != nil {
+			y = result.valueAxisRanges[series.YAxisIndex].getRestHeight(*annotation.Value)
+		}
+
+		seriesThemeIndex := seriesIndex
+		if series.absThemeIndex != nil {
+			seriesThemeIndex = *series.absThemeIndex
+		}
+		painter.add(annotationRenderOption{
+			point: Point{X: x, Y: y},
+			text:  annotation.Text,
+			below: below,
+			style: mergeLabelStyle(annotation.Style,
+				defaultAnnotationStyle(opt.Theme, opt.Theme.GetSeriesColor(seriesThemeIndex))),
+		})
+	}
+	return painter
+}
+
+// crosshairPainter resolves the crosshair against the rendered candle positions, returning nil when no crosshair is
+// configured or the candle is not rendered.
+func (k *candlestickChart) crosshairPainter(seriesPainter *Painter, result *defaultRenderResult,
+	centerValues [][]int) *crosshairPainter {
+	opt := k.opt
+	crosshair := opt.Crosshair
+	if crosshair == nil || crosshair.SeriesIndex < 0 || crosshair.SeriesIndex >= len(centerValues) ||
+		crosshair.Index < 0 || crosshair.Index >= len(centerValues[crosshair.SeriesIndex]) {
+		return nil
+	}
+	series := opt.SeriesList[crosshair.SeriesIndex]
+	var value float64
+	if crosshair.Value != nil {
+		value = *crosshair.Value
+	} else if crosshair.Index < len(series.Data) && validateOHLCClose(series.Data[crosshair.Index]) {
+		value = series.Data[crosshair.Index].Close
+	} else {
+		return nil
+	}
+
+	strokeColor := crosshair.StrokeColor
+	if strokeColor.IsZero() {
+		strokeColor = opt.Theme.GetXAxisStrokeColor()
+	}
+	strokeWidth := crosshair.StrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = 1
+	}
+	dashArray := crosshair.StrokeDashArray
+	if len(dashArray) == 0 {
+		dashArray = []float64{4, 2}
+	}
+	valueFormatter := getPreferredValueFormatter(crosshair.ValueFormatter, opt.ValueFormatter)
+	return newCrosshairPainter(seriesPainter, crosshairRenderOption{
+		point: Point{
+			X: centerValues[crosshair.SeriesIndex][crosshair.Index],
+			Y: result.valueAxisRanges[series.YAxisIndex].getRestHeight(value),
+		},
+		xText:           crosshairXText(opt.XAxis, crosshair.Index),
+		yText:           valueFormatter(value),
+		strokeColor:     strokeColor,
+		strokeWidth:     strokeWidth,
+		strokeDashArray: dashArray,
+		style: mergeLabelStyle(crosshair.LabelStyle, LabelStyle{
+			FontStyle:       FontStyle{FontColor: opt.Theme.GetBackgroundColor(), FontSize: 10},
+			BackgroundColor: strokeColor,
+			CornerRadius:    2,
+		}),
+	})
+}
+
+// renderATRPane draws the ATR line of the series scaled from zero to the maximum visible ATR within the pane box,
+// labeled with the period and latest value.
+func (k *candlestickChart) renderATRPane(p *Painter, series *CandlestickSeries, xValues []int, pane Box,
+	seriesCount int) {
+	period := k.opt.ATRPane.Period
+	if period <= 0 {
+		period = 14
+	}
+	// bars before the visible window are included so the average is warmed up at the first visible candle
+	values := ComputeATR(slices.Concat(series.priorData, series.Data), period)[len(series.priorData):]
+	var maxATR float64
+	latest := GetNullValue()
+	for _, v := range values {
+		if isValidExtent(v) {
+			maxATR = max(maxATR, v)
+			latest = v
+		}
+	}
+	if maxATR <= 0 {
+		return
+	}
+	color := k.opt.ATRPane.Color
+	if color.IsZero() {
+		color = k.opt.Theme.GetSeriesColor(seriesCount + len(k.opt.Overlays))
+	}
+
+	points := make([]Point, 0, len(values))
+	for i, v := range values {
+		if i >= len(xValues) {
+			break
+		} else if isValidExtent(v) {
+			points = append(points, Point{X: xValues[i], Y: pane.Bottom - int(v/maxATR*float64(pane.Height()))})
+		} else if len(points) > 0 {
+			points = append(points, Point{X: xValues[i], Y: math.MaxInt32}) // break the line over null values
+		}
+	}
+	p.LineStroke(points, color, defaultStrokeWidth)
+
+	fontStyle := FontStyle{FontColor: color, FontSize: defaultLabelFontSize}
+	text := "ATR(" + strconv.Itoa(period) + ") " + getPreferredValueFormatter(k.opt.ValueFormatter)(latest)
+	textBox := p.MeasureText(text, 0, fontStyle)
+	p.Text(text, pane.Left+2, pane.Top+textBox.Height(), 0, fontStyle)
+}
+
+// renderRSIPane draws the RSI line of the series closes on a fixed 0-100 scale within the pane box, with dashed
+// oversold and overbought guide lines at 30 and 70, labeled with the period and latest value.
+func (k *candlestickChart) renderRSIPane(p *Painter, series *CandlestickSeries, xValues []int, pane Box,
+	seriesCount int) {
+	period := k.opt.RSIPane.Period
+	if period <= 0 {
+		period = 14
+	}
+	valueY := func(v float64) int {
+		return pane.Bottom - int(v/100*float64(pane.Height()))
+	}
+	for _, guide := range []float64{30, 70} {
+		p.DashedLineStroke([]Point{{X: pane.Left, Y: valueY(guide)}, {X: pane.Right, Y: valueY(guide)}},
+			k.opt.Theme.GetYAxisStrokeColor(), 1, []float64{4, 2})
+	}
+
+	values := ComputeRSI(warmupCloses(series), period)[len(series.priorData):]
+	color := k.opt.RSIPane.Color
+	if color.IsZero() {
+		colorIndex := seriesCount + len(k.opt.Overlays)
+		if k.opt.ATRPane != nil {
+			colorIndex++
+		}
+		color = k.opt.Theme.GetSeriesColor(colorIndex)
+	}
+
+	latest := GetNullValue()
+	points := make([]Point, 0, len(values))
+	for i, v := range values {
+		if i >= len(xValues) {
+			break
+		} else if isValidExtent(v) {
+			points = append(points, Point{X: xValues[i], Y: valueY(v)})
+			latest = v
+		} else if len(points) > 0 {
+			points = append(points, Point{X: xValues[i], Y: math.MaxInt32}) // break the line over null values
+		}
+	}
+	if len(points) == 0 {
+		return // still within the warm-up region
+	}
+	p.LineStroke(points, color, defaultStrokeWidth)
+
+	fontStyle := FontStyle{FontColor: color, FontSize: defaultLabelFontSize}
+	text := "RSI(" + strconv.Itoa(period) + ") " + FormatValueHumanize(latest, 2, true)
+	textBox := p.MeasureText(text, 0, fontStyle)
+	p.Text(text, pane.Left+2, pane.Top+textBox.Height(), 0, fontStyle)
+}
+
+// renderMACDPane draws the MACD histogram as bars from a zero line centered in the pane, colored with the up or down
+// candle color, overlaid with the MACD and signal lines. All three share a scale fit to the largest visible magnitude.
+func (k *candlestickChart) renderMACDPane(p *Painter, series *CandlestickSeries, xValues []int, pane Box,
+	barWidth, seriesCount int) {
+	macdOpt := k.opt.MACDPane
+	fast, slow, signal := macdOpt.Fast, macdOpt.Slow, macdOpt.Signal
+	if fast <= 0 {
+		fast = 12
+	}
+	if slow <= 0 {
+		slow = 26
+	}
+	if signal <= 0 {
+		signal = 9
+	}
+	priorCount := len(series.priorData)
+	macd, signalLine, histogram := ComputeMACD(warmupCloses(series), fast, slow, signal)
+	macd, signalLine, histogram = macd[priorCount:], signalLine[priorCount:], histogram[priorCount:]
+
+	var maxAbs float64
+	for _, values := range [][]float64{macd, signalLine, histogram} {
+		for _, v := range values {
+			if isValidExtent(v) {
+				maxAbs = max(maxAbs, math.Abs(v))
+			}
+		}
+	}
+	zeroY := pane.Top + pane.Height()/2
+	p.LineStroke([]Point{{X: pane.Left, Y: zeroY}, {X: pane.Right, Y: zeroY}}, k.opt.Theme.GetAxisSplitLineColor(), 1)
+	if maxAbs <= 0 {
+		return // still within the warm-up region
+	}
+	valueY := func(v float64) int {
+		return zeroY - int(v/maxAbs*float64(pane.Height()/2))
+	}
+
+	seriesThemeIndex := 0
+	if series.absThemeIndex != nil {
+		seriesThemeIndex = *series.absThemeIndex
+	}
+	upColor, downColor := k.opt.Theme.GetSeriesUpDownColors(seriesThemeIndex)
+	for i, v := range histogram {
+		if i >= len(xValues) {
+			break
+		} else if !isValidExtent(v) {
+			continue
+		}
+		barColor := upColor
+		if v < 0 {
+			barColor = downColor
+		}
+		y := valueY(v)
+		p.FilledRect(xValues[i]-barWidth/2, min(y, zeroY), xValues[i]+barWidth/2, max(y, zeroY),
+			barColor, barColor, 0.0)
+	}
+
+	colorIndex := seriesCount + len(k.opt.Overlays)
+	if k.opt.RSIPane != nil {
+		colorIndex++
+	}
+	if k.opt.ATRPane != nil {
+		colorIndex++
+	}
+	macdColor, signalColor := macdOpt.MACDColor, macdOpt.SignalColor
+	if macdColor.IsZero() {
+		macdColor = k.opt.Theme.GetSeriesColor(colorIndex)
+	}
+	if signalColor.IsZero() {
+		signalColor = k.opt.Theme.GetSeriesColor(colorIndex + 1)
+	}
+	for _, line := range []struct {
+		values []float64
+		color  Color
+	}{{macd, macdColor}, {signalLine, signalColor}} {
+		points := make([]Point, 0, len(line.values))
+		for i, v := range line.values {
+			if i >= len(xValues) {
+				break
+			} else if isValidExtent(v) {
+				points = append(points, Point{X: xValues[i], Y: valueY(v)})
+			} else if len(points) > 0 {
+				points = append(points, Point{X: xValues[i], Y: math.MaxInt32}) // break the line over null values
+			}
+		}
+		p.LineStroke(points, line.color, defaultStrokeWidth)
+	}
+	latest := GetNullValue()
+	for _, v := range macd {
+		if isValidExtent(v) {
+			latest = v
+		}
+	}
+
+	fontStyle := FontStyle{FontColor: macdColor, FontSize: defaultLabelFontSize}
+	text := "MACD(" + strconv.Itoa(fast) + "," + strconv.Itoa(slow) + "," + strconv.Itoa(signal) + ") " +
+		getPreferredValueFormatter(k.opt.ValueFormatter)(latest)
+	textBox := p.MeasureText(text, 0, fontStyle)
+	p.Text(text, pane.Left+2, pane.Top+textBox.Height(), 0, fontStyle)
+}
+
+// volumeProfileWidth returns the configured volume profile width fraction, or the default when out of range.
+func volumeProfileWidth(opt *CandlestickChartOption) float64 {
+	if opt.VolumeProfileWidth <= 0 || opt.VolumeProfileWidth >= 1 {
+		return 0.2
+	}
+	return opt.VolumeProfileWidth
+}
+
+// renderVolumeProfile draws the volume profile bars extending left from the right edge of the plot, one per price
+// bucket across the y-axis range, with the up candle volume against the edge and the down volume stacked beyond it.
+func (k *candlestickChart) renderVolumeProfile(p *Painter, series *CandlestickSeries, yRange axisRange) {
+	buckets := k.opt.VolumeProfileBuckets
+	if buckets <= 0 {
+		buckets = 24
+	}
+	var upBars, downBars []OHLCData
+	for _, ohlc := range series.Data {
+		if ohlc.Close >= ohlc.Open {
+			upBars = append(upBars, ohlc)
+		} else {
+			downBars = append(downBars, ohlc)
+		}
+	}
+	upVolume := ComputeVolumeProfile(upBars, yRange.min, yRange.max, buckets)
+	downVolume := ComputeVolumeProfile(downBars, yRange.min, yRange.max, buckets)
+	var maxVolume float64
+	for i := range upVolume {
+		maxVolume = max(maxVolume, upVolume[i]+downVolume[i])
+	}
+	if maxVolume <= 0 {
+		return
+	}
+
+	seriesThemeIndex := 0
+	if series.absThemeIndex != nil {
+		seriesThemeIndex = *series.absThemeIndex
+	}
+	upColor, downColor := k.opt.Theme.GetSeriesUpDownColors(seriesThemeIndex)
+	upColor, downColor = upColor.WithAlpha(120), downColor.WithAlpha(120)
+	width := p.Width()
+	maxLength := float64(width) * volumeProfileWidth(k.opt)
+	step := (yRange.max - yRange.min) / float64(buckets)
+	for i := range upVolume {
+		top := yRange.getRestHeight(yRange.min + float64(i+1)*step)
+		bottom := yRange.getRestHeight(yRange.min + float64(i)*step)
+		if bottom-top > 2 {
+			top, bottom = top+1, bottom-1 // separate adjacent buckets
+		}
+		upLength := int(upVolume[i] / maxVolume * maxLength)
+		downLength := int(downVolume[i] / maxVolume * maxLength)
+		if upLength > 0 {
+			p.FilledRect(width-upLength, top, width, bottom, upColor, upColor, 0.0)
+		}
+		if downLength > 0 {
+			p.FilledRect(width-upLength-downLength, top, width-upLength, bottom, downColor, downColor, 0.0)
+		}
+	}
+}
+
+// warmupCloses returns the closes of the bars before the visible window followed by the visible closes, so
+// indicators computed from them are warmed up at the first visible candle. Invalid bars are null.
+func warmupCloses(series *CandlestickSeries) []float64 {
+	return append((&CandlestickSeries{Data: series.priorData}).ExtractClosePrices(), series.ExtractClosePrices()...)
+}
+
+// renderOverlays draws the configured moving average lines over the series closes. Default colors continue the theme
+// series colors after the candlestick series.
+func (k *candlestickChart) renderOverlays(p *Painter, series *CandlestickSeries, xValues []int, yRange axisRange,
+	seriesCount int) {
+	if len(k.opt.Overlays) == 0 {
+		return
+	}
+	priorCount := len(series.priorData)
+	closes := warmupCloses(series)
+	for i, overlay := range k.opt.Overlays {
+		if overlay.Period < 2 {
+			continue
+		}
+		var values []float64
+		if overlay.Type == MATypeEMA {
+			values = EMA(closes, overlay.Period)
+		} else {
+			values = SMA(closes, overlay.Period)
+		}
+		values = values[priorCount:]
+		color := overlay.Color
+		if color.IsZero() {
+			color = k.opt.Theme.GetSeriesColor(seriesCount + i)
+		}
+		points := make([]Point, 0, len(values))
+		for j, v := range values {
+			if j >= len(xValues) {
+				break
+			} else if isValidExtent(v) {
+				points = append(points, Point{X: xValues[j], Y: yRange.getRestHeight(v)})
+			} else if len(points) > 0 {
+				points = append(points, Point{X: xValues[j], Y: math.MaxInt32}) // break the line over null closes
+			}
+		}
+		p.LineStroke(points, color, defaultStrokeWidth)
+	}
+}
+
 func (k *candlestickChart) Render() (Box, error) {
 	p := k.p
 	opt := k.opt
 	if opt.Theme == nil {
 		opt.Theme = getPreferredTheme(p.theme)
 	}
+	if flagIs(true, opt.InvertColors) || !opt.UpColor.IsZero() || !opt.DownColor.IsZero() {
+		opt.Theme = upDownPalette{ColorPalette: opt.Theme,
+			swap: flagIs(true, opt.InvertColors), up: opt.UpColor, down: opt.DownColor}
+	}
 	if opt.Legend.Symbol != SymbolNone { // candlestick icons show the up / down colors, only hiding can be configured
 		opt.Legend.Symbol = symbolCandlestick
 	}
 
+	opt.SeriesList = transformSeriesList(opt.SeriesList)
+	windowCandlestickOption(opt)
+	opt.SeriesList = detectSeriesPatterns(opt.SeriesList)
+	yAxis := opt.YAxis
+	if flagIs(true, opt.PercentAxis) {
+		opt.SeriesList = percentChangeSeriesList(opt.SeriesList, opt.PercentReference)
+		yAxis = make([]YAxisOption, max(len(opt.YAxis), getSeriesYAxisCount(opt.SeriesList)))
+		copy(yAxis, opt.YAxis)
+		for i := range yAxis {
+			if yAxis[i].ValueFormatter == nil {
+				yAxis[i].ValueFormatter = formatPercentChange
+			}
+		}
+	}
+
+	k.profileSlots = 0
+	if flagIs(true, opt.VolumeProfile) && len(opt.SeriesList) > 0 && maxSeriesVolume(opt.SeriesList[:1]) > 0 {
+		// sized so the reserved slots cover the profile width
+		profileWidth := volumeProfileWidth(opt)
+		dataCount := float64(getSeriesMaxDataCount(opt.SeriesList))
+		k.profileSlots = int(math.Ceil(dataCount * profileWidth / (1 - profileWidth)))
+	}
+
+	xAxis := opt.XAxis
+	if len(xAxis.TimeValues) > 0 {
+		// time axes position candles by time, so the margin extends the time range past the last sample
+		xAxis.TimeValues = extendTimeValues(xAxis.TimeValues, opt.RightMarginBars)
+	} else if marginSlots := max(opt.RightMarginBars, 0) + k.profileSlots; marginSlots > 0 {
+		// extend the category axis with unlabeled slots so no candles are drawn in the reserved margin
+		labelCount := max(len(xAxis.Labels), getSeriesMaxDataCount(opt.SeriesList))
+		labels := make([]string, labelCount, labelCount+marginSlots)
+		for i := range labels {
+			if i < len(xAxis.Labels) {
+				labels[i] = xAxis.Labels[i]
+			} else {
+				labels[i] = strconv.Itoa(i + 1)
+			}
+		}
+		xAxis.Labels = append(labels, make([]string, marginSlots)...)
+	}
+
+	k.volumeReserve, k.atrReserve, k.rsiReserve, k.macdReserve = 0, 0, 0, 0
+	if flagIs(true, opt.VolumePane) && maxSeriesVolume(opt.SeriesList) > 0 {
+		k.volumeReserve = opt.VolumePaneHeight
+		if k.volumeReserve <= 0 || k.volumeReserve >= 1 {
+			k.volumeReserve = 0.2
+		}
+	}
+	if opt.ATRPane != nil && len(opt.SeriesList) > 0 {
+		k.atrReserve = opt.ATRPane.Height
+		if k.atrReserve <= 0 || k.atrReserve >= 1 {
+			k.atrReserve = 0.2
+		}
+	}
+	if opt.RSIPane != nil && len(opt.SeriesList) > 0 {
+		k.rsiReserve = opt.RSIPane.Height
+		if k.rsiReserve <= 0 || k.rsiReserve >= 1 {
+			k.rsiReserve = 0.2
+		}
+	}
+	if opt.MACDPane != nil && len(opt.SeriesList) > 0 {
+		k.macdReserve = opt.MACDPane.Height
+		if k.macdReserve <= 0 || k.macdReserve >= 1 {
+			k.macdReserve = 0.2
+		}
+	}
+
+	padding := opt.Padding
+	var patternKey *patternLegend
+	if flagIs(true, opt.ShowPatternLegend) {
+		patternKey = k.newPatternLegend()
+		if patternKey != nil { // reserve space to the right of the plot
+			padding.Right += patternKey.width
+			padding.IsSet = true
+		}
+	}
+
 	renderResult, err := defaultRender(p, defaultRenderOption{
-		theme:          opt.Theme,
-		padding:        opt.Padding,
-		seriesList:     &opt.SeriesList,
-		categoryAxis:   &opt.XAxis,
-		valueAxis:      opt.YAxis,
-		title:          opt.Title,
-		legend:         &opt.Legend,
-		valueFormatter: opt.ValueFormatter,
+		theme:               opt.Theme,
+		padding:             padding,
+		seriesList:          &opt.SeriesList,
+		categoryAxis:        &xAxis,
+		valueAxis:           yAxis,
+		title:               opt.Title,
+		footer:              opt.Footer,
+		legend:              &opt.Legend,
+		valueFormatter:      opt.ValueFormatter,
+		seriesBottomReserve: k.volumeReserve + k.atrReserve + k.rsiReserve + k.macdReserve,
 	})
 	if err != nil {
 		return BoxZero, err
 	}
-	return k.renderChart(renderResult)
+	box, err := k.renderChart(renderResult)
+	if err != nil {
+		return BoxZero, err
+	}
+	if patternKey != nil {
+		patternKey.render(p, renderResult.seriesPainter)
+	}
+	return box, nil
+}
+
+const (
+	patternLegendGap     = 10
+	patternLegendLineGap = 4
+)
+
+// patternLegend describes the key of pattern glyphs rendered beside the plot.
+type patternLegend struct {
+	entries   []string
+	fontStyle FontStyle
+	width     int
+}
+
+// newPatternLegend returns the key for the patterns enabled across the series, or nil if no patterns are enabled.
+func (k *candlestickChart) newPatternLegend() *patternLegend {
+	opt := k.opt
+	var entries []string
+	for _, series := range opt.SeriesList {
+		if series.PatternConfig == nil {
+			continue
+		}
+		for _, patternType := range series.PatternConfig.EnabledPatterns {
+			detector, ok := patternDetectors[patternType]
+			if !ok || !series.PatternConfig.DirectionFilter.allows(detector.direction) {
+				continue
+			}
+			entry := detector.patternName
+			if glyph := patternGlyph(patternType, *series.PatternConfig); glyph != "" {
+				entry = glyph + " " + entry
+			}
+			if !slices.Contains(entries, entry) {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	legend := &patternLegend{
+		entries:   entries,
+		fontStyle: fillFontStyleDefaults(FontStyle{}, defaultLabelFontSize, opt.Theme.GetLegendTextColor(), k.p.font),
+	}
+	var textWidth int
+	for _, entry := range entries {
+		textWidth = max(textWidth, k.p.MeasureText(entry, 0, legend.fontStyle).Width())
+	}
+	legend.width = patternLegendGap + textWidth
+	return legend
+}
+
+// render draws the key entries top to bottom to the right of the series painter, stopping at the plot bottom.
+func (l *patternLegend) render(p *Painter, seriesPainter *Painter) {
+	left := seriesPainter.box.Right - p.box.Left + patternLegendGap
+	bottom := seriesPainter.box.Bottom - p.box.Top
+	y := seriesPainter.box.Top - p.box.Top
+	for _, entry := range l.entries {
+		y += p.MeasureText(entry, 0, l.fontStyle).Height()
+		if y > bottom {
+			return
+		}
+		p.Text(entry, left, y, 0, l.fontStyle)
+		y += patternLegendLineGap
+	}
 }
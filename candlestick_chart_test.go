@@ -2,10 +2,13 @@ package charts
 
 import (
 	"fmt"
+	"math"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,6 +63,16 @@ func makeMinimalCandlestickChartOption() CandlestickChartOption {
 	}
 }
 
+func renderCandlestickSVG(t *testing.T, opt CandlestickChartOption) string {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.CandlestickChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	return string(buf)
+}
+
 func TestCandlestickChart(t *testing.T) {
 	t.Parallel()
 
@@ -734,6 +747,631 @@ func TestCandlestickCandleWidthClamped(t *testing.T) {
 	assert.Equal(t, string(expected), string(actual))
 }
 
+func TestCandlestickCandleWidthRatio(t *testing.T) {
+	t.Parallel()
+
+	for _, ratio := range []float64{0.3, 0.9} {
+		t.Run(strconv.FormatFloat(ratio, 'f', -1, 64), func(t *testing.T) {
+			opt := makeMinimalCandlestickChartOption()
+			opt.CandleWidth = ratio
+			assertTestdataSVG(t, []byte(renderCandlestickSVG(t, opt)))
+		})
+	}
+}
+
+func TestCandlestickCandleWidthPixelClamp(t *testing.T) {
+	t.Parallel()
+
+	bodyWidths := func(opt CandlestickChartOption, width int) map[int]bool {
+		opt.Theme = GetTheme(ThemeLight)
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: width, Height: 400})
+		require.NoError(t, p.CandlestickChart(opt))
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		widths := make(map[int]bool)
+		// candle bodies are drawn as closed rectangles, the body width is the distance between the left and right edge
+		re := regexp.MustCompile(`<path d="M (\d+) \d+\nL (\d+) \d+\nL \d+ \d+\nL \d+ \d+\nL \d+ \d+" style="[^"]*fill:rgb`)
+		for _, m := range re.FindAllStringSubmatch(string(buf), -1) {
+			left, _ := strconv.Atoi(m[1])
+			right, _ := strconv.Atoi(m[2])
+			if right > left {
+				widths[right-left] = true
+			}
+		}
+		return widths
+	}
+
+	t.Run("max", func(t *testing.T) {
+		opt := NewCandlestickOptionWithData(makeBasicCandlestickData()[:2])
+		opt.CandleMaxWidth = 20
+		widths := bodyWidths(opt, 800)
+		require.NotEmpty(t, widths)
+		for w := range widths {
+			assert.LessOrEqual(t, w, 20)
+		}
+	})
+	t.Run("min", func(t *testing.T) {
+		data := make([]OHLCData, 0, 200)
+		for i := 0; i < 200; i++ {
+			base := 100 + float64(i%10)
+			data = append(data, OHLCData{Open: base, High: base + 3, Low: base - 3, Close: base + 1})
+		}
+		opt := NewCandlestickOptionWithData(data)
+		opt.CandleMinWidth = 4
+		widths := bodyWidths(opt, 600)
+		require.NotEmpty(t, widths)
+		for w := range widths {
+			assert.GreaterOrEqual(t, w, 4)
+		}
+	})
+}
+
+func TestCandlestickHollowUpCandles(t *testing.T) {
+	t.Parallel()
+
+	theme := GetTheme(ThemeLight)
+	upColor, downColor := theme.GetSeriesUpDownColors(0)
+	opt := NewCandlestickOptionWithData([]OHLCData{
+		{Open: 100, High: 110, Low: 95, Close: 105},
+		{Open: 105, High: 108, Low: 98, Close: 100},
+		{Open: 100, High: 112, Low: 99, Close: 110},
+	})
+	opt.Theme = theme
+	opt.HollowUpCandles = Ptr(true)
+	svg := renderCandlestickSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+
+	assert.Contains(t, svg, "stroke:"+upColor.String()+";fill:none")
+	assert.NotContains(t, svg, "fill:"+upColor.String())
+	assert.Contains(t, svg, "stroke:none;fill:"+downColor.String())
+}
+
+func TestCandlestickFlatBarMarker(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 110, Low: 95, Close: 105},
+		{Open: 104, High: 104, Low: 104, Close: 104}, // flat
+		{Open: 105, High: 115, Low: 100, Close: 112},
+	}
+	render := func(marker *bool) string {
+		opt := NewCandlestickOptionWithData(data)
+		opt.XAxis.Show = Ptr(false)
+		opt.YAxis[0].Show = Ptr(false)
+		opt.FlatBarMarker = marker
+		return renderCandlestickSVG(t, opt)
+	}
+
+	withMarker := render(Ptr(true))
+	assert.NotEqual(t, render(nil), withMarker)
+	assertTestdataSVG(t, []byte(withMarker))
+	assert.Contains(t, withMarker, "stroke-width:2;")
+}
+
+func TestCandlestickInvertColors(t *testing.T) {
+	t.Parallel()
+
+	theme := GetTheme(ThemeVividLight)
+	upColor, downColor := theme.GetSeriesUpDownColors(0)
+	render := func(ohlc OHLCData, invert *bool) string {
+		opt := NewCandlestickOptionWithData([]OHLCData{ohlc})
+		opt.Theme = theme
+		opt.InvertColors = invert
+		opt.Legend.Show = Ptr(false)
+		opt.XAxis.Show = Ptr(false)
+		opt.YAxis[0].Show = Ptr(false)
+		return renderCandlestickSVG(t, opt)
+	}
+	up := OHLCData{Open: 100, High: 110, Low: 95, Close: 105}
+	down := OHLCData{Open: 105, High: 110, Low: 95, Close: 100}
+
+	t.Run("default", func(t *testing.T) {
+		assert.Contains(t, render(up, nil), "fill:"+upColor.String())
+		assert.Contains(t, render(down, nil), "fill:"+downColor.String())
+	})
+	t.Run("inverted", func(t *testing.T) {
+		upSVG := render(up, Ptr(true))
+		assert.Contains(t, upSVG, "fill:"+downColor.String())
+		assert.NotContains(t, upSVG, upColor.String())
+		downSVG := render(down, Ptr(true))
+		assert.Contains(t, downSVG, "fill:"+upColor.String())
+		assert.NotContains(t, downSVG, downColor.String())
+	})
+	t.Run("pattern_label", func(t *testing.T) {
+		style := func(palette ColorPalette) *LabelStyle {
+			_, s := formatPatternsDefault([]PatternDetectionResult{
+				{PatternName: "Hammer", PatternType: candlestickPatternHammer},
+			}, 0, palette, CandlestickPatternConfig{})
+			return s
+		}
+		assert.Equal(t, upColor, style(theme).BorderColor)
+		assert.Equal(t, downColor, style(upDownPalette{ColorPalette: theme, swap: true}).BorderColor)
+	})
+	t.Run("derived_palette", func(t *testing.T) {
+		swapped := upDownPalette{ColorPalette: theme, swap: true}
+		derived := swapped.WithBackgroundColor(ColorBlack)
+		assert.Equal(t, ColorBlack, derived.GetBackgroundColor())
+		derivedUp, derivedDown := derived.GetSeriesUpDownColors(0)
+		assert.Equal(t, downColor, derivedUp)
+		assert.Equal(t, upColor, derivedDown)
+
+		invertedUp, invertedDown := swapped.Invert().GetSeriesUpDownColors(0)
+		expectedUp, expectedDown := theme.Invert().GetSeriesUpDownColors(0)
+		assert.Equal(t, expectedDown, invertedUp)
+		assert.Equal(t, expectedUp, invertedDown)
+	})
+}
+
+func TestCandlestickUpDownColors(t *testing.T) {
+	t.Parallel()
+
+	purple := ColorRGB(128, 0, 160)
+	orange := ColorRGB(255, 140, 0)
+	theme := GetTheme(ThemeVividLight)
+	themeUp, themeDown := theme.GetSeriesUpDownColors(0)
+
+	t.Run("custom", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.UpColor = purple
+		opt.DownColor = orange
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Contains(t, svg, "fill:"+purple.String())
+		assert.Contains(t, svg, "fill:"+orange.String())
+		assert.NotContains(t, svg, themeUp.String())
+		assert.NotContains(t, svg, themeDown.String())
+		// axis and grid colors remain from the theme
+		assert.Contains(t, svg, "stroke:"+theme.GetAxisSplitLineColor().String())
+	})
+	t.Run("not_inverted", func(t *testing.T) {
+		opt := NewCandlestickOptionWithData([]OHLCData{{Open: 100, High: 110, Low: 95, Close: 105}})
+		opt.UpColor = purple
+		opt.InvertColors = Ptr(true)
+		svg := renderCandlestickSVG(t, opt)
+		assert.Contains(t, svg, "fill:"+purple.String())
+	})
+	t.Run("partial_override", func(t *testing.T) {
+		opt := NewCandlestickOptionWithData([]OHLCData{{Open: 105, High: 110, Low: 95, Close: 100}})
+		opt.Theme = theme
+		opt.UpColor = purple
+		svg := renderCandlestickSVG(t, opt)
+		assert.Contains(t, svg, "fill:"+themeDown.String())
+	})
+	t.Run("derived_palette", func(t *testing.T) {
+		palette := upDownPalette{ColorPalette: theme, swap: true, up: purple}
+		derived := palette.WithBackgroundColor(ColorBlack).WithSeriesColors([]Color{ColorRed})
+		assert.Equal(t, ColorBlack, derived.GetBackgroundColor())
+		up, down := derived.GetSeriesUpDownColors(0)
+		assert.Equal(t, purple, up)
+		assert.Equal(t, themeUp, down)
+	})
+	t.Run("wick_colors", func(t *testing.T) {
+		opt := NewCandlestickOptionWithData([]OHLCData{
+			{Open: 100, High: 110, Low: 95, Close: 105},
+			{Open: 105, High: 110, Low: 95, Close: 100},
+		})
+		opt.UpColor = purple
+		opt.DownColor = orange
+		opt.UpWickColor = ColorBlack
+		opt.DownWickColor = ColorRGB(0, 0, 200)
+		svg := renderCandlestickSVG(t, opt)
+		// a wick above and below the body, plus the high and low caps
+		assert.Equal(t, 4, strings.Count(svg, "stroke:"+ColorBlack.String()+";fill:none"))
+		assert.Equal(t, 4, strings.Count(svg, "stroke:"+ColorRGB(0, 0, 200).String()+";fill:none"))
+	})
+}
+
+func TestCandlestickRightMarginBars(t *testing.T) {
+	t.Parallel()
+
+	const marginBars = 5
+	opt := makeMinimalCandlestickChartOption()
+	opt.Padding = NewBoxEqual(0)
+	opt.YAxis[0].Show = Ptr(false)
+	opt.RightMarginBars = marginBars
+	svg := renderCandlestickSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+
+	svg = svg[strings.Index(svg, "/>")+2:] // skip the background path
+	var maxX int
+	for _, m := range regexp.MustCompile(`[ML] (\d+) \d+`).FindAllStringSubmatch(svg, -1) {
+		x, err := strconv.Atoi(m[1])
+		require.NoError(t, err)
+		maxX = max(maxX, x)
+	}
+	slotWidth := 600 / (len(opt.SeriesList[0].Data) + marginBars)
+	assert.Positive(t, maxX)
+	assert.LessOrEqual(t, maxX, 600-marginBars*slotWidth)
+}
+
+func TestCandlestickRightMarginBarsTimeAxis(t *testing.T) {
+	t.Parallel()
+
+	const marginBars = 5
+	opt := makeMinimalCandlestickChartOption()
+	opt.Padding = NewBoxEqual(0)
+	opt.YAxis[0].Show = Ptr(false)
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opt.XAxis.TimeValues = make([]time.Time, len(opt.SeriesList[0].Data))
+	for i := range opt.XAxis.TimeValues {
+		opt.XAxis.TimeValues[i] = start.AddDate(0, 0, i)
+	}
+	opt.RightMarginBars = marginBars
+	svg := renderCandlestickSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+
+	// the time range is extended past the last candle, leaving the margin slots empty
+	svg = svg[strings.Index(svg, "/>")+2:] // skip the background path
+	var maxX int
+	for _, m := range regexp.MustCompile(`[ML] (\d+) \d+`).FindAllStringSubmatch(svg, -1) {
+		x, err := strconv.Atoi(m[1])
+		require.NoError(t, err)
+		maxX = max(maxX, x)
+	}
+	slotWidth := 600 / (len(opt.SeriesList[0].Data) + marginBars)
+	assert.Positive(t, maxX)
+	assert.LessOrEqual(t, maxX, 600-marginBars*slotWidth)
+}
+
+func TestCandlestickVolumeProfile(t *testing.T) {
+	t.Parallel()
+
+	// prices climb from 100 to 130, with heavy volume only while trading between 110 and 114
+	makeProfileOption := func() CandlestickChartOption {
+		data := make([]OHLCData, 20)
+		for i := range data {
+			base := 100 + float64(i)*1.5
+			data[i] = OHLCData{Open: base, High: base + 3, Low: base - 1, Close: base + 2, Volume: 500}
+			if i%3 == 2 {
+				data[i].Open, data[i].Close = data[i].Close, data[i].Open // some down candles
+			}
+			if i >= 8 && i <= 9 {
+				data[i] = OHLCData{Open: 111, High: 114, Low: 110, Close: 113, Volume: 20000}
+			}
+		}
+		return CandlestickChartOption{
+			Padding:       NewBoxEqual(10),
+			SeriesList:    NewSeriesListCandlestick([][]OHLCData{data}),
+			VolumeProfile: Ptr(true),
+		}
+	}
+
+	t.Run("concentrated_band", func(t *testing.T) {
+		opt := makeProfileOption()
+		opt.VolumeProfileBuckets = 10
+		var layout CandlestickLayout
+		opt.LayoutCallback = func(l CandlestickLayout) {
+			layout = l
+		}
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		// the widest up volume bar covers the heavily traded band
+		upColor, _ := GetDefaultTheme().GetSeriesUpDownColors(0)
+		rectPattern := regexp.MustCompile(`<path d="M (\d+) (\d+)\nL (\d+) \d+\nL \d+ (\d+)[^"]*" ` +
+			`style="stroke:none;fill:` + regexp.QuoteMeta(upColor.WithAlpha(120).String()) + `"/>`)
+		var widest, top, bottom int
+		for _, m := range rectPattern.FindAllStringSubmatch(svg, -1) {
+			x1, _ := strconv.Atoi(m[1])
+			x2, _ := strconv.Atoi(m[3])
+			if x2-x1 > widest {
+				widest = x2 - x1
+				top, _ = strconv.Atoi(m[2])
+				bottom, _ = strconv.Atoi(m[4])
+			}
+		}
+		require.Positive(t, widest)
+		bandY := layout.ValueToY(0, 112)
+		assert.LessOrEqual(t, top, bandY)
+		assert.GreaterOrEqual(t, bottom, bandY)
+	})
+	t.Run("reserves_slots", func(t *testing.T) {
+		opt := makeProfileOption()
+		opt.YAxis = []YAxisOption{{Show: Ptr(false)}}
+		svg := renderCandlestickSVG(t, opt)
+		svg = svg[strings.Index(svg, "/>")+2:] // skip the background path
+		// candle wicks are the only up color strokes, all left of the profile width
+		var maxX int
+		upColor, _ := GetDefaultTheme().GetSeriesUpDownColors(0)
+		wickPattern := regexp.MustCompile(`<path d="M (\d+) \d+\nL \d+ \d+" style="stroke-width:1;stroke:` +
+			regexp.QuoteMeta(upColor.String()) + `;fill:none"/>`)
+		for _, m := range wickPattern.FindAllStringSubmatch(svg, -1) {
+			x, err := strconv.Atoi(m[1])
+			require.NoError(t, err)
+			maxX = max(maxX, x)
+		}
+		assert.Positive(t, maxX)
+		assert.Less(t, maxX, 10+int(580*(1-0.2)))
+	})
+	t.Run("no_volume", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		defaultSVG := renderCandlestickSVG(t, opt)
+		opt.VolumeProfile = Ptr(true)
+		assert.Equal(t, defaultSVG, renderCandlestickSVG(t, opt))
+	})
+}
+
+func TestCandlestickPercentAxis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("first_close_reference", func(t *testing.T) {
+		data := makeBasicCandlestickData()
+		rebased := percentChangeSeriesList(NewSeriesListCandlestick([][]OHLCData{data}), 0)
+		require.Len(t, rebased, 1)
+		assert.InDelta(t, 0.0, rebased[0].Data[0].Close, 1e-9)
+		assert.InDelta(t, (100.0/105-1)*100, rebased[0].Data[0].Open, 1e-9)
+		assert.InDelta(t, (120.0/105-1)*100, rebased[0].Data[3].High, 1e-9)
+		assert.InDelta(t, (95.0/105-1)*100, rebased[0].Data[0].Low, 1e-9)
+		assert.InDelta(t, 105.0, data[0].Close, 0) // source data is not modified
+	})
+	t.Run("explicit_reference", func(t *testing.T) {
+		rebased := percentChangeSeriesList(NewSeriesListCandlestick([][]OHLCData{makeBasicCandlestickData()}), 100)
+		assert.InDelta(t, 5.0, rebased[0].Data[0].Close, 1e-9)
+		assert.InDelta(t, 20.0, rebased[0].Data[3].High, 1e-9)
+	})
+	t.Run("render", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.PercentAxis = Ptr(true)
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Contains(t, svg, ">0%</text>")
+		assert.NotContains(t, svg, ">105</text>")
+		assert.Regexp(t, `>-?\d+(\.\d+)?%</text>`, svg)
+	})
+	t.Run("patterns_detected_on_prices", func(t *testing.T) {
+		// highs within the 0.1% tweezer tolerance of the price, but not of the rebased 4% values
+		data := []OHLCData{
+			{Open: 110, High: 130, Low: 108, Close: 125},
+			{Open: 123, High: 130.1, Low: 115, Close: 118},
+		}
+		seriesList := NewSeriesListCandlestick([][]OHLCData{data})
+		seriesList[0].PatternConfig = (&CandlestickPatternConfig{}).WithTweezerTop()
+
+		rebased := percentChangeSeriesList(detectSeriesPatterns(seriesList), 0)
+		require.Len(t, rebased[0].patterns[1], 1)
+		pattern := rebased[0].patterns[1][0]
+		assert.Equal(t, candlestickPatternTweezerTop, pattern.PatternType)
+		assert.Equal(t, 1, pattern.Anchor.Index)
+		assert.InDelta(t, (118.0/125-1)*100, pattern.Anchor.Price, 1e-9)
+
+		opt := CandlestickChartOption{SeriesList: seriesList, PercentAxis: Ptr(true)}
+		assert.Contains(t, renderCandlestickSVG(t, opt), "Tweezer Top")
+	})
+}
+
+func TestCandlestickVolumePane(t *testing.T) {
+	t.Parallel()
+
+	volumes := []float64{1200, 1800, 900, 2400, 1500}
+	makeVolumeOption := func() CandlestickChartOption {
+		opt := makeBasicCandlestickChartOption()
+		for i := range opt.SeriesList[0].Data {
+			opt.SeriesList[0].Data[i].Volume = volumes[i]
+		}
+		opt.VolumePane = Ptr(true)
+		return opt
+	}
+
+	t.Run("render", func(t *testing.T) {
+		opt := makeVolumeOption()
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		opt.VolumePane = nil
+		assert.NotEqual(t, renderCandlestickSVG(t, opt), svg)
+	})
+	t.Run("custom_height", func(t *testing.T) {
+		opt := makeVolumeOption()
+		opt.VolumePaneHeight = 0.35
+		assert.NotEqual(t, renderCandlestickSVG(t, makeVolumeOption()), renderCandlestickSVG(t, opt))
+	})
+	t.Run("zero_volume_skipped", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.VolumePane = Ptr(true)
+		assert.Equal(t, renderCandlestickSVG(t, makeBasicCandlestickChartOption()), renderCandlestickSVG(t, opt))
+	})
+}
+
+func TestCandlestickATRPane(t *testing.T) {
+	t.Parallel()
+
+	makeATROption := func() CandlestickChartOption {
+		opt := makeBasicCandlestickChartOption()
+		opt.ATRPane = &ATRPaneOption{Period: 2, Color: ColorRGB(120, 60, 200)}
+		return opt
+	}
+
+	t.Run("render", func(t *testing.T) {
+		opt := makeATROption()
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		atr := ComputeATR(opt.SeriesList[0].Data, 2)
+		assert.Contains(t, svg, ">ATR(2) "+defaultValueFormatter(atr[len(atr)-1])+"</text>")
+		assert.Contains(t, svg, "stroke:rgb(120,60,200);fill:none")
+	})
+	t.Run("with_volume", func(t *testing.T) {
+		opt := makeATROption()
+		opt.SeriesList[0].Data = slices.Clone(opt.SeriesList[0].Data)
+		for i := range opt.SeriesList[0].Data {
+			opt.SeriesList[0].Data[i].Volume = float64(1000 * (i + 1))
+		}
+		opt.VolumePane = Ptr(true)
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		// pane dividers above the ATR pane and between the ATR and volume panes
+		splitStroke := "stroke-width:1;stroke:" + opt.Theme.GetAxisSplitLineColor().String() + ";fill:none"
+		opt.ATRPane = nil
+		assert.Equal(t, strings.Count(renderCandlestickSVG(t, opt), splitStroke)+1, strings.Count(svg, splitStroke))
+	})
+	t.Run("default_period", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.ATRPane = &ATRPaneOption{}
+		// the basic data is shorter than the default period, the pane is reserved but no line is drawn
+		assert.NotContains(t, renderCandlestickSVG(t, opt), "ATR(14)")
+	})
+}
+
+func TestCandlestickRSIPane(t *testing.T) {
+	t.Parallel()
+
+	makeRSIOption := func() CandlestickChartOption {
+		opt := makeBasicCandlestickChartOption()
+		opt.RSIPane = &RSIPaneOption{Period: 2, Color: ColorRGB(200, 90, 40)}
+		return opt
+	}
+	guideStroke := `stroke-dasharray="4.0, 2.0"`
+
+	t.Run("render", func(t *testing.T) {
+		opt := makeRSIOption()
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		closes := make([]float64, len(opt.SeriesList[0].Data))
+		for i, bar := range opt.SeriesList[0].Data {
+			closes[i] = bar.Close
+		}
+		rsi := ComputeRSI(closes, 2)
+		assert.Contains(t, svg, ">RSI(2) "+FormatValueHumanize(rsi[len(rsi)-1], 2, true)+"</text>")
+		assert.Contains(t, svg, "stroke:rgb(200,90,40);fill:none")
+		assert.Equal(t, 2, strings.Count(svg, guideStroke))
+	})
+	t.Run("with_atr_and_volume", func(t *testing.T) {
+		opt := makeRSIOption()
+		opt.SeriesList[0].Data = slices.Clone(opt.SeriesList[0].Data)
+		for i := range opt.SeriesList[0].Data {
+			opt.SeriesList[0].Data[i].Volume = float64(1000 * (i + 1))
+		}
+		opt.VolumePane = Ptr(true)
+		opt.ATRPane = &ATRPaneOption{Period: 2}
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		// one divider above each pane
+		splitStroke := "stroke-width:1;stroke:" + opt.Theme.GetAxisSplitLineColor().String() + ";fill:none"
+		opt.RSIPane = nil
+		assert.Equal(t, strings.Count(renderCandlestickSVG(t, opt), splitStroke)+1, strings.Count(svg, splitStroke))
+	})
+	t.Run("warm_up", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.RSIPane = &RSIPaneOption{}
+		// the basic data is shorter than the default period, the guides are drawn but no line or label
+		svg := renderCandlestickSVG(t, opt)
+		assert.NotContains(t, svg, "RSI(14)")
+		assert.Equal(t, 2, strings.Count(svg, guideStroke))
+	})
+}
+
+func TestCandlestickMACDPane(t *testing.T) {
+	t.Parallel()
+
+	makeMACDOption := func() CandlestickChartOption {
+		opt := makeBasicCandlestickChartOption()
+		opt.MACDPane = &MACDPaneOption{
+			Fast: 2, Slow: 3, Signal: 2,
+			MACDColor: ColorRGB(30, 100, 200), SignalColor: ColorRGB(230, 120, 30),
+		}
+		return opt
+	}
+
+	t.Run("render", func(t *testing.T) {
+		opt := makeMACDOption()
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		macd, _, _ := ComputeMACD((&opt.SeriesList[0]).ExtractClosePrices(), 2, 3, 2)
+		assert.Contains(t, svg, ">MACD(2,3,2) "+defaultValueFormatter(macd[len(macd)-1])+"</text>")
+		assert.Contains(t, svg, "stroke:rgb(30,100,200);fill:none")
+		assert.Contains(t, svg, "stroke:rgb(230,120,30);fill:none")
+	})
+	t.Run("with_rsi_and_volume", func(t *testing.T) {
+		opt := makeMACDOption()
+		opt.SeriesList[0].Data = slices.Clone(opt.SeriesList[0].Data)
+		for i := range opt.SeriesList[0].Data {
+			opt.SeriesList[0].Data[i].Volume = float64(1000 * (i + 1))
+		}
+		opt.VolumePane = Ptr(true)
+		opt.RSIPane = &RSIPaneOption{Period: 2}
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+	})
+	t.Run("warm_up", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.MACDPane = &MACDPaneOption{}
+		// the basic data is shorter than the default slow period, only the zero line is drawn
+		assert.NotContains(t, renderCandlestickSVG(t, opt), "MACD(12,26,9)")
+	})
+}
+
+func TestCandlestickFontSizes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("axis_labels", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		defaultSVG := renderCandlestickSVG(t, opt)
+		opt.XAxis.LabelFontStyle.FontSize = 16
+		opt.YAxis = []YAxisOption{{LabelFontStyle: FontStyle{FontSize: 16}}}
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.NotContains(t, defaultSVG, "font-size:20.4px")
+		assert.GreaterOrEqual(t, strings.Count(svg, "font-size:20.4px"), len(opt.XAxis.Labels))
+		// beyond the font size the larger labels shift the plot and candle coordinates
+		assert.NotEqual(t, defaultSVG, strings.ReplaceAll(svg, "font-size:20.4px", "font-size:15.3px"))
+	})
+	t.Run("pattern_labels", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.SeriesList[0].PatternConfig = (&CandlestickPatternConfig{}).WithPatternsAll().WithLabelFontSize(14)
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Contains(t, svg, "font-size:17.9px")
+		assert.NotContains(t, svg, "font-size:12.8px")
+	})
+}
+
+func TestCandlestickHeikinAshi(t *testing.T) {
+	t.Parallel()
+
+	t.Run("render", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		data := slices.Clone(opt.SeriesList[0].Data)
+		opt.SeriesList[0].Transform = CandlestickTransformHeikinAshi
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Equal(t, data, opt.SeriesList[0].Data)
+		assert.NotEqual(t, renderCandlestickSVG(t, makeBasicCandlestickChartOption()), svg)
+	})
+	t.Run("pattern_data", func(t *testing.T) {
+		detectedDoji := func(raw bool) bool {
+			var found bool
+			opt := makeBasicCandlestickChartOption()
+			opt.SeriesList[0].Data[2] = OHLCData{Open: 112, High: 118, Low: 108, Close: 112} // raw doji
+			opt.SeriesList[0].Transform = CandlestickTransformHeikinAshi
+			opt.SeriesList[0].PatternConfig = (&CandlestickPatternConfig{}).
+				WithDoji().
+				WithDetectOnRawData(raw).
+				WithPatternFormatter(func(patterns []PatternDetectionResult, _ string, _ float64) (string, *LabelStyle) {
+					for _, pattern := range patterns {
+						if pattern.PatternType == candlestickPatternDoji && pattern.Index == 2 {
+							found = true
+						}
+					}
+					return "", nil
+				})
+			renderCandlestickSVG(t, opt)
+			return found
+		}
+
+		assert.True(t, detectedDoji(true))
+		assert.False(t, detectedDoji(false))
+	})
+}
+
 func validateCandlestickChartRender(t *testing.T, svgP, pngP *Painter, opt CandlestickChartOption, expectedCRC uint32) {
 	t.Helper()
 
@@ -1003,3 +1641,649 @@ func TestCandlestickLegendSymbol(t *testing.T) {
 		assert.Equal(t, []SymbolShape{SymbolNone}, genericSymbols(SymbolNone))
 	})
 }
+
+func TestCandlestickTimeAxis(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC) // Thursday
+	opt := makeBasicCandlestickChartOption()
+	opt.XAxis.Labels = nil
+	opt.XAxis.TimeValues = []time.Time{ // weekend gap between Friday and Monday
+		start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 4), start.AddDate(0, 0, 5), start.AddDate(0, 0, 6),
+	}
+
+	svg := renderCandlestickSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+	assert.Contains(t, svg, ">Mar 11<")
+
+	t.Run("compress_gaps", func(t *testing.T) {
+		opt := opt
+		opt.XAxis.CompressGaps = true
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+		assert.NotContains(t, svg, ">Mar 9<")
+		assert.NotContains(t, svg, ">Mar 10<")
+	})
+	t.Run("missing_time_values", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.XAxis.TimeValues = []time.Time{start}
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.Error(t, p.CandlestickChart(opt))
+	})
+}
+
+func TestCandlestickOverlays(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sma_ema", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		opt.Overlays = []MovingAverage{
+			{Period: 3, Type: MATypeSMA},
+			{Period: 3, Type: MATypeEMA, Color: ColorRGB(20, 40, 160)},
+		}
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Equal(t, 1, strings.Count(svg, "stroke:rgb(20,40,160)"))
+	})
+	t.Run("warm_up_exceeds_data", func(t *testing.T) {
+		opt := makeBasicCandlestickChartOption()
+		base := renderCandlestickSVG(t, opt)
+		opt.Overlays = []MovingAverage{{Period: 10}}
+		assert.Equal(t, base, renderCandlestickSVG(t, opt))
+	})
+}
+
+func TestCandlestickCandleMetadata(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicCandlestickChartOption()
+	opt.SeriesList[0].CandleMetadata = []map[string]string{
+		2: {"id": "c2", "data-date": "2024-01-03"},
+	}
+
+	svg := renderCandlestickSVG(t, opt)
+
+	// wicks, caps, and body of the third candle are annotated
+	assert.Equal(t, 5, strings.Count(svg, `<path data-date="2024-01-03" data-id="c2" d=`))
+	assert.NotContains(t, svg, "data-data-")
+
+	pngP := NewPainter(PainterOptions{OutputFormat: ChartOutputPNG, Width: 600, Height: 400})
+	require.NoError(t, pngP.CandlestickChart(opt))
+	_, err := pngP.Bytes()
+	require.NoError(t, err)
+}
+
+func TestPriceGap(t *testing.T) {
+	t.Parallel()
+
+	prev := OHLCData{Open: 100, High: 105, Low: 98, Close: 104}
+	tests := []struct {
+		name       string
+		current    OHLCData
+		minPercent float64
+		low, high  float64
+		up, ok     bool
+	}{
+		{"gap_up", OHLCData{Open: 108, High: 112, Low: 107, Close: 110}, 0, 105, 107, true, true},
+		{"gap_down", OHLCData{Open: 95, High: 96, Low: 90, Close: 92}, 0, 96, 98, false, true},
+		{"overlap", OHLCData{Open: 104, High: 108, Low: 103, Close: 107}, 0, 0, 0, false, false},
+		{"below_threshold", OHLCData{Open: 106, High: 110, Low: 106, Close: 108}, 2, 0, 0, false, false},
+		{"above_threshold", OHLCData{Open: 108, High: 112, Low: 107, Close: 110}, 1, 105, 107, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			low, high, up, ok := priceGap(prev, tt.current, tt.minPercent, 0)
+			assert.Equal(t, tt.ok, ok)
+			assert.InDelta(t, tt.low, low, 0)
+			assert.InDelta(t, tt.high, high, 0)
+			assert.Equal(t, tt.up, up)
+		})
+	}
+	t.Run("percent_rebased", func(t *testing.T) {
+		// rebased against the prior close, the gap from 105 to 107 is still 1.9% of the price
+		rebased := percentChangeOHLC([]OHLCData{prev, {Open: 108, High: 112, Low: 107, Close: 110}}, prev.Close)
+		low, high, up, ok := priceGap(rebased[0], rebased[1], 1, prev.Close)
+		assert.True(t, ok)
+		assert.True(t, up)
+		assert.InDelta(t, rebased[0].High, low, 0)
+		assert.InDelta(t, rebased[1].Low, high, 0)
+
+		_, _, _, ok = priceGap(rebased[0], rebased[1], 2, prev.Close)
+		assert.False(t, ok)
+	})
+}
+
+func TestCandlestickHighlightGaps(t *testing.T) {
+	t.Parallel()
+
+	makeOpt := func() CandlestickChartOption {
+		opt := NewCandlestickOptionWithData([]OHLCData{
+			{Open: 100, High: 106, Low: 98, Close: 105},
+			{Open: 112, High: 118, Low: 110, Close: 116}, // gap up from 106 to 110
+		})
+		opt.XAxis.Labels = []string{"A", "B"}
+		opt.HighlightGaps = Ptr(true)
+		return opt
+	}
+	upColor, _ := GetDefaultTheme().GetSeriesUpDownColors(0)
+	gapFill := "fill:" + upColor.WithAlpha(gapFillAlpha).String()
+
+	t.Run("gap_up", func(t *testing.T) {
+		svg := renderCandlestickSVG(t, makeOpt())
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Equal(t, 1, strings.Count(svg, gapFill))
+	})
+	t.Run("min_percent", func(t *testing.T) {
+		opt := makeOpt()
+		opt.GapMinPercent = 5 // gap is under 4% of the prior close
+		assert.NotContains(t, renderCandlestickSVG(t, opt), gapFill)
+	})
+	t.Run("min_percent_percent_axis", func(t *testing.T) {
+		opt := makeOpt()
+		opt.PercentAxis = Ptr(true) // the prior close is the rebase reference, so its value is 0%
+		opt.GapMinPercent = 3
+		assert.Equal(t, 1, strings.Count(renderCandlestickSVG(t, opt), gapFill))
+
+		opt.GapMinPercent = 5
+		assert.NotContains(t, renderCandlestickSVG(t, opt), gapFill)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		opt := makeOpt()
+		opt.HighlightGaps = nil
+		assert.NotContains(t, renderCandlestickSVG(t, opt), gapFill)
+	})
+}
+
+func TestCandlestickAnnotations(t *testing.T) {
+	t.Parallel()
+
+	opt := NewCandlestickOptionWithData([]OHLCData{
+		{Open: 100, High: 110, Low: 95, Close: 105},
+		{Open: 105, High: 115, Low: 100, Close: 112},
+		{Open: 112, High: 118, Low: 104, Close: 106},
+	})
+	opt.XAxis.Labels = []string{"A", "B", "C"}
+	opt.Annotations = []ChartAnnotation{
+		{Index: 1, Text: "Earnings"},
+		{Index: 2, Text: "Exit", Position: PositionBottom, Style: LabelStyle{BorderColor: ColorRGB(200, 0, 100)}},
+		{Index: 7, Text: "Out of range"},
+	}
+
+	svg := renderCandlestickSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+
+	assert.Contains(t, svg, ">Earnings</text>")
+	assert.Contains(t, svg, ">Exit</text>")
+	assert.NotContains(t, svg, "Out of range")
+	// pointer lines rise above the second candle high and fall below the third candle low
+	assert.Contains(t, svg, `<path d="M 324 87`+"\nL 324 67\"")
+	assert.Contains(t, svg, `<path d="M 494 235`+"\nL 494 255\"")
+	assert.Contains(t, svg, `style="stroke-width:1;stroke:rgb(200,0,100);fill:none"`)
+}
+
+func TestCandlestickCrosshair(t *testing.T) {
+	t.Parallel()
+
+	makeOpt := func() CandlestickChartOption {
+		opt := NewCandlestickOptionWithData([]OHLCData{
+			{Open: 100, High: 110, Low: 95, Close: 105},
+			{Open: 105, High: 115, Low: 100, Close: 112},
+			{Open: 112, High: 118, Low: 104, Close: 106},
+		})
+		opt.XAxis.Labels = []string{"A", "B", "C"}
+		return opt
+	}
+
+	t.Run("value", func(t *testing.T) {
+		opt := makeOpt()
+		opt.Crosshair = &CrosshairOption{Index: 1, Value: Ptr(108.5), StrokeColor: ColorRGB(200, 0, 100)}
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Contains(t, svg, ">108.5</text>")
+		assert.Equal(t, 2, strings.Count(svg, ">B</text>")) // axis label and crosshair edge label
+		assert.Equal(t, 2, strings.Count(svg, `stroke-dasharray="4.0, 2.0"`))
+		assert.Contains(t, svg, `<path stroke-dasharray="4.0, 2.0" d="M 324 20`)
+	})
+	t.Run("default_close", func(t *testing.T) {
+		opt := makeOpt()
+		opt.Crosshair = &CrosshairOption{Index: 2}
+		assert.Contains(t, renderCandlestickSVG(t, opt), ">106</text>")
+	})
+	t.Run("visible_range", func(t *testing.T) {
+		opt := makeOpt()
+		opt.VisibleRange = &IndexRange{Start: 1}
+		opt.Crosshair = &CrosshairOption{Index: 2}
+		windowed := renderCandlestickSVG(t, opt)
+		assert.Contains(t, windowed, ">106</text>")
+		assert.Equal(t, 2, opt.Crosshair.Index) // option not mutated
+
+		opt.Crosshair = &CrosshairOption{Index: 0}
+		assert.NotContains(t, renderCandlestickSVG(t, opt), "stroke-dasharray")
+	})
+	t.Run("out_of_range", func(t *testing.T) {
+		opt := makeOpt()
+		opt.Crosshair = &CrosshairOption{Index: 5}
+		assert.NotContains(t, renderCandlestickSVG(t, opt), "stroke-dasharray")
+	})
+}
+
+func TestCandlestickLayoutCallback(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicCandlestickChartOption()
+	opt.SeriesList[0].Data = slices.Clone(opt.SeriesList[0].Data)
+	opt.SeriesList[0].Data[3] = OHLCData{Open: GetNullValue(), High: GetNullValue(), Low: GetNullValue(), Close: GetNullValue()}
+	var layout CandlestickLayout
+	var calls int
+	opt.LayoutCallback = func(l CandlestickLayout) {
+		layout = l
+		calls++
+	}
+
+	svg := renderCandlestickSVG(t, opt)
+
+	require.Equal(t, 1, calls)
+	require.Len(t, layout.Candles, 1)
+	require.Len(t, layout.Candles[0], len(opt.SeriesList[0].Data))
+
+	t.Run("body_matches_path", func(t *testing.T) {
+		for i, candle := range layout.Candles[0] {
+			if !candle.Valid {
+				continue
+			}
+			body := candle.Body
+			assert.Contains(t, svg, fmt.Sprintf("M %d %d\nL %d %d\nL %d %d\nL %d %d", body.Left, body.Top,
+				body.Right, body.Top, body.Right, body.Bottom, body.Left, body.Bottom), "candle %d", i)
+			assert.Contains(t, svg, fmt.Sprintf("M %d %d\nL %d %d", candle.CenterX, candle.High.Y,
+				candle.CenterX, body.Top), "candle %d", i)
+		}
+	})
+	t.Run("invalid_candle", func(t *testing.T) {
+		assert.False(t, layout.Candles[0][3].Valid)
+		assert.True(t, layout.Candles[0][2].Valid)
+	})
+	t.Run("value_mapping", func(t *testing.T) {
+		ohlc := opt.SeriesList[0].Data[0]
+		candle := layout.Candles[0][0]
+		assert.Equal(t, candle.High.Y, layout.ValueToY(0, ohlc.High))
+		assert.Equal(t, candle.Low.Y, layout.ValueToY(0, ohlc.Low))
+		assert.Equal(t, candle.Body.Bottom, layout.ValueToY(0, min(ohlc.Open, ohlc.Close)))
+		assert.True(t, layout.PlotBox.Top <= candle.High.Y && candle.Low.Y <= layout.PlotBox.Bottom)
+
+		// pixel rounding limits the accuracy of the inverse to about one pixel of value
+		onePixel := layout.YToValue(0, layout.PlotBox.Top) - layout.YToValue(0, layout.PlotBox.Top+1)
+		assert.InDelta(t, ohlc.High, layout.YToValue(0, candle.High.Y), onePixel)
+		assert.False(t, isValidExtent(layout.YToValue(1, candle.High.Y)))
+	})
+}
+
+func TestCandlestickVisibleRange(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 106, Low: 98, Close: 104},
+		{Open: 104, High: 110, Low: 102, Close: 108},
+		{Open: 108, High: 113, Low: 106, Close: 110},
+		{Open: 110, High: 112, Low: 104, Close: 105},
+		{Open: 120, High: 125, Low: 105, Close: 108}, // large bearish
+		{Open: 102, High: 104, Low: 100, Close: 103}, // small body, gap down
+		{Open: 108, High: 125, Low: 106, Close: 122}, // large bullish, gap up, first visible candle
+		{Open: 122, High: 126, Low: 119, Close: 124},
+		{Open: 124, High: 128, Low: 121, Close: 126},
+	}
+	makeOption := func() CandlestickChartOption {
+		opt := NewCandlestickOptionWithData(data)
+		opt.XAxis.Labels = []string{"L0", "L1", "L2", "L3", "L4", "L5", "L6", "L7", "L8"}
+		opt.SeriesList[0].PatternConfig = (&CandlestickPatternConfig{}).WithMorningStar()
+		opt.SeriesList[0].CandleMetadata = make([]map[string]string, len(data))
+		for i := range data {
+			opt.SeriesList[0].CandleMetadata[i] = map[string]string{"bar": strconv.Itoa(i)}
+		}
+		opt.Annotations = []ChartAnnotation{
+			{Index: 2, Text: "Hidden"},
+			{Index: 7, Text: "Visible"},
+		}
+		opt.VisibleRange = &IndexRange{Start: 6}
+		return opt
+	}
+
+	t.Run("window", func(t *testing.T) {
+		opt := makeOption()
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		for i := range data {
+			if i < 6 {
+				assert.NotContains(t, svg, `data-bar="`+strconv.Itoa(i)+`"`)
+				assert.NotContains(t, svg, ">L"+strconv.Itoa(i)+"</text>")
+			} else {
+				assert.Contains(t, svg, `data-bar="`+strconv.Itoa(i)+`"`)
+				assert.Contains(t, svg, ">L"+strconv.Itoa(i)+"</text>")
+			}
+		}
+		// the morning star begins before the window but completes on the first visible candle
+		assert.Contains(t, svg, "Morning Star")
+		assert.NotContains(t, svg, "Hidden")
+		assert.Contains(t, svg, ">Visible</text>")
+		// the caller option is not modified
+		assert.Len(t, opt.SeriesList[0].Data, len(data))
+		assert.Nil(t, opt.SeriesList[0].PatternConfig.PreHistory)
+	})
+
+	t.Run("pattern_outside_window", func(t *testing.T) {
+		opt := makeOption()
+		opt.VisibleRange = &IndexRange{Start: 7}
+		assert.NotContains(t, renderCandlestickSVG(t, opt), "Morning Star")
+	})
+
+	t.Run("percent_axis", func(t *testing.T) {
+		overlayColor := Color{R: 1, G: 2, B: 3, A: 255}
+		overlayY := func(t *testing.T, opt CandlestickChartOption) ([]string, string) {
+			t.Helper()
+
+			opt.Overlays = []MovingAverage{{Period: 3, Color: overlayColor}}
+			svg := renderCandlestickSVG(t, opt)
+
+			match := regexp.MustCompile(`<path d="([^"]*)" style="stroke-width:[^;]*;stroke:` +
+				regexp.QuoteMeta(overlayColor.String()) + `;fill:none"/>`).FindStringSubmatch(svg)
+			require.Len(t, match, 2)
+			var ys []string
+			for _, point := range regexp.MustCompile(`[ML] \d+ (\d+)`).FindAllStringSubmatch(match[1], -1) {
+				ys = append(ys, point[1])
+			}
+			return ys, svg
+		}
+
+		opt := makeOption()
+		opt.PercentAxis = Ptr(true)
+		ys, svg := overlayY(t, opt)
+
+		// warm up closes preceding the window are rebased against the first visible close
+		base := data[6].Close
+		rebased := make([]OHLCData, len(data))
+		for i, ohlc := range data {
+			rebased[i] = OHLCData{
+				Open:  (ohlc.Open/base - 1) * 100,
+				High:  (ohlc.High/base - 1) * 100,
+				Low:   (ohlc.Low/base - 1) * 100,
+				Close: (ohlc.Close/base - 1) * 100,
+			}
+		}
+		expectedOpt := makeOption()
+		expectedOpt.SeriesList[0].Data = rebased
+		expectedYs, _ := overlayY(t, expectedOpt)
+
+		assert.Len(t, ys, len(data)-6)
+		assert.Equal(t, expectedYs, ys)
+		// pre-history is rebased with the visible candles so the pattern across the window start is still detected
+		assert.Contains(t, svg, "Morning Star")
+	})
+
+	t.Run("end_bound", func(t *testing.T) {
+		opt := makeOption()
+		opt.VisibleRange = &IndexRange{Start: 1, End: 3}
+		svg := renderCandlestickSVG(t, opt)
+
+		assert.NotContains(t, svg, `data-bar="0"`)
+		assert.Contains(t, svg, `data-bar="1"`)
+		assert.Contains(t, svg, `data-bar="2"`)
+		assert.NotContains(t, svg, `data-bar="3"`)
+		assert.NotContains(t, svg, "Visible")
+	})
+}
+
+func TestIndexRangeBounds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		r             IndexRange
+		length        int
+		expectedStart int
+		expectedEnd   int
+	}{
+		{"full", IndexRange{}, 10, 0, 10},
+		{"start_only", IndexRange{Start: 4}, 10, 4, 10},
+		{"start_end", IndexRange{Start: 2, End: 5}, 10, 2, 5},
+		{"end_past_length", IndexRange{Start: 2, End: 50}, 10, 2, 10},
+		{"negative_start", IndexRange{Start: -3, End: 5}, 10, 0, 5},
+		{"start_past_end", IndexRange{Start: 8, End: 5}, 10, 5, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.r.bounds(tt.length)
+			assert.Equal(t, tt.expectedStart, start)
+			assert.Equal(t, tt.expectedEnd, end)
+		})
+	}
+}
+
+func TestCandlestickMultiSeriesLayout(t *testing.T) {
+	t.Parallel()
+
+	seriesA := []OHLCData{
+		{Open: 100, High: 110, Low: 95, Close: 105},
+		{Open: 105, High: 108, Low: 96, Close: 98},
+		{Open: 98, High: 112, Low: 97, Close: 110},
+	}
+	seriesB := []OHLCData{
+		{Open: 102, High: 109, Low: 99, Close: 100},
+		{Open: 100, High: 111, Low: 98, Close: 108},
+		{Open: 108, High: 113, Low: 101, Close: 103},
+	}
+	upDown := [][2]Color{
+		{ColorRGB(0, 150, 0), ColorRGB(150, 0, 0)},
+		{ColorRGB(0, 0, 200), ColorRGB(200, 120, 0)},
+	}
+	opt := CandlestickChartOption{
+		Theme:   GetDefaultTheme().WithSeriesUpDownColors(upDown),
+		Padding: NewBoxEqual(0),
+		XAxis:   XAxisOption{Labels: []string{"A", "B", "C"}},
+		YAxis:   make([]YAxisOption, 1),
+		SeriesList: CandlestickSeriesList{
+			{Data: seriesA, Name: "A"},
+			{Data: seriesB, Name: "B"},
+		},
+	}
+	for si := range opt.SeriesList {
+		opt.SeriesList[si].CandleMetadata = make([]map[string]string, 3)
+		for i := range opt.SeriesList[si].CandleMetadata {
+			opt.SeriesList[si].CandleMetadata[i] = map[string]string{"candle": fmt.Sprintf("%d-%d", si, i)}
+		}
+	}
+
+	svg := renderCandlestickSVG(t, opt)
+
+	// horizontal extent of all elements drawn for a candle
+	extent := func(series, index int) (int, int) {
+		re := regexp.MustCompile(`<path data-candle="` + fmt.Sprintf("%d-%d", series, index) + `" d="([^"]+)"`)
+		matches := re.FindAllStringSubmatch(svg, -1)
+		require.NotEmpty(t, matches)
+		minX, maxX := math.MaxInt, math.MinInt
+		for _, m := range matches {
+			for _, coords := range regexp.MustCompile(`[ML] (\d+) \d+`).FindAllStringSubmatch(m[1], -1) {
+				x, err := strconv.Atoi(coords[1])
+				require.NoError(t, err)
+				minX, maxX = min(minX, x), max(maxX, x)
+			}
+		}
+		return minX, maxX
+	}
+	var prevBMax int
+	for i := range seriesA {
+		aMin, aMax := extent(0, i)
+		bMin, bMax := extent(1, i)
+		assert.Less(t, aMax, bMin, "series A should be left of series B at index %d", i)
+		assert.Greater(t, aMin, prevBMax, "candles should stay within their x band at index %d", i)
+		prevBMax = bMax
+	}
+
+	// each series uses its own up and down colors
+	assert.Contains(t, svg, "fill:rgb(0,150,0)")
+	assert.Contains(t, svg, "fill:rgb(150,0,0)")
+	assert.Contains(t, svg, "fill:rgb(0,0,200)")
+	assert.Contains(t, svg, "fill:rgb(200,120,0)")
+}
+
+func TestCandlestickPatternLegend(t *testing.T) {
+	t.Parallel()
+
+	// keyEntries returns the text rendered in the same column as the first expected key entry.
+	textPattern := regexp.MustCompile(`<text x="(\d+)" y="\d+" [^>]*>([^<]*)</text>`)
+	keyEntries := func(svg, first string) []string {
+		matches := textPattern.FindAllStringSubmatch(svg, -1)
+		var column string
+		for _, m := range matches {
+			if m[2] == first {
+				column = m[1]
+				break
+			}
+		}
+		var entries []string
+		for _, m := range matches {
+			if column != "" && m[1] == column {
+				entries = append(entries, m[2])
+			}
+		}
+		return entries
+	}
+	makePatternOption := func(config *CandlestickPatternConfig) CandlestickChartOption {
+		opt := makeBasicCandlestickChartOption()
+		opt.SeriesList[0].PatternConfig = config
+		opt.ShowPatternLegend = Ptr(true)
+		return opt
+	}
+
+	t.Run("enabled_patterns", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithHammer().WithDoji().WithEngulfingBull().WithShootingStar()
+		config.PatternGlyphs = map[string]string{candlestickPatternDoji: "◎"}
+		svg := renderCandlestickSVG(t, makePatternOption(config))
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Equal(t, []string{"Γ Hammer", "◎ Doji", "Λ Bullish Engulfing", "※ Shooting Star"},
+			keyEntries(svg, "Γ Hammer"))
+	})
+
+	t.Run("direction_filter", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithHammer().WithDoji().WithEngulfingBull().WithShootingStar()
+		config.DirectionFilter = DirectionBullishOnly
+		svg := renderCandlestickSVG(t, makePatternOption(config))
+
+		assert.Equal(t, []string{"Γ Hammer", "Λ Bullish Engulfing"}, keyEntries(svg, "Γ Hammer"))
+	})
+
+	t.Run("no_enabled_patterns", func(t *testing.T) {
+		opt := makePatternOption(&CandlestickPatternConfig{})
+		withKey := renderCandlestickSVG(t, opt)
+		opt.ShowPatternLegend = nil
+
+		assert.Equal(t, renderCandlestickSVG(t, opt), withKey)
+	})
+}
+
+func TestCandlestickPatternLabelPlacement(t *testing.T) {
+	t.Parallel()
+
+	// a single hammer (bullish) at index 2 and shooting star (bearish) at index 4
+	makePlacementOption := func(placement PatternLabelPlacement) (CandlestickChartOption, *CandlestickLayout) {
+		data := []OHLCData{
+			{Open: 110, High: 112, Low: 105, Close: 106},
+			{Open: 106, High: 107, Low: 101, Close: 102},
+			{Open: 101, High: 101.5, Low: 92, Close: 101.2},
+			{Open: 101, High: 108, Low: 100, Close: 107},
+			{Open: 108.5, High: 118, Low: 108, Close: 108.2},
+		}
+		layout := &CandlestickLayout{}
+		opt := CandlestickChartOption{
+			Padding:    NewBoxEqual(10),
+			XAxis:      XAxisOption{Labels: []string{"1", "2", "3", "4", "5"}},
+			SeriesList: NewSeriesListCandlestick([][]OHLCData{data}),
+			LayoutCallback: func(l CandlestickLayout) {
+				*layout = l
+			},
+		}
+		opt.SeriesList[0].PatternConfig = (&CandlestickPatternConfig{}).
+			WithHammer().WithShootingStar().WithLabelPlacement(placement)
+		return opt, layout
+	}
+	labelY := func(t *testing.T, svg, text string) int {
+		t.Helper()
+
+		m := regexp.MustCompile(`<text x="\d+" y="(\d+)" [^>]*>` + regexp.QuoteMeta(text) + `</text>`).
+			FindStringSubmatch(svg)
+		require.NotNil(t, m, text)
+		y, err := strconv.Atoi(m[1])
+		require.NoError(t, err)
+		return y
+	}
+
+	t.Run("forced_above", func(t *testing.T) {
+		opt, layout := makePlacementOption(PatternLabelAbove)
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Less(t, labelY(t, svg, "Γ Hammer"), layout.Candles[0][2].High.Y)
+		assert.Less(t, labelY(t, svg, "※ Shooting Star"), layout.Candles[0][4].High.Y)
+	})
+
+	t.Run("forced_below", func(t *testing.T) {
+		opt, layout := makePlacementOption(PatternLabelBelow)
+		svg := renderCandlestickSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		// the label top, a font height above the baseline, clears the candle low
+		const fontHeight = 10
+		assert.Greater(t, labelY(t, svg, "Γ Hammer")-fontHeight, layout.Candles[0][2].Low.Y)
+		assert.Greater(t, labelY(t, svg, "※ Shooting Star")-fontHeight, layout.Candles[0][4].Low.Y)
+	})
+
+	t.Run("auto", func(t *testing.T) {
+		opt, layout := makePlacementOption(PatternLabelAuto)
+		svg := renderCandlestickSVG(t, opt)
+
+		assert.Greater(t, labelY(t, svg, "Γ Hammer"), layout.Candles[0][2].Low.Y)
+		assert.Less(t, labelY(t, svg, "※ Shooting Star"), layout.Candles[0][4].High.Y)
+	})
+
+	t.Run("beside_default", func(t *testing.T) {
+		opt, layout := makePlacementOption(PatternLabelBeside)
+		svg := renderCandlestickSVG(t, opt)
+
+		y := labelY(t, svg, "Γ Hammer")
+		assert.Greater(t, y, layout.Candles[0][2].High.Y)
+		assert.Less(t, y, layout.Candles[0][2].Low.Y)
+	})
+}
+
+func TestCandlestickWickWidth(t *testing.T) {
+	t.Parallel()
+
+	render := func(wickWidth float64) string {
+		opt := makeMinimalCandlestickChartOption()
+		opt.WickWidth = wickWidth
+		return renderCandlestickSVG(t, opt)
+	}
+	upColor, downColor := GetTheme(ThemeVividLight).GetSeriesUpDownColors(0)
+	candleColors := `(` + regexp.QuoteMeta(upColor.String()) + `|` + regexp.QuoteMeta(downColor.String()) + `)`
+	wickPattern := func(width string) *regexp.Regexp {
+		return regexp.MustCompile(`<path d="M \d+ \d+\nL \d+ \d+" style="stroke-width:` + width + `;stroke:` +
+			candleColors + `;fill:none"/>`)
+	}
+	bodyPattern := regexp.MustCompile(`<path d="[^"]*" style="stroke:none;fill:` + candleColors + `"/>`)
+
+	svg := render(3)
+	assertTestdataSVG(t, []byte(svg))
+	defaultSVG := render(0)
+
+	// each candle draws an upper and lower wick with a cap at the high and low
+	dataCount := len(makeBasicCandlestickData())
+	assert.Len(t, wickPattern("3").FindAllString(svg, -1), 4*dataCount)
+	assert.Empty(t, wickPattern("1").FindAllString(svg, -1))
+	assert.Len(t, wickPattern("1").FindAllString(defaultSVG, -1), 4*dataCount)
+	bodies := bodyPattern.FindAllString(svg, -1)
+	assert.Len(t, bodies, dataCount)
+	assert.Equal(t, bodyPattern.FindAllString(defaultSVG, -1), bodies)
+}
@@ -0,0 +1,51 @@
+package charts
+
+// CandlestickLayout describes where each candle was drawn, in canvas pixel coordinates, so that custom shapes can be
+// drawn aligned to the candles or the rendered output post-processed. It is provided to
+// CandlestickChartOption.LayoutCallback once the chart is rendered.
+type CandlestickLayout struct {
+	// PlotBox is the area of the canvas the candles are drawn within.
+	PlotBox Box
+	// Candles contains the geometry of each candle, indexed by series and then data index.
+	Candles [][]CandleGeometry
+
+	// yRanges are the value axis ranges, positioned relative to PlotBox.
+	yRanges map[int]axisRange
+}
+
+// CandlestickLayoutCallback receives the layout of a rendered candlestick chart.
+type CandlestickLayoutCallback func(CandlestickLayout)
+
+// CandleGeometry is the rendered position of a single candle in canvas pixel coordinates.
+type CandleGeometry struct {
+	// Valid is false when the candle data is invalid and nothing was drawn, other fields are then zero.
+	Valid bool
+	// CenterX is the horizontal center of the candle, where the wick is drawn.
+	CenterX int
+	// Body is the rectangle spanning the open and close prices. A doji body has no height.
+	Body Box
+	// High is the top of the upper wick, at the high price.
+	High Point
+	// Low is the bottom of the lower wick, at the low price.
+	Low Point
+}
+
+// ValueToY returns the canvas y coordinate of the value on the y-axis with the given index. The plot bottom is
+// returned when the axis does not exist.
+func (l CandlestickLayout) ValueToY(yAxisIndex int, value float64) int {
+	yRange, ok := l.yRanges[yAxisIndex]
+	if !ok {
+		return l.PlotBox.Bottom
+	}
+	return l.PlotBox.Top + yRange.getRestHeight(value)
+}
+
+// YToValue returns the value on the y-axis with the given index at the canvas y coordinate, or a null value when the
+// axis does not exist.
+func (l CandlestickLayout) YToValue(yAxisIndex int, y int) float64 {
+	yRange, ok := l.yRanges[yAxisIndex]
+	if !ok {
+		return GetNullValue()
+	}
+	return yRange.getRestValue(y - l.PlotBox.Top)
+}
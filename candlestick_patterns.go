@@ -32,10 +32,22 @@ const (
 	candlestickPatternEngulfingBull = "engulfing_bull"
 	// candlestickPatternEngulfingBear represents a bearish engulfing pattern where a large bearish candle engulfs the previous bullish candle.
 	candlestickPatternEngulfingBear = "engulfing_bear"
+	// candlestickPatternHaramiBull represents a bullish harami where a small bullish body is contained within the previous large bearish body.
+	candlestickPatternHaramiBull = "harami_bull"
+	// candlestickPatternHaramiBear represents a bearish harami where a small bearish body is contained within the previous large bullish body.
+	candlestickPatternHaramiBear = "harami_bear"
+	// candlestickPatternTweezerTop represents a tweezer top where a bullish candle and following bearish candle share the same high, signaling potential bearish reversal.
+	candlestickPatternTweezerTop = "tweezer_top"
+	// candlestickPatternTweezerBottom represents a tweezer bottom where a bearish candle and following bullish candle share the same low, signaling potential bullish reversal.
+	candlestickPatternTweezerBottom = "tweezer_bottom"
 	// candlestickPatternPiercingLine represents a piercing line where a bullish candle closes above the midpoint of the previous bearish candle.
 	candlestickPatternPiercingLine = "piercing_line"
 	// candlestickPatternDarkCloudCover represents a dark cloud cover where a bearish candle closes below the midpoint of the previous bullish candle.
 	candlestickPatternDarkCloudCover = "dark_cloud_cover"
+	// candlestickPatternKickerBull represents a bullish kicker where a bearish candle is followed by a bullish candle gapping entirely above its body, signaling strong bullish reversal.
+	candlestickPatternKickerBull = "kicker_bull"
+	// candlestickPatternKickerBear represents a bearish kicker where a bullish candle is followed by a bearish candle gapping entirely below its body, signaling strong bearish reversal.
+	candlestickPatternKickerBear = "kicker_bear"
 
 	/** Three candle patterns **/
 
@@ -43,6 +55,91 @@ const (
 	candlestickPatternMorningStar = "morning_star"
 	// candlestickPatternEveningStar represents a bearish evening star pattern with a doji or small candle between two opposite-colored candles.
 	candlestickPatternEveningStar = "evening_star"
+	// candlestickPatternThreeWhiteSoldiers represents three consecutive bullish candles each opening within the prior body and closing higher, signaling bullish continuation.
+	candlestickPatternThreeWhiteSoldiers = "three_white_soldiers"
+	// candlestickPatternThreeBlackCrows represents three consecutive bearish candles each opening within the prior body and closing lower, signaling bearish continuation.
+	candlestickPatternThreeBlackCrows = "three_black_crows"
+	// candlestickPatternAbandonedBabyBull represents a bullish abandoned baby where a doji gaps below a bearish candle and a bullish candle gaps back above the doji.
+	candlestickPatternAbandonedBabyBull = "abandoned_baby_bull"
+	// candlestickPatternAbandonedBabyBear represents a bearish abandoned baby where a doji gaps above a bullish candle and a bearish candle gaps back below the doji.
+	candlestickPatternAbandonedBabyBear = "abandoned_baby_bear"
+	// candlestickPatternTriStarBull represents a bullish tri-star of three dojis where the middle doji gaps below the other two, signaling potential bullish reversal.
+	candlestickPatternTriStarBull = "tri_star_bull"
+	// candlestickPatternTriStarBear represents a bearish tri-star of three dojis where the middle doji gaps above the other two, signaling potential bearish reversal.
+	candlestickPatternTriStarBear = "tri_star_bear"
+)
+
+// PatternDirectionFilter restricts pattern detection to signals with a specific market bias.
+type PatternDirectionFilter int
+
+const (
+	// DirectionAny labels all enabled patterns regardless of their direction.
+	DirectionAny PatternDirectionFilter = iota
+	// DirectionBullishOnly labels only bullish reversal and continuation patterns.
+	DirectionBullishOnly
+	// DirectionBearishOnly labels only bearish reversal and continuation patterns.
+	DirectionBearishOnly
+)
+
+// patternDirection is the market bias a pattern signals.
+type patternDirection int
+
+const (
+	patternDirectionNeutral patternDirection = iota
+	patternDirectionBullish
+	patternDirectionBearish
+)
+
+// allows reports if a pattern with the provided direction passes the filter.
+func (f PatternDirectionFilter) allows(direction patternDirection) bool {
+	switch f {
+	case DirectionBullishOnly:
+		return direction == patternDirectionBullish
+	case DirectionBearishOnly:
+		return direction == patternDirectionBearish
+	default:
+		return true
+	}
+}
+
+// PatternOverlapStrategy determines which patterns are kept when multiple patterns are detected on the same candle.
+type PatternOverlapStrategy int
+
+const (
+	// OverlapShowAll keeps every pattern detected on a candle.
+	OverlapShowAll PatternOverlapStrategy = iota
+	// OverlapHighestConfidence keeps only the pattern with the highest confidence on each candle.
+	OverlapHighestConfidence
+	// OverlapPriorityOrder keeps only the pattern ranked first in CandlestickPatternConfig.PatternPriority on each
+	// candle. Patterns missing from the priority list rank below listed patterns, in EnabledPatterns order.
+	OverlapPriorityOrder
+)
+
+// ShadowToleranceMode selects the units CandlestickPatternConfig.ShadowTolerance is measured in.
+type ShadowToleranceMode int
+
+const (
+	// ShadowToleranceRelative measures the combined shadows as a ratio of the candle high-low range.
+	ShadowToleranceRelative ShadowToleranceMode = iota
+	// ShadowToleranceAbsolute measures the combined shadows in price units, for example 0.10 allows ten cents of
+	// shadow regardless of the candle size. An unset ShadowTolerance still defaults to 0.01, which is then a price,
+	// so the tolerance should be set to suit the price scale of the data.
+	ShadowToleranceAbsolute
+)
+
+// PatternLabelPlacement selects where pattern labels are drawn relative to their candle.
+type PatternLabelPlacement int
+
+const (
+	// PatternLabelBeside draws the label to the right of the candle close.
+	PatternLabelBeside PatternLabelPlacement = iota
+	// PatternLabelAuto draws the label below the candle low when the candle patterns are predominantly bullish, and
+	// above the candle high otherwise.
+	PatternLabelAuto
+	// PatternLabelAbove draws the label centered above the candle high.
+	PatternLabelAbove
+	// PatternLabelBelow draws the label centered below the candle low.
+	PatternLabelBelow
 )
 
 // PatternFormatter allows custom formatting of detected patterns.
@@ -63,25 +160,111 @@ type CandlestickPatternConfig struct {
 	// Use With* methods to configure patterns
 	EnabledPatterns []string
 
+	// DirectionFilter limits labeled patterns to those matching the desired bias. Neutral patterns (for example doji)
+	// are excluded by both DirectionBullishOnly and DirectionBearishOnly.
+	// Default: DirectionAny
+	DirectionFilter PatternDirectionFilter
+
+	// OverlapStrategy determines which patterns are kept when several are detected on the same candle.
+	// Default: OverlapShowAll
+	OverlapStrategy PatternOverlapStrategy
+
+	// PatternPriority ranks pattern types (for example "gravestone_doji") from most to least important, used when
+	// OverlapStrategy is OverlapPriorityOrder.
+	PatternPriority []string
+
 	// DojiThreshold is the body-to-range ratio threshold for doji pattern detection.
 	// Default: 0.05 (5% - standard textbook definition)
 	// A candlestick where the body is ≤5% of the total range is considered a doji.
 	DojiThreshold float64
 
-	// ShadowTolerance is the shadow-to-range ratio threshold for patterns requiring minimal shadows.
-	// Used by marubozu patterns to determine acceptable shadow size.
-	// Default: 0.01 (1% of range)
+	// ShadowTolerance is the maximum combined upper and lower shadow for patterns requiring minimal shadows.
+	// Used by marubozu patterns to determine acceptable shadow size. The units are set by ShadowToleranceMode.
+	// Default: 0.01 (1% of range, or 0.01 in price units with ShadowToleranceAbsolute)
 	ShadowTolerance float64
 
+	// ShadowToleranceMode selects whether ShadowTolerance is a ratio of the candle range or an absolute price.
+	// Default: ShadowToleranceRelative
+	ShadowToleranceMode ShadowToleranceMode
+
 	// ShadowRatio is the minimum shadow-to-body ratio for patterns requiring long shadows.
 	// Used by hammer, shooting star, and similar patterns.
 	// Default: 2.0 (shadow must be at least 2x the body - standard textbook definition)
 	ShadowRatio float64
 
+	// GravestoneShadowRatio overrides ShadowRatio for gravestone doji detection, setting the minimum upper
+	// shadow-to-body ratio independently of hammer and shooting star patterns.
+	// Default: 0 (ShadowRatio is used)
+	GravestoneShadowRatio float64
+
+	// DragonflyShadowRatio overrides ShadowRatio for dragonfly doji detection, setting the minimum lower
+	// shadow-to-body ratio independently of hammer and shooting star patterns.
+	// Default: 0 (ShadowRatio is used)
+	DragonflyShadowRatio float64
+
 	// EngulfingMinSize is the minimum size ratio for engulfing patterns.
 	// The engulfing candle body must be at least this percentage of the engulfed candle body.
 	// Default: 1.0 (100% - must completely engulf the previous body)
 	EngulfingMinSize float64
+
+	// HaramiMaxSize is the maximum size ratio for harami patterns.
+	// The contained candle body must be at most this percentage of the previous candle body.
+	// Default: 0.5 (50% - the current body must be at most half of the previous body)
+	HaramiMaxSize float64
+
+	// TweezerTolerance is the maximum difference between the matched highs or lows of tweezer patterns, as a ratio
+	// of the price.
+	// Default: 0.001 (0.1% of the price)
+	TweezerTolerance float64
+
+	// TrendLookback requires reversal patterns to follow a trend over this many prior closes. Bullish reversals
+	// (hammer, inverted hammer, dragonfly doji, bullish engulfing, bullish harami, tweezer bottom, piercing line,
+	// bullish kicker, morning star, bullish abandoned baby, and bullish tri-star) require a preceding downtrend.
+	// Bearish reversals (shooting star, gravestone doji, bearish engulfing, bearish harami, tweezer top, dark cloud
+	// cover, bearish kicker, evening star, bearish abandoned baby, and bearish tri-star) require a preceding uptrend.
+	// The trend is measured as the net change across the closes before the first candle of the pattern; if there
+	// is not enough prior data, gated patterns are not detected (see PreHistory).
+	// Default: 0 (no trend requirement)
+	TrendLookback int
+
+	// MinConfidence drops detected patterns with a PatternDetectionResult.Confidence below this value (0.0-1.0).
+	// Default: 0 (all detected patterns are labeled)
+	MinConfidence float64
+
+	// SoldierMinBodyRatio is the minimum body-to-range ratio for each candle of three white soldiers and three
+	// black crows patterns, rejecting doji-like candles within the sequence.
+	// Default: 0.5 (50% - each body must cover at least half of the candle range)
+	SoldierMinBodyRatio float64
+
+	// DetectOnRawData when true detects patterns on the series data as provided, rather than the data produced by
+	// CandlestickSeries.Transform (for example Heikin-Ashi candles). Has no effect when no transform is set.
+	// Default: false (patterns are detected on the rendered candles)
+	DetectOnRawData bool
+
+	// PatternStyles overrides the label style for specific pattern types (for example "doji"). Unset fields of the
+	// override use the default pattern label style. When several patterns are labeled on one candle, the style of
+	// the first overridden pattern is used.
+	PatternStyles map[string]LabelStyle
+
+	// PatternGlyphs overrides the symbol displayed before the pattern name for specific pattern types. An empty
+	// glyph displays the name only.
+	PatternGlyphs map[string]string
+
+	// LabelFontSize sets the font size of pattern labels, allowing the labels to be scaled with the chart. A
+	// PatternStyles font size takes precedence for that pattern.
+	// Default: 10
+	LabelFontSize float64
+
+	// LabelPlacement sets where pattern labels are drawn relative to the candle. Placing labels above or below the
+	// candle keeps them clear of the candle body and wicks. MergePatterns treats PatternLabelBeside as unset, taking
+	// the placement of the other config.
+	// Default: PatternLabelBeside
+	LabelPlacement PatternLabelPlacement
+
+	// PreHistory provides bars which precede the first charted data point. These bars are not rendered, but allow
+	// multi-candle patterns near the start of the visible data to look back past the chart window. This is useful
+	// when charting a window of a longer series. Detections which include pre-history bars are marked as Partial.
+	PreHistory []OHLCData
 }
 
 // MergePatterns creates a new CandlestickPatternConfig by combining the enabled patterns config with another.
@@ -122,27 +305,96 @@ func (c *CandlestickPatternConfig) MergePatterns(other *CandlestickPatternConfig
 	if dojiThreshold <= 0 {
 		dojiThreshold = other.DojiThreshold
 	}
-	shadowTolerance := c.ShadowTolerance
-	if shadowTolerance <= 0 {
-		shadowTolerance = other.ShadowTolerance
+	shadowTolerance, shadowToleranceMode := c.ShadowTolerance, c.ShadowToleranceMode
+	if shadowTolerance <= 0 { // mode follows the tolerance so the value keeps its units
+		shadowTolerance, shadowToleranceMode = other.ShadowTolerance, other.ShadowToleranceMode
 	}
 	shadowRatio := c.ShadowRatio
 	if shadowRatio <= 0 {
 		shadowRatio = other.ShadowRatio
 	}
+	gravestoneShadowRatio := c.GravestoneShadowRatio
+	if gravestoneShadowRatio <= 0 {
+		gravestoneShadowRatio = other.GravestoneShadowRatio
+	}
+	dragonflyShadowRatio := c.DragonflyShadowRatio
+	if dragonflyShadowRatio <= 0 {
+		dragonflyShadowRatio = other.DragonflyShadowRatio
+	}
 	engulfingMinSize := c.EngulfingMinSize
 	if engulfingMinSize <= 0 {
 		engulfingMinSize = other.EngulfingMinSize
 	}
+	tweezerTolerance := c.TweezerTolerance
+	if tweezerTolerance <= 0 {
+		tweezerTolerance = other.TweezerTolerance
+	}
+	soldierMinBodyRatio := c.SoldierMinBodyRatio
+	if soldierMinBodyRatio <= 0 {
+		soldierMinBodyRatio = other.SoldierMinBodyRatio
+	}
+	trendLookback := c.TrendLookback
+	if trendLookback <= 0 {
+		trendLookback = other.TrendLookback
+	}
+	minConfidence := c.MinConfidence
+	if minConfidence <= 0 {
+		minConfidence = other.MinConfidence
+	}
+	preHistory := c.PreHistory
+	if len(preHistory) == 0 {
+		preHistory = other.PreHistory
+	}
+	patternPriority := c.PatternPriority
+	if len(patternPriority) == 0 {
+		patternPriority = other.PatternPriority
+	}
+	haramiMaxSize := c.HaramiMaxSize
+	if haramiMaxSize <= 0 {
+		haramiMaxSize = other.HaramiMaxSize
+	}
+	patternStyles := c.PatternStyles
+	if patternStyles == nil {
+		patternStyles = other.PatternStyles
+	}
+	patternGlyphs := c.PatternGlyphs
+	if patternGlyphs == nil {
+		patternGlyphs = other.PatternGlyphs
+	}
+	labelFontSize := c.LabelFontSize
+	if labelFontSize <= 0 {
+		labelFontSize = other.LabelFontSize
+	}
+	labelPlacement := c.LabelPlacement
+	if labelPlacement == PatternLabelBeside {
+		labelPlacement = other.LabelPlacement
+	}
 
 	return &CandlestickPatternConfig{
-		PreferPatternLabels: c.PreferPatternLabels,
-		EnabledPatterns:     mergedPatterns,
-		PatternFormatter:    c.PatternFormatter,
-		DojiThreshold:       dojiThreshold,
-		ShadowTolerance:     shadowTolerance,
-		ShadowRatio:         shadowRatio,
-		EngulfingMinSize:    engulfingMinSize,
+		PreferPatternLabels:   c.PreferPatternLabels,
+		EnabledPatterns:       mergedPatterns,
+		PatternFormatter:      c.PatternFormatter,
+		DirectionFilter:       c.DirectionFilter,
+		OverlapStrategy:       c.OverlapStrategy,
+		LabelPlacement:        labelPlacement,
+		PatternPriority:       slices.Clone(patternPriority),
+		DetectOnRawData:       c.DetectOnRawData,
+		DojiThreshold:         dojiThreshold,
+		ShadowTolerance:       shadowTolerance,
+		ShadowToleranceMode:   shadowToleranceMode,
+		ShadowRatio:           shadowRatio,
+		GravestoneShadowRatio: gravestoneShadowRatio,
+		DragonflyShadowRatio:  dragonflyShadowRatio,
+		EngulfingMinSize:      engulfingMinSize,
+		HaramiMaxSize:         haramiMaxSize,
+		TweezerTolerance:      tweezerTolerance,
+		TrendLookback:         trendLookback,
+		MinConfidence:         minConfidence,
+		SoldierMinBodyRatio:   soldierMinBodyRatio,
+		PatternStyles:         patternStyles,
+		PatternGlyphs:         patternGlyphs,
+		LabelFontSize:         labelFontSize,
+		PreHistory:            preHistory,
 	}
 }
 
@@ -154,6 +406,24 @@ type PatternDetectionResult struct {
 	PatternName string
 	// PatternType is the identifier constant (e.g., CandlestickPatternDoji).
 	PatternType string
+	// Anchor is the data space coordinate the pattern label is rendered at, allowing external annotations
+	// to be aligned with the detection.
+	Anchor PatternAnchor
+	// Partial is true when some of the candles forming the pattern come from CandlestickPatternConfig.PreHistory
+	// rather than the charted data.
+	Partial bool
+	// Confidence is how strongly the candles satisfy the pattern thresholds, from 0.5 for a match just meeting the
+	// thresholds up to 1.0 for a textbook example.
+	Confidence float64
+}
+
+// PatternAnchor is a data space coordinate, combining a series data index with a price.
+type PatternAnchor struct {
+	// Index is the series data point position.
+	Index int
+	// Price is the value on the y-axis. Pattern labels are anchored to the final candle, at the close when placed
+	// beside it, the high when placed above, and the low when placed below (see LabelPlacement).
+	Price float64
 }
 
 // addPattern is a helper to add a pattern to the list if not already present.
@@ -181,11 +451,18 @@ func (c *CandlestickPatternConfig) WithPatternsAll() *CandlestickPatternConfig {
 		// Strong reversal patterns
 		candlestickPatternEngulfingBull, candlestickPatternEngulfingBear, candlestickPatternHammer,
 		candlestickPatternMorningStar, candlestickPatternEveningStar, candlestickPatternShootingStar,
+		candlestickPatternAbandonedBabyBull, candlestickPatternAbandonedBabyBear,
+		candlestickPatternKickerBull, candlestickPatternKickerBear,
 		// Moderate patterns
 		candlestickPatternDarkCloudCover, candlestickPatternDragonfly, candlestickPatternGravestone,
 		candlestickPatternMarubozuBear, candlestickPatternMarubozuBull, candlestickPatternPiercingLine,
+		candlestickPatternInvertedHammer, candlestickPatternHaramiBull, candlestickPatternHaramiBear,
+		candlestickPatternTweezerTop, candlestickPatternTweezerBottom,
+		candlestickPatternTriStarBull, candlestickPatternTriStarBear,
+		// Continuation patterns
+		candlestickPatternThreeWhiteSoldiers, candlestickPatternThreeBlackCrows,
 		// Neutral/indecision patterns
-		candlestickPatternDoji, candlestickPatternInvertedHammer,
+		candlestickPatternDoji,
 	)
 	return c
 }
@@ -205,7 +482,9 @@ func (c *CandlestickPatternConfig) WithPatternsBullish() *CandlestickPatternConf
 	c.addPatterns(
 		candlestickPatternHammer, candlestickPatternInvertedHammer, candlestickPatternDragonfly,
 		candlestickPatternMarubozuBull, candlestickPatternEngulfingBull, candlestickPatternPiercingLine,
-		candlestickPatternMorningStar,
+		candlestickPatternHaramiBull, candlestickPatternTweezerBottom, candlestickPatternKickerBull,
+		candlestickPatternMorningStar, candlestickPatternAbandonedBabyBull, candlestickPatternTriStarBull,
+		candlestickPatternThreeWhiteSoldiers,
 	)
 	return c
 }
@@ -214,7 +493,9 @@ func (c *CandlestickPatternConfig) WithPatternsBullish() *CandlestickPatternConf
 func (c *CandlestickPatternConfig) WithPatternsBearish() *CandlestickPatternConfig {
 	c.addPatterns(
 		candlestickPatternShootingStar, candlestickPatternGravestone, candlestickPatternMarubozuBear,
-		candlestickPatternEngulfingBear, candlestickPatternDarkCloudCover, candlestickPatternEveningStar,
+		candlestickPatternEngulfingBear, candlestickPatternDarkCloudCover, candlestickPatternHaramiBear,
+		candlestickPatternTweezerTop, candlestickPatternKickerBear, candlestickPatternEveningStar,
+		candlestickPatternAbandonedBabyBear, candlestickPatternTriStarBear, candlestickPatternThreeBlackCrows,
 	)
 	return c
 }
@@ -228,8 +509,13 @@ func (c *CandlestickPatternConfig) WithPatternsReversal() *CandlestickPatternCon
 		// Two candle reversals
 		candlestickPatternEngulfingBull, candlestickPatternEngulfingBear,
 		candlestickPatternPiercingLine, candlestickPatternDarkCloudCover,
+		candlestickPatternHaramiBull, candlestickPatternHaramiBear,
+		candlestickPatternTweezerTop, candlestickPatternTweezerBottom,
+		candlestickPatternKickerBull, candlestickPatternKickerBear,
 		// Three candle reversals
 		candlestickPatternMorningStar, candlestickPatternEveningStar,
+		candlestickPatternAbandonedBabyBull, candlestickPatternAbandonedBabyBear,
+		candlestickPatternTriStarBull, candlestickPatternTriStarBear,
 	)
 	return c
 }
@@ -238,6 +524,7 @@ func (c *CandlestickPatternConfig) WithPatternsReversal() *CandlestickPatternCon
 func (c *CandlestickPatternConfig) WithPatternsTrend() *CandlestickPatternConfig {
 	c.addPatterns(
 		candlestickPatternMarubozuBull, candlestickPatternMarubozuBear,
+		candlestickPatternThreeWhiteSoldiers, candlestickPatternThreeBlackCrows,
 	)
 	return c
 }
@@ -302,6 +589,30 @@ func (c *CandlestickPatternConfig) WithEngulfingBear() *CandlestickPatternConfig
 	return c
 }
 
+// WithHaramiBull adds the bullish harami pattern.
+func (c *CandlestickPatternConfig) WithHaramiBull() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternHaramiBull)
+	return c
+}
+
+// WithHaramiBear adds the bearish harami pattern.
+func (c *CandlestickPatternConfig) WithHaramiBear() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternHaramiBear)
+	return c
+}
+
+// WithTweezerTop adds the tweezer top pattern.
+func (c *CandlestickPatternConfig) WithTweezerTop() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternTweezerTop)
+	return c
+}
+
+// WithTweezerBottom adds the tweezer bottom pattern.
+func (c *CandlestickPatternConfig) WithTweezerBottom() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternTweezerBottom)
+	return c
+}
+
 // WithPiercingLine adds the piercing line pattern.
 func (c *CandlestickPatternConfig) WithPiercingLine() *CandlestickPatternConfig {
 	c.addPattern(candlestickPatternPiercingLine)
@@ -314,6 +625,18 @@ func (c *CandlestickPatternConfig) WithDarkCloudCover() *CandlestickPatternConfi
 	return c
 }
 
+// WithKickerBull adds the bullish kicker pattern.
+func (c *CandlestickPatternConfig) WithKickerBull() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternKickerBull)
+	return c
+}
+
+// WithKickerBear adds the bearish kicker pattern.
+func (c *CandlestickPatternConfig) WithKickerBear() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternKickerBear)
+	return c
+}
+
 // WithMorningStar adds the morning star pattern.
 func (c *CandlestickPatternConfig) WithMorningStar() *CandlestickPatternConfig {
 	c.addPattern(candlestickPatternMorningStar)
@@ -326,6 +649,73 @@ func (c *CandlestickPatternConfig) WithEveningStar() *CandlestickPatternConfig {
 	return c
 }
 
+// WithTweezerTolerance sets the tweezer high / low matching tolerance (default: 0.001).
+func (c *CandlestickPatternConfig) WithTweezerTolerance(tolerance float64) *CandlestickPatternConfig {
+	c.TweezerTolerance = tolerance
+	return c
+}
+
+// WithTrendLookback sets the number of prior closes reversal patterns must be trending over (default: 0).
+func (c *CandlestickPatternConfig) WithTrendLookback(lookback int) *CandlestickPatternConfig {
+	c.TrendLookback = lookback
+	return c
+}
+
+// WithMinConfidence sets the minimum confidence for detected patterns to be labeled (default: 0).
+func (c *CandlestickPatternConfig) WithMinConfidence(confidence float64) *CandlestickPatternConfig {
+	c.MinConfidence = confidence
+	return c
+}
+
+// WithSoldierMinBodyRatio sets the soldier and crow minimum body ratio (default: 0.5).
+func (c *CandlestickPatternConfig) WithSoldierMinBodyRatio(ratio float64) *CandlestickPatternConfig {
+	c.SoldierMinBodyRatio = ratio
+	return c
+}
+
+// WithPreHistory sets the bars preceding the charted data, used as look back for detecting patterns at the start
+// of the series.
+func (c *CandlestickPatternConfig) WithPreHistory(preHistory []OHLCData) *CandlestickPatternConfig {
+	c.PreHistory = preHistory
+	return c
+}
+
+// WithThreeWhiteSoldiers adds the three white soldiers pattern.
+func (c *CandlestickPatternConfig) WithThreeWhiteSoldiers() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternThreeWhiteSoldiers)
+	return c
+}
+
+// WithThreeBlackCrows adds the three black crows pattern.
+func (c *CandlestickPatternConfig) WithThreeBlackCrows() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternThreeBlackCrows)
+	return c
+}
+
+// WithAbandonedBabyBull adds the bullish abandoned baby pattern.
+func (c *CandlestickPatternConfig) WithAbandonedBabyBull() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternAbandonedBabyBull)
+	return c
+}
+
+// WithAbandonedBabyBear adds the bearish abandoned baby pattern.
+func (c *CandlestickPatternConfig) WithAbandonedBabyBear() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternAbandonedBabyBear)
+	return c
+}
+
+// WithTriStarBull adds the bullish tri-star pattern.
+func (c *CandlestickPatternConfig) WithTriStarBull() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternTriStarBull)
+	return c
+}
+
+// WithTriStarBear adds the bearish tri-star pattern.
+func (c *CandlestickPatternConfig) WithTriStarBear() *CandlestickPatternConfig {
+	c.addPattern(candlestickPatternTriStarBear)
+	return c
+}
+
 // WithPreferPatternLabels sets whether pattern labels have priority over user labels.
 func (c *CandlestickPatternConfig) WithPreferPatternLabels(prefer bool) *CandlestickPatternConfig {
 	c.PreferPatternLabels = prefer
@@ -338,68 +728,377 @@ func (c *CandlestickPatternConfig) WithPatternFormatter(formatter PatternFormatt
 	return c
 }
 
+// WithDirectionFilter sets the direction bias patterns must match to be labeled (default: DirectionAny).
+func (c *CandlestickPatternConfig) WithDirectionFilter(filter PatternDirectionFilter) *CandlestickPatternConfig {
+	c.DirectionFilter = filter
+	return c
+}
+
+// WithOverlapStrategy sets how overlapping patterns on the same candle are resolved.
+func (c *CandlestickPatternConfig) WithOverlapStrategy(strategy PatternOverlapStrategy) *CandlestickPatternConfig {
+	c.OverlapStrategy = strategy
+	return c
+}
+
+// WithPatternPriority sets the pattern ranking and resolves overlapping patterns using it.
+func (c *CandlestickPatternConfig) WithPatternPriority(patterns ...string) *CandlestickPatternConfig {
+	c.PatternPriority = patterns
+	c.OverlapStrategy = OverlapPriorityOrder
+	return c
+}
+
+// WithDetectOnRawData sets whether patterns are detected on the untransformed series data.
+func (c *CandlestickPatternConfig) WithDetectOnRawData(raw bool) *CandlestickPatternConfig {
+	c.DetectOnRawData = raw
+	return c
+}
+
 // WithDojiThreshold sets the doji threshold (default: 0.05).
 func (c *CandlestickPatternConfig) WithDojiThreshold(threshold float64) *CandlestickPatternConfig {
 	c.DojiThreshold = threshold
 	return c
 }
 
-// WithShadowTolerance sets the shadow tolerance (default: 0.01).
+// WithShadowTolerance sets the shadow tolerance (default: 0.01), in the units selected by WithShadowToleranceMode.
 func (c *CandlestickPatternConfig) WithShadowTolerance(tolerance float64) *CandlestickPatternConfig {
 	c.ShadowTolerance = tolerance
 	return c
 }
 
+// WithShadowToleranceMode sets whether the shadow tolerance is relative to the candle range or an absolute price.
+func (c *CandlestickPatternConfig) WithShadowToleranceMode(mode ShadowToleranceMode) *CandlestickPatternConfig {
+	c.ShadowToleranceMode = mode
+	return c
+}
+
 // WithShadowRatio sets the shadow ratio (default: 2.0).
 func (c *CandlestickPatternConfig) WithShadowRatio(ratio float64) *CandlestickPatternConfig {
 	c.ShadowRatio = ratio
 	return c
 }
 
+// WithGravestoneShadowRatio sets the shadow ratio used for gravestone doji detection (default: ShadowRatio).
+func (c *CandlestickPatternConfig) WithGravestoneShadowRatio(ratio float64) *CandlestickPatternConfig {
+	c.GravestoneShadowRatio = ratio
+	return c
+}
+
+// WithDragonflyShadowRatio sets the shadow ratio used for dragonfly doji detection (default: ShadowRatio).
+func (c *CandlestickPatternConfig) WithDragonflyShadowRatio(ratio float64) *CandlestickPatternConfig {
+	c.DragonflyShadowRatio = ratio
+	return c
+}
+
 // WithEngulfingMinSize sets the engulfing minimum size (default: 1.0).
 func (c *CandlestickPatternConfig) WithEngulfingMinSize(size float64) *CandlestickPatternConfig {
 	c.EngulfingMinSize = size
 	return c
 }
 
+// WithHaramiMaxSize sets the harami maximum size (default: 0.5).
+func (c *CandlestickPatternConfig) WithHaramiMaxSize(size float64) *CandlestickPatternConfig {
+	c.HaramiMaxSize = size
+	return c
+}
+
+// WithLabelFontSize sets the pattern label font size (default: 10).
+func (c *CandlestickPatternConfig) WithLabelFontSize(size float64) *CandlestickPatternConfig {
+	c.LabelFontSize = size
+	return c
+}
+
+// WithLabelPlacement sets where pattern labels are drawn relative to the candle (default: PatternLabelBeside).
+func (c *CandlestickPatternConfig) WithLabelPlacement(placement PatternLabelPlacement) *CandlestickPatternConfig {
+	c.LabelPlacement = placement
+	return c
+}
+
+// ScanCandlestickPatterns detects the patterns enabled in the config across the data without rendering a chart. The
+// result maps each data index to the patterns whose final candle is at that index. Detections honor the config
+// thresholds, DirectionFilter, MinConfidence, and PreHistory the same as when the patterns are labeled on a chart.
+// Returns nil if no patterns are enabled.
+//
+// For example, to check the most recent candle for an alert:
+//
+//	cfg := (&CandlestickPatternConfig{}).WithPatternsCore()
+//	if patterns := ScanCandlestickPatterns(data, *cfg)[len(data)-1]; len(patterns) > 0 {
+//		fmt.Println(patterns[0].PatternName)
+//	}
+func ScanCandlestickPatterns(data []OHLCData, cfg CandlestickPatternConfig) map[int][]PatternDetectionResult {
+	return scanForCandlestickPatterns(data, cfg)
+}
+
 // scanForCandlestickPatterns scans entire series upfront for configured patterns (private)
 func scanForCandlestickPatterns(data []OHLCData, config CandlestickPatternConfig) map[int][]PatternDetectionResult {
 	if len(config.EnabledPatterns) == 0 {
 		return nil
 	}
 
+	// pre-history is prepended so detectors can look back, results are shifted back to the charted indexes
+	offset := len(config.PreHistory)
+	if offset > 0 {
+		data = append(slices.Clone(config.PreHistory), data...)
+	}
+
 	patternMap := make(map[int][]PatternDetectionResult)
 	for _, patternType := range config.EnabledPatterns {
 		detector, ok := patternDetectors[patternType]
-		if !ok {
+		if !ok || !config.DirectionFilter.allows(detector.direction) {
 			continue
 		}
 		// Scan series for this specific pattern
-		for i := detector.minCandles - 1; i < len(data); i++ {
-			if detector.detectFunc(data, i, config) {
-				patternMap[i] = append(patternMap[i], PatternDetectionResult{
-					Index:       i,
-					PatternName: detector.patternName,
-					PatternType: patternType,
-				})
+		for i := max(detector.minCandles-1, offset); i < len(data); i++ {
+			if !detector.detectFunc(data, i, config) {
+				continue
 			}
+			confidence := detector.confidenceFunc(data, i, config)
+			if confidence < config.MinConfidence {
+				continue
+			}
+			index := i - offset
+			patternMap[index] = append(patternMap[index], PatternDetectionResult{
+				Index:       index,
+				PatternName: detector.patternName,
+				PatternType: patternType,
+				Partial:     i-detector.minCandles+1 < offset,
+				Confidence:  confidence,
+			})
 		}
 	}
 
+	for index, patterns := range patternMap {
+		if config.OverlapStrategy != OverlapShowAll {
+			patterns = resolvePatternOverlap(patterns, config)
+		}
+		// anchored where the label is drawn, matching the placement resolved by the chart
+		ohlc := data[index+offset]
+		anchor := PatternAnchor{Index: index, Price: ohlc.Close}
+		switch patternLabelPlacement(&config, patterns) {
+		case PatternLabelAbove:
+			anchor.Price = ohlc.High
+		case PatternLabelBelow:
+			anchor.Price = ohlc.Low
+		}
+		for i := range patterns {
+			patterns[i].Anchor = anchor
+		}
+		patternMap[index] = patterns
+	}
 	return patternMap
 }
 
+// resolvePatternOverlap reduces the patterns detected on a single candle to the one selected by the overlap strategy.
+// Patterns are in EnabledPatterns order, which breaks any ties.
+func resolvePatternOverlap(patterns []PatternDetectionResult, config CandlestickPatternConfig) []PatternDetectionResult {
+	if len(patterns) < 2 {
+		return patterns
+	}
+	best := 0
+	switch config.OverlapStrategy {
+	case OverlapHighestConfidence:
+		for i, p := range patterns {
+			if p.Confidence > patterns[best].Confidence {
+				best = i
+			}
+		}
+	case OverlapPriorityOrder:
+		rank := func(p PatternDetectionResult) int {
+			if r := slices.Index(config.PatternPriority, p.PatternType); r >= 0 {
+				return r
+			}
+			return len(config.PatternPriority)
+		}
+		for i, p := range patterns {
+			if rank(p) < rank(patterns[best]) {
+				best = i
+			}
+		}
+	default:
+		return patterns
+	}
+	return patterns[best : best+1]
+}
+
+// detectPatternIndex runs the detector if the index is within the data. The exported Detect* predicates evaluate a
+// single pattern using the config thresholds (and TrendLookback), ignoring EnabledPatterns and PreHistory.
+func detectPatternIndex(detect func([]OHLCData, int, CandlestickPatternConfig) bool,
+	data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return index >= 0 && index < len(data) && detect(data, index, cfg)
+}
+
+// DetectDoji reports if the candle at the index is a doji, where the body is small relative to the range.
+func DetectDoji(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectDojiAt, data, index, cfg)
+}
+
+// DetectHammer reports if the candle at the index is a hammer, a small body at the top of the range with a long lower shadow.
+func DetectHammer(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectHammerAt, data, index, cfg)
+}
+
+// DetectInvertedHammer reports if the candle at the index is an inverted hammer, a small body at the bottom of the range with a long upper shadow.
+func DetectInvertedHammer(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectInvertedHammerAt, data, index, cfg)
+}
+
+// DetectShootingStar reports if the candle at the index is a shooting star, a small body at the bottom of the range with a long upper shadow.
+func DetectShootingStar(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectShootingStarAt, data, index, cfg)
+}
+
+// DetectGravestoneDoji reports if the candle at the index is a gravestone doji, a doji with a long upper shadow and no lower shadow.
+func DetectGravestoneDoji(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectGravestoneDojiAt, data, index, cfg)
+}
+
+// DetectDragonflyDoji reports if the candle at the index is a dragonfly doji, a doji with a long lower shadow and no upper shadow.
+func DetectDragonflyDoji(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectDragonflyDojiAt, data, index, cfg)
+}
+
+// DetectBullishMarubozu reports if the candle at the index is a bullish marubozu, a bullish candle with minimal shadows.
+func DetectBullishMarubozu(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBullishMarubozuAt, data, index, cfg)
+}
+
+// DetectBearishMarubozu reports if the candle at the index is a bearish marubozu, a bearish candle with minimal shadows.
+func DetectBearishMarubozu(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBearishMarubozuAt, data, index, cfg)
+}
+
+// DetectBullishEngulfing reports if the candle at the index is the final candle of a bullish engulfing pattern.
+func DetectBullishEngulfing(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBullishEngulfingAt, data, index, cfg)
+}
+
+// DetectBearishEngulfing reports if the candle at the index is the final candle of a bearish engulfing pattern.
+func DetectBearishEngulfing(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBearishEngulfingAt, data, index, cfg)
+}
+
+// DetectBullishHarami reports if the candle at the index is the final candle of a bullish harami pattern.
+func DetectBullishHarami(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectHaramiAt, data, index, cfg)
+}
+
+// DetectBearishHarami reports if the candle at the index is the final candle of a bearish harami pattern.
+func DetectBearishHarami(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBearishHaramiAt, data, index, cfg)
+}
+
+// DetectTweezerTop reports if the candle at the index is the final candle of a tweezer top pattern.
+func DetectTweezerTop(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectTweezerTopAt, data, index, cfg)
+}
+
+// DetectTweezerBottom reports if the candle at the index is the final candle of a tweezer bottom pattern.
+func DetectTweezerBottom(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectTweezerBottomAt, data, index, cfg)
+}
+
+// DetectPiercingLine reports if the candle at the index is the final candle of a piercing line pattern.
+func DetectPiercingLine(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectPiercingLineAt, data, index, cfg)
+}
+
+// DetectDarkCloudCover reports if the candle at the index is the final candle of a dark cloud cover pattern.
+func DetectDarkCloudCover(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectDarkCloudCoverAt, data, index, cfg)
+}
+
+// DetectBullishKicker reports if the candle at the index is the final candle of a bullish kicker pattern.
+func DetectBullishKicker(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBullishKickerAt, data, index, cfg)
+}
+
+// DetectBearishKicker reports if the candle at the index is the final candle of a bearish kicker pattern.
+func DetectBearishKicker(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBearishKickerAt, data, index, cfg)
+}
+
+// DetectMorningStar reports if the candle at the index is the final candle of a morning star pattern.
+func DetectMorningStar(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectMorningStarAt, data, index, cfg)
+}
+
+// DetectEveningStar reports if the candle at the index is the final candle of an evening star pattern.
+func DetectEveningStar(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectEveningStarAt, data, index, cfg)
+}
+
+// DetectThreeWhiteSoldiers reports if the candle at the index is the final candle of a three white soldiers pattern.
+func DetectThreeWhiteSoldiers(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectThreeWhiteSoldiersAt, data, index, cfg)
+}
+
+// DetectThreeBlackCrows reports if the candle at the index is the final candle of a three black crows pattern.
+func DetectThreeBlackCrows(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectThreeBlackCrowsAt, data, index, cfg)
+}
+
+// DetectBullishAbandonedBaby reports if the candle at the index is the final candle of a bullish abandoned baby pattern.
+func DetectBullishAbandonedBaby(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectAbandonedBabyAt, data, index, cfg)
+}
+
+// DetectBearishAbandonedBaby reports if the candle at the index is the final candle of a bearish abandoned baby pattern.
+func DetectBearishAbandonedBaby(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBearishAbandonedBabyAt, data, index, cfg)
+}
+
+// DetectBullishTriStar reports if the candle at the index is the final candle of a bullish tri-star pattern.
+func DetectBullishTriStar(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectTriStarAt, data, index, cfg)
+}
+
+// DetectBearishTriStar reports if the candle at the index is the final candle of a bearish tri-star pattern.
+func DetectBearishTriStar(data []OHLCData, index int, cfg CandlestickPatternConfig) bool {
+	return detectPatternIndex(detectBearishTriStarAt, data, index, cfg)
+}
+
+// priceTrend describes the direction of price movement.
+type priceTrend int
+
+const (
+	trendFlat priceTrend = iota
+	trendUp
+	trendDown
+)
+
+// priorTrend returns the direction of the net close change over the lookback moves before the index. The trend is
+// flat if there is insufficient data or the closes are invalid.
+func priorTrend(data []OHLCData, index, lookback int) priceTrend {
+	start := index - lookback - 1
+	if lookback <= 0 || start < 0 || index > len(data) {
+		return trendFlat
+	}
+	first := data[start].Close
+	last := data[index-1].Close
+	if !isValidExtent(first) || !isValidExtent(last) {
+		return trendFlat
+	} else if last > first {
+		return trendUp
+	} else if last < first {
+		return trendDown
+	}
+	return trendFlat
+}
+
+// trendConfirmed checks the trend preceding the pattern starting at index when TrendLookback is configured.
+func trendConfirmed(data []OHLCData, index int, options CandlestickPatternConfig, expected priceTrend) bool {
+	if options.TrendLookback <= 0 {
+		return true
+	}
+	return priorTrend(data, index, options.TrendLookback) == expected
+}
+
 func detectDojiAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	ohlc := data[index]
 	if !validateOHLCData(ohlc) {
 		return false
 	}
 
-	// Use configured threshold or default to standard 5% (0.05)
-	threshold := options.DojiThreshold
-	if threshold <= 0 {
-		threshold = 0.05 // Standard textbook definition: body ≤5% of range
-	}
+	threshold := options.dojiThreshold()
 
 	bodySize := math.Abs(ohlc.Close - ohlc.Open)
 	priceRange := ohlc.High - ohlc.Low
@@ -411,15 +1110,14 @@ func detectDojiAt(data []OHLCData, index int, options CandlestickPatternConfig)
 
 func detectHammerAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	ohlc := data[index]
+	if !trendConfirmed(data, index, options, trendDown) {
+		return false
+	}
 	if !validateOHLCData(ohlc) {
 		return false
 	}
 
-	// Use configured ratio or default to standard 2:1
-	shadowRatio := options.ShadowRatio
-	if shadowRatio <= 0 {
-		shadowRatio = 2.0 // Standard: shadow at least 2x the body
-	}
+	shadowRatio := options.shadowRatio()
 
 	bodySize := math.Abs(ohlc.Close - ohlc.Open)
 	lowerShadow := min(ohlc.Open, ohlc.Close) - ohlc.Low
@@ -431,15 +1129,14 @@ func detectHammerAt(data []OHLCData, index int, options CandlestickPatternConfig
 
 func detectInvertedHammerAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	ohlc := data[index]
+	if !trendConfirmed(data, index, options, trendDown) {
+		return false
+	}
 	if !validateOHLCData(ohlc) {
 		return false
 	}
 
-	// Use configured ratio or default to standard 2:1
-	shadowRatio := options.ShadowRatio
-	if shadowRatio <= 0 {
-		shadowRatio = 2.0 // Standard: shadow at least 2x the body
-	}
+	shadowRatio := options.shadowRatio()
 
 	bodySize := math.Abs(ohlc.Close - ohlc.Open)
 	lowerShadow := min(ohlc.Open, ohlc.Close) - ohlc.Low
@@ -451,15 +1148,14 @@ func detectInvertedHammerAt(data []OHLCData, index int, options CandlestickPatte
 
 func detectShootingStarAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	ohlc := data[index]
+	if !trendConfirmed(data, index, options, trendUp) {
+		return false
+	}
 	if !validateOHLCData(ohlc) {
 		return false
 	}
 
-	// Use configured ratio or default to standard 2:1
-	shadowRatio := options.ShadowRatio
-	if shadowRatio <= 0 {
-		shadowRatio = 2.0 // Standard: shadow at least 2x the body
-	}
+	shadowRatio := options.shadowRatio()
 
 	bodySize := math.Abs(ohlc.Close - ohlc.Open)
 	lowerShadow := min(ohlc.Open, ohlc.Close) - ohlc.Low
@@ -482,15 +1178,15 @@ func detectShootingStarAt(data []OHLCData, index int, options CandlestickPattern
 
 func detectGravestoneDojiAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	ohlc := data[index]
+	if !trendConfirmed(data, index, options, trendUp) {
+		return false
+	}
 	if !validateOHLCData(ohlc) {
 		return false
 	}
 
 	// Must be a doji first
-	threshold := options.DojiThreshold
-	if threshold <= 0 {
-		threshold = 0.05 // Standard: body ≤5% of range
-	}
+	threshold := options.dojiThreshold()
 
 	bodySize := math.Abs(ohlc.Close - ohlc.Open)
 	priceRange := ohlc.High - ohlc.Low
@@ -505,10 +1201,7 @@ func detectGravestoneDojiAt(data []OHLCData, index int, options CandlestickPatte
 	upperShadow := ohlc.High - bodyMidpoint
 	lowerShadow := bodyMidpoint - ohlc.Low
 
-	shadowRatio := options.ShadowRatio
-	if shadowRatio <= 0 {
-		shadowRatio = 2.0 // Standard: shadow at least 2x the body
-	}
+	shadowRatio := options.gravestoneShadowRatio()
 
 	// Gravestone doji: long upper shadow, minimal lower shadow
 	hasLongUpperShadow := upperShadow >= shadowRatio*math.Abs(ohlc.Close-ohlc.Open)
@@ -519,15 +1212,15 @@ func detectGravestoneDojiAt(data []OHLCData, index int, options CandlestickPatte
 
 func detectDragonflyDojiAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	ohlc := data[index]
+	if !trendConfirmed(data, index, options, trendDown) {
+		return false
+	}
 	if !validateOHLCData(ohlc) {
 		return false
 	}
 
 	// Must be a doji first
-	threshold := options.DojiThreshold
-	if threshold <= 0 {
-		threshold = 0.05 // Standard: body ≤5% of range
-	}
+	threshold := options.dojiThreshold()
 
 	bodySize := math.Abs(ohlc.Close - ohlc.Open)
 	priceRange := ohlc.High - ohlc.Low
@@ -542,10 +1235,7 @@ func detectDragonflyDojiAt(data []OHLCData, index int, options CandlestickPatter
 	upperShadow := ohlc.High - bodyMidpoint
 	lowerShadow := bodyMidpoint - ohlc.Low
 
-	shadowRatio := options.ShadowRatio
-	if shadowRatio <= 0 {
-		shadowRatio = 2.0 // Standard: shadow at least 2x the body
-	}
+	shadowRatio := options.dragonflyShadowRatio()
 
 	// Dragonfly doji: long lower shadow, minimal upper shadow
 	hasLongLowerShadow := lowerShadow >= shadowRatio*math.Abs(ohlc.Close-ohlc.Open)
@@ -560,26 +1250,13 @@ func detectBullishMarubozuAt(data []OHLCData, index int, options CandlestickPatt
 		return false
 	}
 
-	// Use configured tolerance or default to 1% of range
-	threshold := options.ShadowTolerance
-	if threshold <= 0 {
-		threshold = 0.01 // Standard: shadows ≤1% of range
-	}
-
-	// Calculate shadow sizes
-	upper := ohlc.High - max(ohlc.Open, ohlc.Close)
-	lower := min(ohlc.Open, ohlc.Close) - ohlc.Low
 	body := math.Abs(ohlc.Close - ohlc.Open)
-	total := ohlc.High - ohlc.Low
-
-	if total == 0 || body == 0 {
+	if ohlc.High == ohlc.Low || body == 0 {
 		return false
 	}
 
-	// Shadows should be minimal compared to total range
-	hasMinimalShadows := (upper+lower)/total <= threshold
-
-	if !hasMinimalShadows {
+	// Shadows should be minimal, by default compared to the total range
+	if options.shadowMeasure(ohlc) > options.shadowTolerance() {
 		return false
 	}
 	return ohlc.Close > ohlc.Open
@@ -591,26 +1268,13 @@ func detectBearishMarubozuAt(data []OHLCData, index int, options CandlestickPatt
 		return false
 	}
 
-	// Use configured tolerance or default to 1% of range
-	threshold := options.ShadowTolerance
-	if threshold <= 0 {
-		threshold = 0.01 // Standard: shadows ≤1% of range
-	}
-
-	// Calculate shadow sizes
-	upper := ohlc.High - max(ohlc.Open, ohlc.Close)
-	lower := min(ohlc.Open, ohlc.Close) - ohlc.Low
 	body := math.Abs(ohlc.Close - ohlc.Open)
-	total := ohlc.High - ohlc.Low
-
-	if total == 0 || body == 0 {
+	if ohlc.High == ohlc.Low || body == 0 {
 		return false
 	}
 
-	// Shadows should be minimal compared to total range
-	hasMinimalShadows := (upper+lower)/total <= threshold
-
-	if !hasMinimalShadows {
+	// Shadows should be minimal, by default compared to the total range
+	if options.shadowMeasure(ohlc) > options.shadowTolerance() {
 		return false
 	}
 	return ohlc.Close < ohlc.Open
@@ -622,15 +1286,14 @@ func detectBullishEngulfingAt(data []OHLCData, index int, options CandlestickPat
 	}
 	prev := data[index-1]
 	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendDown) {
+		return false
+	}
 	if !validateOHLCData(prev) || !validateOHLCData(current) {
 		return false
 	}
 
-	// Use configured size or default to complete engulfing (100%)
-	minSize := options.EngulfingMinSize
-	if minSize <= 0 {
-		minSize = 1.0 // Standard: must completely engulf previous body
-	}
+	minSize := options.engulfingMinSize()
 
 	prevBody := math.Abs(prev.Close - prev.Open)
 	currentBody := math.Abs(current.Close - current.Open)
@@ -661,15 +1324,14 @@ func detectBearishEngulfingAt(data []OHLCData, index int, options CandlestickPat
 	}
 	prev := data[index-1]
 	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendUp) {
+		return false
+	}
 	if !validateOHLCData(prev) || !validateOHLCData(current) {
 		return false
 	}
 
-	// Use configured size or default to complete engulfing (100%)
-	minSize := options.EngulfingMinSize
-	if minSize <= 0 {
-		minSize = 1.0 // Standard: must completely engulf previous body
-	}
+	minSize := options.engulfingMinSize()
 
 	prevBody := math.Abs(prev.Close - prev.Open)
 	currentBody := math.Abs(current.Close - current.Open)
@@ -694,17 +1356,147 @@ func detectBearishEngulfingAt(data []OHLCData, index int, options CandlestickPat
 	return prevBullish && currentBearish
 }
 
-func detectPiercingLineAt(data []OHLCData, index int, _ CandlestickPatternConfig) bool {
+func detectHaramiAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	if index < 1 {
 		return false
 	}
 	prev := data[index-1]
 	current := data[index]
-	if !validateOHLCData(prev) || !validateOHLCData(current) {
+	if !trendConfirmed(data, index-1, options, trendDown) {
 		return false
-	} else if prev.Close >= prev.Open { // Previous candle must be bearish
+	}
+	if !validateOHLCData(prev) || !validateOHLCData(current) {
 		return false
-	} else if current.Close <= current.Open { // Current candle must be bullish
+	}
+
+	// Determine bullish
+	prevBearish := prev.Close < prev.Open
+	currentBullish := current.Close > current.Open
+	if !prevBearish || !currentBullish {
+		return false
+	}
+
+	return isHaramiContained(prev, current, options)
+}
+
+func detectBearishHaramiAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 1 {
+		return false
+	}
+	prev := data[index-1]
+	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendUp) {
+		return false
+	}
+	if !validateOHLCData(prev) || !validateOHLCData(current) {
+		return false
+	}
+
+	// Determine bearish
+	prevBullish := prev.Close > prev.Open
+	currentBearish := current.Close < current.Open
+	if !prevBullish || !currentBearish {
+		return false
+	}
+
+	return isHaramiContained(prev, current, options)
+}
+
+func (c CandlestickPatternConfig) haramiMaxSize() float64 {
+	if c.HaramiMaxSize <= 0 {
+		return 0.5
+	}
+	return c.HaramiMaxSize
+}
+
+// isHaramiContained checks that the current body is within the previous body and small relative to it.
+func isHaramiContained(prev, current OHLCData, options CandlestickPatternConfig) bool {
+	maxSize := options.haramiMaxSize()
+
+	prevBody := math.Abs(prev.Close - prev.Open)
+	currentBody := math.Abs(current.Close - current.Open)
+
+	// Previous candle body must contain the current candle's body
+	prevTop := max(prev.Open, prev.Close)
+	prevBottom := min(prev.Open, prev.Close)
+	currentTop := max(current.Open, current.Close)
+	currentBottom := min(current.Open, current.Close)
+
+	isContained := currentTop < prevTop && currentBottom > prevBottom
+	isSizeSmall := currentBody <= maxSize*prevBody
+
+	return isContained && isSizeSmall
+}
+
+func detectTweezerTopAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 1 {
+		return false
+	}
+	prev := data[index-1]
+	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendUp) {
+		return false
+	}
+	if !validateOHLCData(prev) || !validateOHLCData(current) {
+		return false
+	}
+
+	// Bullish candle followed by a bearish candle
+	if prev.Close <= prev.Open || current.Close >= current.Open {
+		return false
+	}
+
+	return isTweezerMatch(prev.High, current.High, options)
+}
+
+func detectTweezerBottomAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 1 {
+		return false
+	}
+	prev := data[index-1]
+	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendDown) {
+		return false
+	}
+	if !validateOHLCData(prev) || !validateOHLCData(current) {
+		return false
+	}
+
+	// Bearish candle followed by a bullish candle
+	if prev.Close >= prev.Open || current.Close <= current.Open {
+		return false
+	}
+
+	return isTweezerMatch(prev.Low, current.Low, options)
+}
+
+func (c CandlestickPatternConfig) tweezerTolerance() float64 {
+	if c.TweezerTolerance <= 0 {
+		return 0.001 // Standard: extremes within 0.1%
+	}
+	return c.TweezerTolerance
+}
+
+// isTweezerMatch checks if the two extremes are equal within the configured tolerance.
+func isTweezerMatch(a, b float64, options CandlestickPatternConfig) bool {
+	tolerance := options.tweezerTolerance()
+	return math.Abs(a-b) <= tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func detectPiercingLineAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 1 {
+		return false
+	}
+	prev := data[index-1]
+	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendDown) {
+		return false
+	}
+	if !validateOHLCData(prev) || !validateOHLCData(current) {
+		return false
+	} else if prev.Close >= prev.Open { // Previous candle must be bearish
+		return false
+	} else if current.Close <= current.Open { // Current candle must be bullish
 		return false
 	} else if current.Open >= prev.Close { // Current must open below previous close (gap down)
 		return false
@@ -721,12 +1513,15 @@ func detectPiercingLineAt(data []OHLCData, index int, _ CandlestickPatternConfig
 	return true
 }
 
-func detectDarkCloudCoverAt(data []OHLCData, index int, _ CandlestickPatternConfig) bool {
+func detectDarkCloudCoverAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	if index < 1 {
 		return false
 	}
 	prev := data[index-1]
 	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendUp) {
+		return false
+	}
 	if !validateOHLCData(prev) || !validateOHLCData(current) {
 		return false
 	} else if prev.Close <= prev.Open { // Previous candle must be bullish
@@ -748,13 +1543,56 @@ func detectDarkCloudCoverAt(data []OHLCData, index int, _ CandlestickPatternConf
 	return true
 }
 
-func detectMorningStarAt(data []OHLCData, index int, _ CandlestickPatternConfig) bool {
+func detectBullishKickerAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 1 {
+		return false
+	}
+	prev := data[index-1]
+	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendDown) {
+		return false
+	}
+	if !validateOHLCData(prev) || !validateOHLCData(current) {
+		return false
+	}
+	// Bearish candle followed by a bullish candle
+	if prev.Close >= prev.Open || current.Close <= current.Open {
+		return false
+	}
+	// Current body gaps entirely above the previous body
+	return current.Open > prev.Open
+}
+
+func detectBearishKickerAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 1 {
+		return false
+	}
+	prev := data[index-1]
+	current := data[index]
+	if !trendConfirmed(data, index-1, options, trendUp) {
+		return false
+	}
+	if !validateOHLCData(prev) || !validateOHLCData(current) {
+		return false
+	}
+	// Bullish candle followed by a bearish candle
+	if prev.Close <= prev.Open || current.Close >= current.Open {
+		return false
+	}
+	// Current body gaps entirely below the previous body
+	return current.Open < prev.Open
+}
+
+func detectMorningStarAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	if index < 2 {
 		return false
 	}
 	first := data[index-2]
 	second := data[index-1]
 	third := data[index]
+	if !trendConfirmed(data, index-2, options, trendDown) {
+		return false
+	}
 	if !validateOHLCData(first) || !validateOHLCData(second) || !validateOHLCData(third) {
 		return false
 	}
@@ -794,13 +1632,16 @@ func detectMorningStarAt(data []OHLCData, index int, _ CandlestickPatternConfig)
 	return true
 }
 
-func detectEveningStarAt(data []OHLCData, index int, _ CandlestickPatternConfig) bool {
+func detectEveningStarAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
 	if index < 2 {
 		return false
 	}
 	first := data[index-2]
 	second := data[index-1]
 	third := data[index]
+	if !trendConfirmed(data, index-2, options, trendUp) {
+		return false
+	}
 	if !validateOHLCData(first) || !validateOHLCData(second) || !validateOHLCData(third) {
 		return false
 	}
@@ -838,36 +1679,374 @@ func detectEveningStarAt(data []OHLCData, index int, _ CandlestickPatternConfig)
 	return true
 }
 
+func detectAbandonedBabyAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 2 {
+		return false
+	}
+	first := data[index-2]
+	second := data[index-1]
+	third := data[index]
+	if !trendConfirmed(data, index-2, options, trendDown) {
+		return false
+	}
+	if !validateOHLCData(first) || !validateOHLCData(third) {
+		return false
+	}
+	// First candle bearish, third candle bullish
+	if first.Close >= first.Open || third.Close <= third.Open {
+		return false
+	}
+	// Middle candle is a doji gapping below the first candle, and the third gaps back above it, shadows included
+	if !detectDojiAt(data, index-1, options) {
+		return false
+	}
+	return second.High < first.Low && third.Low > second.High
+}
+
+func detectBearishAbandonedBabyAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 2 {
+		return false
+	}
+	first := data[index-2]
+	second := data[index-1]
+	third := data[index]
+	if !trendConfirmed(data, index-2, options, trendUp) {
+		return false
+	}
+	if !validateOHLCData(first) || !validateOHLCData(third) {
+		return false
+	}
+	// First candle bullish, third candle bearish
+	if first.Close <= first.Open || third.Close >= third.Open {
+		return false
+	}
+	// Middle candle is a doji gapping above the first candle, and the third gaps back below it, shadows included
+	if !detectDojiAt(data, index-1, options) {
+		return false
+	}
+	return second.Low > first.High && third.High < second.Low
+}
+
+func detectTriStarAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 2 {
+		return false
+	}
+	first := data[index-2]
+	second := data[index-1]
+	third := data[index]
+	if !trendConfirmed(data, index-2, options, trendDown) {
+		return false
+	}
+	for i := index - 2; i <= index; i++ {
+		if !detectDojiAt(data, i, options) {
+			return false
+		}
+	}
+	// Middle doji body gaps below the bodies of the first and third dojis
+	secondTop := max(second.Open, second.Close)
+	return secondTop < min(first.Open, first.Close) && secondTop < min(third.Open, third.Close)
+}
+
+func detectBearishTriStarAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 2 {
+		return false
+	}
+	first := data[index-2]
+	second := data[index-1]
+	third := data[index]
+	if !trendConfirmed(data, index-2, options, trendUp) {
+		return false
+	}
+	for i := index - 2; i <= index; i++ {
+		if !detectDojiAt(data, i, options) {
+			return false
+		}
+	}
+	// Middle doji body gaps above the bodies of the first and third dojis
+	secondBottom := min(second.Open, second.Close)
+	return secondBottom > max(first.Open, first.Close) && secondBottom > max(third.Open, third.Close)
+}
+
+func detectThreeWhiteSoldiersAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 2 {
+		return false
+	}
+	candles := data[index-2 : index+1]
+	for i, c := range candles {
+		if !validateOHLCData(c) || c.Close <= c.Open || !hasSoldierBody(c, options) {
+			return false
+		}
+		if i > 0 {
+			prev := candles[i-1]
+			// Open within the prior body and close progressively higher
+			if c.Open < prev.Open || c.Open > prev.Close || c.Close <= prev.Close {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func detectThreeBlackCrowsAt(data []OHLCData, index int, options CandlestickPatternConfig) bool {
+	if index < 2 {
+		return false
+	}
+	candles := data[index-2 : index+1]
+	for i, c := range candles {
+		if !validateOHLCData(c) || c.Close >= c.Open || !hasSoldierBody(c, options) {
+			return false
+		}
+		if i > 0 {
+			prev := candles[i-1]
+			// Open within the prior body and close progressively lower
+			if c.Open > prev.Open || c.Open < prev.Close || c.Close >= prev.Close {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (c CandlestickPatternConfig) soldierMinBodyRatio() float64 {
+	if c.SoldierMinBodyRatio <= 0 {
+		return 0.5
+	}
+	return c.SoldierMinBodyRatio
+}
+
+// hasSoldierBody checks that the candle body is a meaningful portion of its range.
+func hasSoldierBody(ohlc OHLCData, options CandlestickPatternConfig) bool {
+	minRatio := options.soldierMinBodyRatio()
+	candleRange := ohlc.High - ohlc.Low
+	if candleRange <= 0 {
+		return false
+	}
+	return math.Abs(ohlc.Close-ohlc.Open)/candleRange >= minRatio
+}
+
+// confidenceAtLeast scores a value which must meet the minimum, reaching full confidence at twice the minimum.
+func confidenceAtLeast(value, minimum float64) float64 {
+	if minimum <= 0 {
+		return 1
+	}
+	return 0.5 + 0.5*math.Max(0, math.Min(1, (value-minimum)/minimum))
+}
+
+// confidenceAtMost scores a value which must not exceed the limit, reaching full confidence at zero.
+func confidenceAtMost(value, limit float64) float64 {
+	if limit <= 0 {
+		return 1
+	}
+	return 0.5 + 0.5*math.Max(0, math.Min(1, 1-value/limit))
+}
+
+// confidencePenetration scores how far a close moves from the reference midpoint toward the target price.
+func confidencePenetration(close, midpoint, target float64) float64 {
+	if target == midpoint {
+		return 1
+	}
+	return 0.5 + 0.5*math.Max(0, math.Min(1, (close-midpoint)/(target-midpoint)))
+}
+
+func (c CandlestickPatternConfig) dojiThreshold() float64 {
+	if c.DojiThreshold <= 0 {
+		return 0.05
+	}
+	return c.DojiThreshold
+}
+
+func (c CandlestickPatternConfig) shadowRatio() float64 {
+	if c.ShadowRatio <= 0 {
+		return 2.0
+	}
+	return c.ShadowRatio
+}
+
+func (c CandlestickPatternConfig) engulfingMinSize() float64 {
+	if c.EngulfingMinSize <= 0 {
+		return 1.0 // Standard: must completely engulf previous body
+	}
+	return c.EngulfingMinSize
+}
+
+func (c CandlestickPatternConfig) gravestoneShadowRatio() float64 {
+	if c.GravestoneShadowRatio > 0 {
+		return c.GravestoneShadowRatio
+	}
+	return c.shadowRatio()
+}
+
+func (c CandlestickPatternConfig) dragonflyShadowRatio() float64 {
+	if c.DragonflyShadowRatio > 0 {
+		return c.DragonflyShadowRatio
+	}
+	return c.shadowRatio()
+}
+
+func (c CandlestickPatternConfig) shadowTolerance() float64 {
+	if c.ShadowTolerance <= 0 {
+		return 0.01 // Standard: shadows ≤1% of range, or a price of 0.01 in absolute mode
+	}
+	return c.ShadowTolerance
+}
+
+// shadowMeasure returns the combined upper and lower shadow of the candle in the units of ShadowToleranceMode.
+func (c CandlestickPatternConfig) shadowMeasure(ohlc OHLCData) float64 {
+	upper := ohlc.High - max(ohlc.Open, ohlc.Close)
+	lower := min(ohlc.Open, ohlc.Close) - ohlc.Low
+	if c.ShadowToleranceMode == ShadowToleranceAbsolute {
+		return upper + lower
+	}
+	return (upper + lower) / (ohlc.High - ohlc.Low)
+}
+
+func dojiConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	ohlc := data[index]
+	return confidenceAtMost(math.Abs(ohlc.Close-ohlc.Open)/(ohlc.High-ohlc.Low), options.dojiThreshold())
+}
+
+func lowerShadowConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return lowerShadowRatioConfidence(data[index], options.shadowRatio())
+}
+
+func lowerShadowRatioConfidence(ohlc OHLCData, shadowRatio float64) float64 {
+	body := math.Abs(ohlc.Close - ohlc.Open)
+	lowerShadow := min(ohlc.Open, ohlc.Close) - ohlc.Low
+	upperShadow := ohlc.High - max(ohlc.Open, ohlc.Close)
+	return (confidenceAtLeast(lowerShadow, shadowRatio*body) +
+		confidenceAtMost(upperShadow, lowerShadow*0.3)) / 2
+}
+
+func upperShadowConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return upperShadowRatioConfidence(data[index], options.shadowRatio())
+}
+
+func upperShadowRatioConfidence(ohlc OHLCData, shadowRatio float64) float64 {
+	body := math.Abs(ohlc.Close - ohlc.Open)
+	lowerShadow := min(ohlc.Open, ohlc.Close) - ohlc.Low
+	upperShadow := ohlc.High - max(ohlc.Open, ohlc.Close)
+	return (confidenceAtLeast(upperShadow, shadowRatio*body) +
+		confidenceAtMost(lowerShadow, upperShadow*0.3)) / 2
+}
+
+func gravestoneConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return (dojiConfidence(data, index, options) +
+		upperShadowRatioConfidence(data[index], options.gravestoneShadowRatio())) / 2
+}
+
+func dragonflyConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return (dojiConfidence(data, index, options) +
+		lowerShadowRatioConfidence(data[index], options.dragonflyShadowRatio())) / 2
+}
+
+func marubozuConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return confidenceAtMost(options.shadowMeasure(data[index]), options.shadowTolerance())
+}
+
+func engulfingConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	minSize := options.engulfingMinSize()
+	prev, current := data[index-1], data[index]
+	return confidenceAtLeast(math.Abs(current.Close-current.Open), minSize*math.Abs(prev.Close-prev.Open))
+}
+
+func haramiConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	maxSize := options.haramiMaxSize()
+	prev, current := data[index-1], data[index]
+	return confidenceAtMost(math.Abs(current.Close-current.Open), maxSize*math.Abs(prev.Close-prev.Open))
+}
+
+func tweezerTopConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return tweezerConfidence(data[index-1].High, data[index].High, options)
+}
+
+func tweezerBottomConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return tweezerConfidence(data[index-1].Low, data[index].Low, options)
+}
+
+func tweezerConfidence(a, b float64, options CandlestickPatternConfig) float64 {
+	tolerance := options.tweezerTolerance()
+	return confidenceAtMost(math.Abs(a-b), tolerance*math.Max(math.Abs(a), math.Abs(b)))
+}
+
+func penetrationConfidence(data []OHLCData, index int, _ CandlestickPatternConfig) float64 {
+	prev := data[index-1]
+	return confidencePenetration(data[index].Close, (prev.Open+prev.Close)/2, prev.Open)
+}
+
+func kickerConfidence(data []OHLCData, index int, _ CandlestickPatternConfig) float64 {
+	prev, current := data[index-1], data[index]
+	return confidenceAtLeast(math.Abs(current.Close-current.Open), math.Abs(prev.Close-prev.Open))
+}
+
+func starConfidence(data []OHLCData, index int, _ CandlestickPatternConfig) float64 {
+	first := data[index-2]
+	return confidencePenetration(data[index].Close, (first.Open+first.Close)/2, first.Open)
+}
+
+func soldierConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	minRatio := options.soldierMinBodyRatio()
+	var total float64
+	for _, c := range data[index-2 : index+1] {
+		total += confidenceAtLeast(math.Abs(c.Close-c.Open)/(c.High-c.Low), minRatio)
+	}
+	return total / 3
+}
+
+func abandonedBabyConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return dojiConfidence(data, index-1, options)
+}
+
+func triStarConfidence(data []OHLCData, index int, options CandlestickPatternConfig) float64 {
+	return (dojiConfidence(data, index-2, options) + dojiConfidence(data, index-1, options) +
+		dojiConfidence(data, index, options)) / 3
+}
+
 // patternDetector defines a single pattern detection function with metadata.
 type patternDetector struct {
 	patternName string
 	detectFunc  func([]OHLCData, int, CandlestickPatternConfig) bool
 	minCandles  int
+	direction   patternDirection
+	// confidenceFunc scores a detection, it is only invoked after detectFunc has matched.
+	confidenceFunc func([]OHLCData, int, CandlestickPatternConfig) float64
 }
 
 // patternDetectors contains all available pattern detectors organized by type
 var patternDetectors = map[string]patternDetector{
 	// single candle patterns
-	candlestickPatternDoji:           {"Doji", detectDojiAt, 1},
-	candlestickPatternHammer:         {"Hammer", detectHammerAt, 1},
-	candlestickPatternInvertedHammer: {"Inverted Hammer", detectInvertedHammerAt, 1},
-	candlestickPatternShootingStar:   {"Shooting Star", detectShootingStarAt, 1},
-	candlestickPatternGravestone:     {"Gravestone Doji", detectGravestoneDojiAt, 1},
-	candlestickPatternDragonfly:      {"Dragonfly Doji", detectDragonflyDojiAt, 1},
-	candlestickPatternMarubozuBull:   {"Bullish Marubozu", detectBullishMarubozuAt, 1},
-	candlestickPatternMarubozuBear:   {"Bearish Marubozu", detectBearishMarubozuAt, 1},
+	candlestickPatternDoji:           {"Doji", detectDojiAt, 1, patternDirectionNeutral, dojiConfidence},
+	candlestickPatternHammer:         {"Hammer", detectHammerAt, 1, patternDirectionBullish, lowerShadowConfidence},
+	candlestickPatternInvertedHammer: {"Inverted Hammer", detectInvertedHammerAt, 1, patternDirectionBullish, upperShadowConfidence},
+	candlestickPatternShootingStar:   {"Shooting Star", detectShootingStarAt, 1, patternDirectionBearish, upperShadowConfidence},
+	candlestickPatternGravestone:     {"Gravestone Doji", detectGravestoneDojiAt, 1, patternDirectionBearish, gravestoneConfidence},
+	candlestickPatternDragonfly:      {"Dragonfly Doji", detectDragonflyDojiAt, 1, patternDirectionBullish, dragonflyConfidence},
+	candlestickPatternMarubozuBull:   {"Bullish Marubozu", detectBullishMarubozuAt, 1, patternDirectionBullish, marubozuConfidence},
+	candlestickPatternMarubozuBear:   {"Bearish Marubozu", detectBearishMarubozuAt, 1, patternDirectionBearish, marubozuConfidence},
 	// double candle patterns
-	candlestickPatternEngulfingBull:  {"Bullish Engulfing", detectBullishEngulfingAt, 2},
-	candlestickPatternEngulfingBear:  {"Bearish Engulfing", detectBearishEngulfingAt, 2},
-	candlestickPatternPiercingLine:   {"Piercing Line", detectPiercingLineAt, 2},
-	candlestickPatternDarkCloudCover: {"Dark Cloud Cover", detectDarkCloudCoverAt, 2},
+	candlestickPatternEngulfingBull:  {"Bullish Engulfing", detectBullishEngulfingAt, 2, patternDirectionBullish, engulfingConfidence},
+	candlestickPatternEngulfingBear:  {"Bearish Engulfing", detectBearishEngulfingAt, 2, patternDirectionBearish, engulfingConfidence},
+	candlestickPatternPiercingLine:   {"Piercing Line", detectPiercingLineAt, 2, patternDirectionBullish, penetrationConfidence},
+	candlestickPatternDarkCloudCover: {"Dark Cloud Cover", detectDarkCloudCoverAt, 2, patternDirectionBearish, penetrationConfidence},
+	candlestickPatternHaramiBull:     {"Bullish Harami", detectHaramiAt, 2, patternDirectionBullish, haramiConfidence},
+	candlestickPatternHaramiBear:     {"Bearish Harami", detectBearishHaramiAt, 2, patternDirectionBearish, haramiConfidence},
+	candlestickPatternTweezerTop:     {"Tweezer Top", detectTweezerTopAt, 2, patternDirectionBearish, tweezerTopConfidence},
+	candlestickPatternTweezerBottom:  {"Tweezer Bottom", detectTweezerBottomAt, 2, patternDirectionBullish, tweezerBottomConfidence},
+	candlestickPatternKickerBull:     {"Bullish Kicker", detectBullishKickerAt, 2, patternDirectionBullish, kickerConfidence},
+	candlestickPatternKickerBear:     {"Bearish Kicker", detectBearishKickerAt, 2, patternDirectionBearish, kickerConfidence},
 	// triple candle patterns
-	candlestickPatternMorningStar: {"Morning Star", detectMorningStarAt, 3},
-	candlestickPatternEveningStar: {"Evening Star", detectEveningStarAt, 3},
+	candlestickPatternMorningStar:        {"Morning Star", detectMorningStarAt, 3, patternDirectionBullish, starConfidence},
+	candlestickPatternEveningStar:        {"Evening Star", detectEveningStarAt, 3, patternDirectionBearish, starConfidence},
+	candlestickPatternThreeWhiteSoldiers: {"Three White Soldiers", detectThreeWhiteSoldiersAt, 3, patternDirectionBullish, soldierConfidence},
+	candlestickPatternThreeBlackCrows:    {"Three Black Crows", detectThreeBlackCrowsAt, 3, patternDirectionBearish, soldierConfidence},
+	candlestickPatternAbandonedBabyBull:  {"Bullish Abandoned Baby", detectAbandonedBabyAt, 3, patternDirectionBullish, abandonedBabyConfidence},
+	candlestickPatternAbandonedBabyBear:  {"Bearish Abandoned Baby", detectBearishAbandonedBabyAt, 3, patternDirectionBearish, abandonedBabyConfidence},
+	candlestickPatternTriStarBull:        {"Bullish Tri-Star", detectTriStarAt, 3, patternDirectionBullish, triStarConfidence},
+	candlestickPatternTriStarBear:        {"Bearish Tri-Star", detectBearishTriStarAt, 3, patternDirectionBearish, triStarConfidence},
 }
 
-// formatPatternsDefault provides default pattern formatting (private)
-func formatPatternsDefault(patterns []PatternDetectionResult, seriesIndex int, theme ColorPalette) (string, *LabelStyle) {
+// formatPatternsDefault provides default pattern formatting, applying the config style and glyph overrides (private)
+func formatPatternsDefault(patterns []PatternDetectionResult, seriesIndex int, theme ColorPalette,
+	config CandlestickPatternConfig) (string, *LabelStyle) {
 	if len(patterns) == 0 {
 		return "", nil
 	}
@@ -875,18 +2054,30 @@ func formatPatternsDefault(patterns []PatternDetectionResult, seriesIndex int, t
 	// Build display names and determine color
 	displayNames := make([]string, len(patterns))
 	var bullishCount, bearishCount, neutralCount int
+	var styleOverride *LabelStyle
 	for i, pattern := range patterns {
 		displayName := getPatternDisplayName(pattern.PatternType)
 		if displayName == "" {
 			displayName = pattern.PatternName // fallback name without icon
 		}
+		if glyph, ok := config.PatternGlyphs[pattern.PatternType]; ok {
+			if _, name, found := strings.Cut(displayName, " "); found && displayName != pattern.PatternName {
+				displayName = name // strip the default glyph
+			}
+			if glyph != "" {
+				displayName = glyph + " " + displayName
+			}
+		}
 		displayNames[i] = displayName
+		if style, ok := config.PatternStyles[pattern.PatternType]; ok && styleOverride == nil {
+			styleOverride = &style
+		}
 
-		// Count pattern types to determine color
-		switch pattern.PatternType {
-		case candlestickPatternHammer, candlestickPatternMorningStar, candlestickPatternEngulfingBull, candlestickPatternDragonfly, candlestickPatternMarubozuBull, candlestickPatternPiercingLine:
+		// Count pattern directions to determine color
+		switch patternDetectors[pattern.PatternType].direction {
+		case patternDirectionBullish:
 			bullishCount++
-		case candlestickPatternShootingStar, candlestickPatternEveningStar, candlestickPatternEngulfingBear, candlestickPatternGravestone, candlestickPatternMarubozuBear, candlestickPatternDarkCloudCover:
+		case patternDirectionBearish:
 			bearishCount++
 		default: // Doji and other neutral patterns
 			neutralCount++
@@ -918,16 +2109,25 @@ func formatPatternsDefault(patterns []PatternDetectionResult, seriesIndex int, t
 		fontColor = color.WithAdjustHSL(0, 0, -0.28) // Darker for light backgrounds
 	}
 
-	return strings.Join(displayNames, "\n"), &LabelStyle{
+	fontSize := config.LabelFontSize
+	if fontSize <= 0 {
+		fontSize = 10
+	}
+	style := &LabelStyle{
 		FontStyle: FontStyle{
 			FontColor: fontColor,
-			FontSize:  10,
+			FontSize:  fontSize,
 		},
 		BackgroundColor: backgroundColor,
 		CornerRadius:    4,
 		BorderColor:     color,
 		BorderWidth:     1.2,
 	}
+	if styleOverride != nil {
+		merged := mergeLabelStyle(*styleOverride, *style)
+		style = &merged
+	}
+	return strings.Join(displayNames, "\n"), style
 }
 
 /* All symbols currently supported:
@@ -946,6 +2146,15 @@ Financial: $ ¢ £ ¤ ¥ ₠ ₡ ₢ ₣ ₤ ₥ ₦ ₧ ₨ ₩ ₪ ₫ € ₭
 Special: ! " # % & ' ( ) + , - . / : ; < = > ? @ [ ] _ ` { | } ¡ ¦ § ¨ © ª « ¬ ® ¯ ° ² ³ ´ µ ¶ · ¸ ¹ º » ¼ ½ ¾ ¿ Å ÷ ƒ ǀ ʘ ˆ ˇ ˘ ˙ ˚ ˛ ˜ ˝ – — † ‡ • ‣ ‰ ‱ ′ ″ ‴ ‵ ‶ ‷ ‸ ‹ › ‼ ‽ ⁂ ⁄ ⁅ ⁆ ⁇ ⁈ ⁉ ⁊ ⁋ ⁌ ⁍ ⁏ Ʇ
 */
 
+// patternGlyph returns the symbol displayed before the pattern name, applying any PatternGlyphs override.
+func patternGlyph(patternType string, config CandlestickPatternConfig) string {
+	if glyph, ok := config.PatternGlyphs[patternType]; ok {
+		return glyph
+	}
+	glyph, _, _ := strings.Cut(getPatternDisplayName(patternType), " ")
+	return glyph
+}
+
 // getPatternDisplayName returns the pattern name with appropriate symbol.
 func getPatternDisplayName(patternType string) string {
 	switch patternType {
@@ -1039,6 +2248,70 @@ func getPatternDisplayName(patternType string) string {
 		// Directional: ↓ (down arrow), ⬇ (bold down arrow), ⬊ (SE arrow), ➘ (SE dingbat arrow), ▼ / ▽ (down triangle)
 		// Semantic: ~ (tilde, wavy/cloud), ☽ (crescent moon, darkening), 📉 (chart decreasing)
 		return "Ξ Dark Cloud"
+	case candlestickPatternHaramiBull:
+		// Current: ◐ (circle left half filled - small body held within the previous large body)
+		// Shape: ◎ (bullseye, inner body), ◑ (circle right half), ▣ (square containing square)
+		// Directional: ↑ (up arrow), ⬆ (bold up arrow), ⬈ (NE arrow), ➚ (NE dingbat arrow), ▲ / △ (up triangle)
+		// Semantic: 📈 (chart increasing)
+		return "◐ Bull Harami"
+	case candlestickPatternHaramiBear:
+		// Current: ◑ (circle right half filled - small body held within the previous large body)
+		// Shape: ◎ (bullseye, inner body), ◐ (circle left half), ▣ (square containing square)
+		// Directional: ↓ (down arrow), ⬇ (bold down arrow), ⬊ (SE arrow), ➘ (SE dingbat arrow), ▼ / ▽ (down triangle)
+		// Semantic: 📉 (chart decreasing)
+		return "◑ Bear Harami"
+	case candlestickPatternTweezerTop:
+		// Current: ⌈ (left ceiling bracket - two candles capped at the same high)
+		// Shape: Π (capital pi, matching tops), ∏ (n-ary product), ⌉ (right ceiling bracket)
+		// Directional: ↓ (down arrow), ⬇ (bold down arrow), ⬊ (SE arrow), ➘ (SE dingbat arrow), ▼ / ▽ (down triangle)
+		// Semantic: 📉 (chart decreasing)
+		return "⌈ Tweezer Top"
+	case candlestickPatternTweezerBottom:
+		// Current: ⌊ (left floor bracket - two candles resting on the same low)
+		// Shape: ⌋ (right floor bracket), ◡ (lower half arc)
+		// Directional: ↑ (up arrow), ⬆ (bold up arrow), ⬈ (NE arrow), ➚ (NE dingbat arrow), ▲ / △ (up triangle)
+		// Semantic: 📈 (chart increasing)
+		return "⌊ Tweezer Bottom"
+	case candlestickPatternThreeWhiteSoldiers:
+		// Current: ⇧ (up white arrow - three advancing bullish candles)
+		// Directional: ↑ (up arrow), ⬆ (bold up arrow), ⇪ (up white arrow from bar), ➚ (NE dingbat arrow), ▲ / △ (up triangle)
+		// Semantic: 📈 (chart increasing), ⁂ (asterism, three marks)
+		return "⇧ 3 Soldiers"
+	case candlestickPatternThreeBlackCrows:
+		// Current: ⇩ (down white arrow - three declining bearish candles)
+		// Directional: ↓ (down arrow), ⬇ (bold down arrow), ➘ (SE dingbat arrow), ▼ / ▽ (down triangle)
+		// Semantic: 📉 (chart decreasing), ⁂ (asterism, three marks)
+		return "⇩ 3 Crows"
+	case candlestickPatternKickerBull:
+		// Current: ➚ (NE dingbat arrow - bullish candle kicks away above the prior bearish body)
+		// Directional: ⇪ (up white arrow from bar), ⬈ (NE arrow), ↑ (up arrow), ▲ / △ (up triangle)
+		// Semantic: ↯ (zigzag, abrupt change), 📈 (chart increasing)
+		return "➚ Bull Kicker"
+	case candlestickPatternKickerBear:
+		// Current: ➘ (SE dingbat arrow - bearish candle kicks away below the prior bullish body)
+		// Directional: ⬊ (SE arrow), ↓ (down arrow), ▼ / ▽ (down triangle)
+		// Semantic: ↯ (zigzag, abrupt change), 📉 (chart decreasing)
+		return "➘ Bear Kicker"
+	case candlestickPatternAbandonedBabyBull:
+		// Current: ◡ (lower half arc - doji isolated below the surrounding candles)
+		// Shape: ◇ (white diamond, isolated baby), ⌊ (left floor bracket)
+		// Directional: ↑ (up arrow), ⬆ (bold up arrow), ⬈ (NE arrow), ➚ (NE dingbat arrow), ▲ / △ (up triangle)
+		return "◡ Bull Baby"
+	case candlestickPatternAbandonedBabyBear:
+		// Current: ◠ (upper half arc - doji isolated above the surrounding candles)
+		// Shape: ◇ (white diamond, isolated baby), ⌈ (left ceiling bracket)
+		// Directional: ↓ (down arrow), ⬇ (bold down arrow), ⬊ (SE arrow), ➘ (SE dingbat arrow), ▼ / ▽ (down triangle)
+		return "◠ Bear Baby"
+	case candlestickPatternTriStarBull:
+		// Current: ⁂ (asterism - three doji stars)
+		// Stars: ✧ (white four-pointed star), ✶ (six-pointed star)
+		// Directional: ↑ (up arrow), ⬆ (bold up arrow), ➚ (NE dingbat arrow)
+		return "⁂ Bull Tri-Star"
+	case candlestickPatternTriStarBear:
+		// Current: ⁂ (asterism - three doji stars)
+		// Stars: ✧ (white four-pointed star), ✶ (six-pointed star)
+		// Directional: ↓ (down arrow), ⬇ (bold down arrow), ➘ (SE dingbat arrow)
+		return "⁂ Bear Tri-Star"
 	default:
 		return ""
 	}
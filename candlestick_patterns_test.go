@@ -1,6 +1,7 @@
 package charts
 
 import (
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -142,6 +143,137 @@ func TestEngulfingPattern(t *testing.T) {
 	assert.False(t, detectBearishEngulfingAt([]OHLCData{prevBullish, nonEngulfing}, 1, CandlestickPatternConfig{EngulfingMinSize: 0.8}))
 }
 
+func TestHaramiPattern(t *testing.T) {
+	t.Parallel()
+
+	prevBearish := OHLCData{Open: 120, High: 122, Low: 99, Close: 100}
+	currentBullish := OHLCData{Open: 106, High: 112, Low: 105, Close: 111}
+	for _, tt := range []struct {
+		name     string
+		size     float64
+		expected bool
+	}{
+		{"low", 0.1, false},
+		{"default", 0, true},
+		{"high", 0.8, true},
+	} {
+		t.Run("bullish_"+tt.name, func(t *testing.T) {
+			detected := detectHaramiAt([]OHLCData{prevBearish, currentBullish}, 1, CandlestickPatternConfig{HaramiMaxSize: tt.size})
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+	assert.False(t, detectBearishHaramiAt([]OHLCData{prevBearish, currentBullish}, 1, CandlestickPatternConfig{}))
+
+	prevBullish := OHLCData{Open: 100, High: 121, Low: 98, Close: 120}
+	currentBearish := OHLCData{Open: 114, High: 115, Low: 107, Close: 108}
+	for _, tt := range []struct {
+		name     string
+		size     float64
+		expected bool
+	}{
+		{"low", 0.1, false},
+		{"default", 0, true},
+		{"high", 0.8, true},
+	} {
+		t.Run("bearish_"+tt.name, func(t *testing.T) {
+			detected := detectBearishHaramiAt([]OHLCData{prevBullish, currentBearish}, 1, CandlestickPatternConfig{HaramiMaxSize: tt.size})
+			assert.Equal(t, tt.expected, detected)
+		})
+	}
+	assert.False(t, detectHaramiAt([]OHLCData{prevBullish, currentBearish}, 1, CandlestickPatternConfig{}))
+
+	// Body extending beyond the previous body is not contained
+	outside := OHLCData{Open: 118, High: 125, Low: 110, Close: 123}
+	assert.False(t, detectHaramiAt([]OHLCData{prevBearish, outside}, 1, CandlestickPatternConfig{HaramiMaxSize: 1}))
+	assert.False(t, detectHaramiAt([]OHLCData{currentBullish}, 0, CandlestickPatternConfig{}))
+}
+
+func TestTweezerPattern(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bottom_exact", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 125, High: 126, Low: 100, Close: 102},
+			{Open: 102, High: 108, Low: 100, Close: 107},
+		}
+		assert.True(t, detectTweezerBottomAt(data, 1, CandlestickPatternConfig{}))
+		assert.False(t, detectTweezerTopAt(data, 1, CandlestickPatternConfig{}))
+	})
+	t.Run("top_exact", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 110, High: 130, Low: 108, Close: 125},
+			{Open: 123, High: 130, Low: 115, Close: 118},
+		}
+		assert.True(t, detectTweezerTopAt(data, 1, CandlestickPatternConfig{}))
+		assert.False(t, detectTweezerBottomAt(data, 1, CandlestickPatternConfig{}))
+	})
+	t.Run("near_match", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 125, High: 126, Low: 100, Close: 102},
+			{Open: 102, High: 108, Low: 100.05, Close: 107},
+		}
+		assert.True(t, detectTweezerBottomAt(data, 1, CandlestickPatternConfig{}))
+		data[1].Low = 100.5
+		assert.False(t, detectTweezerBottomAt(data, 1, CandlestickPatternConfig{}))
+		assert.True(t, detectTweezerBottomAt(data, 1, CandlestickPatternConfig{TweezerTolerance: 0.01}))
+	})
+	t.Run("same_direction", func(t *testing.T) {
+		bearish := []OHLCData{
+			{Open: 125, High: 126, Low: 100, Close: 102},
+			{Open: 110, High: 112, Low: 100, Close: 104},
+		}
+		assert.False(t, detectTweezerBottomAt(bearish, 1, CandlestickPatternConfig{}))
+		bullish := []OHLCData{
+			{Open: 110, High: 130, Low: 108, Close: 125},
+			{Open: 120, High: 130, Low: 118, Close: 128},
+		}
+		assert.False(t, detectTweezerTopAt(bullish, 1, CandlestickPatternConfig{}))
+	})
+}
+
+func TestThreeSoldiersCrowsPattern(t *testing.T) {
+	t.Parallel()
+
+	soldiers := []OHLCData{
+		{Open: 110, High: 115, Low: 109, Close: 114},
+		{Open: 113, High: 118, Low: 112, Close: 117},
+		{Open: 116, High: 121, Low: 115, Close: 120},
+	}
+	crows := []OHLCData{
+		{Open: 120, High: 121, Low: 115, Close: 116},
+		{Open: 117, High: 118, Low: 112, Close: 113},
+		{Open: 114, High: 115, Low: 108, Close: 109},
+	}
+
+	t.Run("detected", func(t *testing.T) {
+		assert.True(t, detectThreeWhiteSoldiersAt(soldiers, 2, CandlestickPatternConfig{}))
+		assert.False(t, detectThreeBlackCrowsAt(soldiers, 2, CandlestickPatternConfig{}))
+		assert.True(t, detectThreeBlackCrowsAt(crows, 2, CandlestickPatternConfig{}))
+		assert.False(t, detectThreeWhiteSoldiersAt(crows, 2, CandlestickPatternConfig{}))
+		assert.False(t, detectThreeWhiteSoldiersAt(soldiers, 1, CandlestickPatternConfig{}))
+	})
+	t.Run("min_body_ratio", func(t *testing.T) {
+		// each body covers 4/6 of the candle range
+		assert.True(t, detectThreeWhiteSoldiersAt(soldiers, 2, CandlestickPatternConfig{SoldierMinBodyRatio: 0.6}))
+		assert.False(t, detectThreeWhiteSoldiersAt(soldiers, 2, CandlestickPatternConfig{SoldierMinBodyRatio: 0.7}))
+		assert.False(t, detectThreeBlackCrowsAt(crows, 2, CandlestickPatternConfig{SoldierMinBodyRatio: 0.9}))
+
+		dojiFiller := slices.Clone(soldiers)
+		dojiFiller[1] = OHLCData{Open: 113, High: 120, Low: 108, Close: 114.5}
+		assert.False(t, detectThreeWhiteSoldiersAt(dojiFiller, 2, CandlestickPatternConfig{}))
+	})
+	t.Run("open_outside_body", func(t *testing.T) {
+		gapped := slices.Clone(soldiers)
+		gapped[2] = OHLCData{Open: 118, High: 123, Low: 117, Close: 122}
+		assert.False(t, detectThreeWhiteSoldiersAt(gapped, 2, CandlestickPatternConfig{}))
+	})
+	t.Run("lower_close", func(t *testing.T) {
+		stalled := slices.Clone(crows)
+		stalled[2] = OHLCData{Open: 116, High: 117, Low: 113, Close: 114}
+		assert.False(t, detectThreeBlackCrowsAt(stalled, 2, CandlestickPatternConfig{}))
+	})
+}
+
 func TestShootingStarPattern(t *testing.T) {
 	t.Parallel()
 
@@ -228,6 +360,54 @@ func TestDragonflyDojiPattern(t *testing.T) {
 	assert.False(t, detectDragonflyDojiAt([]OHLCData{dojiNoShadow}, 0, CandlestickPatternConfig{DojiThreshold: 0.01, ShadowRatio: 2.0}))
 }
 
+func TestDojiSubtypeShadowRatio(t *testing.T) {
+	t.Parallel()
+
+	// upper shadow is ~120x the body, lower shadow is ~190x the body
+	gravestone := []OHLCData{{Open: 108, High: 120, Low: 107, Close: 108.1}}
+	dragonfly := []OHLCData{{Open: 109, High: 110, Low: 90, Close: 108.9}}
+	hammer := []OHLCData{{Open: 105, High: 106, Low: 95, Close: 105.5}}
+
+	for _, tt := range []struct {
+		name               string
+		cfg                CandlestickPatternConfig
+		expectedGravestone bool
+		expectedDragonfly  bool
+	}{
+		{"shared_ratio", CandlestickPatternConfig{ShadowRatio: 2}, true, true},
+		{"gravestone_stricter", CandlestickPatternConfig{ShadowRatio: 2, GravestoneShadowRatio: 150}, false, true},
+		{"dragonfly_stricter", CandlestickPatternConfig{ShadowRatio: 2, DragonflyShadowRatio: 200}, true, false},
+		{"gravestone_boundary", CandlestickPatternConfig{GravestoneShadowRatio: 119}, true, true},
+		{"overrides_looser", CandlestickPatternConfig{
+			ShadowRatio: 500, GravestoneShadowRatio: 100, DragonflyShadowRatio: 100,
+		}, true, true},
+		{"shared_ratio_strict", CandlestickPatternConfig{ShadowRatio: 500}, false, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedGravestone, detectGravestoneDojiAt(gravestone, 0, tt.cfg))
+			assert.Equal(t, tt.expectedDragonfly, detectDragonflyDojiAt(dragonfly, 0, tt.cfg))
+		})
+	}
+
+	t.Run("hammer_unaffected", func(t *testing.T) {
+		cfg := (&CandlestickPatternConfig{}).WithShadowRatio(2).WithGravestoneShadowRatio(500).WithDragonflyShadowRatio(500)
+		assert.True(t, detectHammerAt(hammer, 0, *cfg))
+		assert.False(t, detectDragonflyDojiAt(dragonfly, 0, *cfg))
+	})
+	t.Run("confidence", func(t *testing.T) {
+		base := CandlestickPatternConfig{ShadowRatio: 2}
+		strict := CandlestickPatternConfig{ShadowRatio: 2, GravestoneShadowRatio: 119}
+		assert.Less(t, gravestoneConfidence(gravestone, 0, strict), gravestoneConfidence(gravestone, 0, base))
+		assert.InDelta(t, dragonflyConfidence(dragonfly, 0, base), dragonflyConfidence(dragonfly, 0, strict), 0)
+	})
+	t.Run("merge", func(t *testing.T) {
+		merged := (&CandlestickPatternConfig{GravestoneShadowRatio: 3}).
+			MergePatterns(&CandlestickPatternConfig{GravestoneShadowRatio: 5, DragonflyShadowRatio: 4})
+		assert.InDelta(t, 3.0, merged.GravestoneShadowRatio, 0)
+		assert.InDelta(t, 4.0, merged.DragonflyShadowRatio, 0)
+	})
+}
+
 func TestMorningStarPattern(t *testing.T) {
 	t.Parallel()
 
@@ -278,6 +458,114 @@ func TestEveningStarPattern(t *testing.T) {
 	assert.False(t, detectEveningStarAt([]OHLCData{first, second, invalidThird}, 2, opt))
 }
 
+func TestAbandonedBabyPattern(t *testing.T) {
+	t.Parallel()
+
+	opt := CandlestickPatternConfig{}
+
+	t.Run("bullish", func(t *testing.T) {
+		first := OHLCData{Open: 120, High: 121, Low: 105, Close: 106}  // Large bearish
+		second := OHLCData{Open: 102, High: 103, Low: 101, Close: 102} // Doji, gaps below first low
+		third := OHLCData{Open: 105, High: 118, Low: 104, Close: 117}  // Bullish, gaps above doji high
+
+		assert.True(t, detectAbandonedBabyAt([]OHLCData{first, second, third}, 2, opt))
+		assert.False(t, detectBearishAbandonedBabyAt([]OHLCData{first, second, third}, 2, opt))
+
+		// Invalid: doji shadow overlaps the first candle
+		noGapSecond := OHLCData{Open: 102, High: 105.5, Low: 101, Close: 102}
+		assert.False(t, detectAbandonedBabyAt([]OHLCData{first, noGapSecond, third}, 2, opt))
+
+		// Invalid: third candle does not gap above the doji
+		noGapThird := OHLCData{Open: 103, High: 118, Low: 102.5, Close: 117}
+		assert.False(t, detectAbandonedBabyAt([]OHLCData{first, second, noGapThird}, 2, opt))
+
+		// Invalid: middle candle is not a doji
+		nonDojiSecond := OHLCData{Open: 103, High: 103.5, Low: 100, Close: 100.5}
+		assert.False(t, detectAbandonedBabyAt([]OHLCData{first, nonDojiSecond, third}, 2, opt))
+
+		// Invalid: third candle not bullish
+		bearishThird := OHLCData{Open: 117, High: 118, Low: 104, Close: 105}
+		assert.False(t, detectAbandonedBabyAt([]OHLCData{first, second, bearishThird}, 2, opt))
+	})
+
+	t.Run("bearish", func(t *testing.T) {
+		first := OHLCData{Open: 100, High: 115, Low: 99, Close: 114}   // Large bullish
+		second := OHLCData{Open: 118, High: 119, Low: 117, Close: 118} // Doji, gaps above first high
+		third := OHLCData{Open: 115, High: 116, Low: 102, Close: 103}  // Bearish, gaps below doji low
+
+		assert.True(t, detectBearishAbandonedBabyAt([]OHLCData{first, second, third}, 2, opt))
+		assert.False(t, detectAbandonedBabyAt([]OHLCData{first, second, third}, 2, opt))
+
+		// Invalid: doji shadow overlaps the first candle
+		noGapSecond := OHLCData{Open: 118, High: 119, Low: 114.5, Close: 118}
+		assert.False(t, detectBearishAbandonedBabyAt([]OHLCData{first, noGapSecond, third}, 2, opt))
+
+		// Invalid: third candle does not gap below the doji
+		noGapThird := OHLCData{Open: 115, High: 117.5, Low: 102, Close: 103}
+		assert.False(t, detectBearishAbandonedBabyAt([]OHLCData{first, second, noGapThird}, 2, opt))
+
+		// Invalid: middle candle is not a doji
+		nonDojiSecond := OHLCData{Open: 116.5, High: 120, Low: 116, Close: 119.5}
+		assert.False(t, detectBearishAbandonedBabyAt([]OHLCData{first, nonDojiSecond, third}, 2, opt))
+	})
+
+	t.Run("trend_lookback", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 130, High: 131, Low: 124, Close: 125},
+			{Open: 125, High: 126, Low: 119, Close: 120},
+			{Open: 120, High: 121, Low: 105, Close: 106},
+			{Open: 102, High: 103, Low: 101, Close: 102},
+			{Open: 105, High: 118, Low: 104, Close: 117},
+		}
+		assert.True(t, detectAbandonedBabyAt(data, 4, CandlestickPatternConfig{TrendLookback: 1}))
+		assert.False(t, detectBearishAbandonedBabyAt(data, 4, CandlestickPatternConfig{TrendLookback: 1}))
+	})
+}
+
+func TestTriStarPattern(t *testing.T) {
+	t.Parallel()
+
+	opt := CandlestickPatternConfig{}
+
+	t.Run("bullish", func(t *testing.T) {
+		first := OHLCData{Open: 110, High: 112, Low: 108, Close: 110.1}
+		second := OHLCData{Open: 105, High: 107, Low: 103, Close: 105.1} // Body gaps below both neighbors
+		third := OHLCData{Open: 109, High: 111, Low: 107, Close: 109.1}
+
+		assert.True(t, detectTriStarAt([]OHLCData{first, second, third}, 2, opt))
+		assert.False(t, detectBearishTriStarAt([]OHLCData{first, second, third}, 2, opt))
+
+		// Invalid: middle doji body does not gap below the third
+		noGapSecond := OHLCData{Open: 109.05, High: 111, Low: 107, Close: 109}
+		assert.False(t, detectTriStarAt([]OHLCData{first, noGapSecond, third}, 2, opt))
+
+		// Invalid: middle candle is not a doji
+		nonDojiSecond := OHLCData{Open: 103.5, High: 107, Low: 103, Close: 106}
+		assert.False(t, detectTriStarAt([]OHLCData{first, nonDojiSecond, third}, 2, opt))
+
+		// Invalid: third candle is not a doji
+		nonDojiThird := OHLCData{Open: 108, High: 111, Low: 107, Close: 110.5}
+		assert.False(t, detectTriStarAt([]OHLCData{first, second, nonDojiThird}, 2, opt))
+	})
+
+	t.Run("bearish", func(t *testing.T) {
+		first := OHLCData{Open: 110, High: 112, Low: 108, Close: 110.1}
+		second := OHLCData{Open: 115, High: 117, Low: 113, Close: 115.1} // Body gaps above both neighbors
+		third := OHLCData{Open: 111, High: 113, Low: 109, Close: 111.1}
+
+		assert.True(t, detectBearishTriStarAt([]OHLCData{first, second, third}, 2, opt))
+		assert.False(t, detectTriStarAt([]OHLCData{first, second, third}, 2, opt))
+
+		// Invalid: middle doji body does not gap above the first
+		noGapSecond := OHLCData{Open: 110.05, High: 112, Low: 108, Close: 110.1}
+		assert.False(t, detectBearishTriStarAt([]OHLCData{first, noGapSecond, third}, 2, opt))
+
+		// Invalid: first candle is not a doji
+		nonDojiFirst := OHLCData{Open: 108.5, High: 112, Low: 108, Close: 111.5}
+		assert.False(t, detectBearishTriStarAt([]OHLCData{nonDojiFirst, second, third}, 2, opt))
+	})
+}
+
 func newCandlestickWithPatterns(data []OHLCData, options ...CandlestickPatternConfig) CandlestickSeries {
 	// Start with defaults and override with provided options
 	config := &CandlestickPatternConfig{
@@ -369,6 +657,58 @@ func TestMarubozuPattern(t *testing.T) {
 	assert.True(t, detectBullishMarubozuAt([]OHLCData{notMarubozu}, 0, CandlestickPatternConfig{ShadowTolerance: 0.7}))
 }
 
+func TestShadowToleranceMode(t *testing.T) {
+	t.Parallel()
+
+	// shadows total 0.1 on a 10.1 range, ~0.0099 of the range
+	bullish := []OHLCData{{Open: 100, High: 110.05, Low: 99.95, Close: 110}}
+	bearish := []OHLCData{{Open: 110, High: 110.05, Low: 99.95, Close: 100}}
+
+	for _, tt := range []struct {
+		name     string
+		mode     ShadowToleranceMode
+		tol      float64
+		expected bool
+	}{
+		{"relative_within", ShadowToleranceRelative, 0.01, true},
+		{"relative_exceeded", ShadowToleranceRelative, 0.009, false},
+		{"absolute_within", ShadowToleranceAbsolute, 0.11, true},
+		{"absolute_exceeded", ShadowToleranceAbsolute, 0.09, false},
+		{"absolute_ratio_value", ShadowToleranceAbsolute, 0.01, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := (&CandlestickPatternConfig{}).WithShadowTolerance(tt.tol).WithShadowToleranceMode(tt.mode)
+			assert.Equal(t, tt.expected, detectBullishMarubozuAt(bullish, 0, *cfg))
+			assert.Equal(t, tt.expected, detectBearishMarubozuAt(bearish, 0, *cfg))
+		})
+	}
+
+	t.Run("absolute_default", func(t *testing.T) {
+		// an unset tolerance falls back to 0.01, measured as a price in absolute mode
+		cfg := CandlestickPatternConfig{ShadowToleranceMode: ShadowToleranceAbsolute}
+		assert.InDelta(t, 0.01, cfg.shadowTolerance(), 0)
+		assert.False(t, detectBullishMarubozuAt(bullish, 0, cfg))
+
+		tight := []OHLCData{{Open: 100, High: 110.004, Low: 99.996, Close: 110}}
+		assert.True(t, detectBullishMarubozuAt(tight, 0, cfg))
+	})
+	t.Run("confidence", func(t *testing.T) {
+		relative := CandlestickPatternConfig{ShadowTolerance: 0.01}
+		absolute := CandlestickPatternConfig{ShadowTolerance: 0.2, ShadowToleranceMode: ShadowToleranceAbsolute}
+		assert.Greater(t, marubozuConfidence(bullish, 0, absolute), marubozuConfidence(bullish, 0, relative))
+	})
+	t.Run("merge", func(t *testing.T) {
+		absolute := &CandlestickPatternConfig{ShadowTolerance: 0.1, ShadowToleranceMode: ShadowToleranceAbsolute}
+		merged := (&CandlestickPatternConfig{}).MergePatterns(absolute)
+		assert.InDelta(t, 0.1, merged.ShadowTolerance, 0)
+		assert.Equal(t, ShadowToleranceAbsolute, merged.ShadowToleranceMode)
+
+		merged = (&CandlestickPatternConfig{ShadowTolerance: 0.02}).MergePatterns(absolute)
+		assert.InDelta(t, 0.02, merged.ShadowTolerance, 0)
+		assert.Equal(t, ShadowToleranceRelative, merged.ShadowToleranceMode)
+	})
+}
+
 func TestPiercingLinePattern(t *testing.T) {
 	t.Parallel()
 
@@ -399,6 +739,61 @@ func TestDarkCloudCoverPattern(t *testing.T) {
 	assert.False(t, detected)
 }
 
+func TestKickerPattern(t *testing.T) {
+	t.Parallel()
+
+	opt := CandlestickPatternConfig{}
+
+	t.Run("bullish", func(t *testing.T) {
+		prev := OHLCData{Open: 110, High: 111, Low: 99, Close: 100}     // Bearish
+		current := OHLCData{Open: 112, High: 124, Low: 111, Close: 123} // Bullish, body gaps above prev open
+
+		assert.True(t, detectBullishKickerAt([]OHLCData{prev, current}, 1, opt))
+		assert.False(t, detectBearishKickerAt([]OHLCData{prev, current}, 1, opt))
+
+		// Invalid: bodies overlap
+		overlap := OHLCData{Open: 108, High: 124, Low: 107, Close: 123}
+		assert.False(t, detectBullishKickerAt([]OHLCData{prev, overlap}, 1, opt))
+
+		// Invalid: opens at the previous open, touching bodies
+		touching := OHLCData{Open: 110, High: 124, Low: 109, Close: 123}
+		assert.False(t, detectBullishKickerAt([]OHLCData{prev, touching}, 1, opt))
+
+		// Invalid: both candles bullish
+		bullishPrev := OHLCData{Open: 100, High: 111, Low: 99, Close: 110}
+		assert.False(t, detectBullishKickerAt([]OHLCData{bullishPrev, current}, 1, opt))
+	})
+
+	t.Run("bearish", func(t *testing.T) {
+		prev := OHLCData{Open: 100, High: 111, Low: 99, Close: 110} // Bullish
+		current := OHLCData{Open: 98, High: 99, Low: 86, Close: 87} // Bearish, body gaps below prev open
+
+		assert.True(t, detectBearishKickerAt([]OHLCData{prev, current}, 1, opt))
+		assert.False(t, detectBullishKickerAt([]OHLCData{prev, current}, 1, opt))
+
+		// Invalid: bodies overlap
+		overlap := OHLCData{Open: 103, High: 104, Low: 86, Close: 87}
+		assert.False(t, detectBearishKickerAt([]OHLCData{prev, overlap}, 1, opt))
+
+		// Invalid: both candles bearish
+		bearishPrev := OHLCData{Open: 110, High: 111, Low: 99, Close: 100}
+		assert.False(t, detectBearishKickerAt([]OHLCData{bearishPrev, current}, 1, opt))
+	})
+
+	t.Run("trend_lookback", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 90, High: 96, Low: 89, Close: 95},
+			{Open: 100, High: 111, Low: 99, Close: 110},
+			{Open: 98, High: 99, Low: 86, Close: 87},
+		}
+		cfg := CandlestickPatternConfig{TrendLookback: 1}
+		assert.False(t, detectBearishKickerAt(data, 2, cfg)) // no prior close before the lookback start
+		data = append([]OHLCData{{Open: 85, High: 91, Low: 84, Close: 90}}, data...)
+		assert.True(t, detectBearishKickerAt(data, 3, cfg))
+		assert.False(t, detectBullishKickerAt(data, 3, cfg))
+	})
+}
+
 func TestPatternValidation(t *testing.T) {
 	t.Parallel()
 
@@ -420,12 +815,39 @@ func TestPatternValidation(t *testing.T) {
 	assert.False(t, detectEveningStarAt([]OHLCData{invalidOHLC, validOHLC, validOHLC}, 2, opt))
 	assert.False(t, detectEveningStarAt([]OHLCData{validOHLC, invalidOHLC, validOHLC}, 2, opt))
 	assert.False(t, detectEveningStarAt([]OHLCData{validOHLC, validOHLC, invalidOHLC}, 2, opt))
-}
 
-func TestPatternScanningComprehensive(t *testing.T) {
-	t.Parallel()
+	assert.False(t, detectHaramiAt([]OHLCData{invalidOHLC, validOHLC}, 1, opt))
+	assert.False(t, detectHaramiAt([]OHLCData{validOHLC, invalidOHLC}, 1, opt))
+	assert.False(t, detectBearishHaramiAt([]OHLCData{invalidOHLC, validOHLC}, 1, opt))
+	assert.False(t, detectBearishHaramiAt([]OHLCData{validOHLC, invalidOHLC}, 1, opt))
+	assert.False(t, detectTweezerTopAt([]OHLCData{invalidOHLC, validOHLC}, 1, opt))
+	assert.False(t, detectTweezerBottomAt([]OHLCData{validOHLC, invalidOHLC}, 1, opt))
+
+	soldiers := []OHLCData{
+		{Open: 110, High: 115, Low: 109, Close: 114},
+		{Open: 113, High: 118, Low: 112, Close: 117},
+		{Open: 116, High: 121, Low: 115, Close: 120},
+	}
+	crows := []OHLCData{
+		{Open: 120, High: 121, Low: 115, Close: 116},
+		{Open: 117, High: 118, Low: 112, Close: 113},
+		{Open: 114, High: 115, Low: 108, Close: 109},
+	}
+	require.True(t, detectThreeWhiteSoldiersAt(soldiers, 2, opt))
+	require.True(t, detectThreeBlackCrowsAt(crows, 2, opt))
+	for i := range 3 {
+		invalidSoldiers := slices.Clone(soldiers)
+		invalidSoldiers[i] = invalidOHLC
+		assert.False(t, detectThreeWhiteSoldiersAt(invalidSoldiers, 2, opt))
+		invalidCrows := slices.Clone(crows)
+		invalidCrows[i] = invalidOHLC
+		assert.False(t, detectThreeBlackCrowsAt(invalidCrows, 2, opt))
+	}
+}
 
-	data := []OHLCData{
+// makeComprehensivePatternData returns a series with an example of each pattern.
+func makeComprehensivePatternData() []OHLCData {
+	return []OHLCData{
 		// Index 0: Normal candle
 		{Open: 100, High: 110, Low: 95, Close: 105},
 		// Index 1: Doji
@@ -473,6 +895,12 @@ func TestPatternScanningComprehensive(t *testing.T) {
 		{Open: 117, High: 118, Low: 112, Close: 113}, // 25: Second crow
 		{Open: 114, High: 115, Low: 108, Close: 109}, // 26: Third crow
 	}
+}
+
+func TestPatternScanningComprehensive(t *testing.T) {
+	t.Parallel()
+
+	data := makeComprehensivePatternData()
 
 	opt := (&CandlestickPatternConfig{}).WithPatternsAll()
 	opt.DojiThreshold = 0.01
@@ -491,7 +919,7 @@ func TestPatternScanningComprehensive(t *testing.T) {
 	}
 
 	// Check expected patterns
-	assert.Len(t, uniquePatterns, 13)
+	assert.Len(t, uniquePatterns, 19)
 	assert.Contains(t, patternsByIndex[1], "doji")
 	assert.Contains(t, patternsByIndex[2], "hammer")
 	assert.Contains(t, patternsByIndex[3], "shooting_star")
@@ -499,10 +927,349 @@ func TestPatternScanningComprehensive(t *testing.T) {
 	assert.Contains(t, patternsByIndex[5], "dragonfly_doji")
 	assert.Contains(t, patternsByIndex[8], "morning_star")
 	assert.Contains(t, patternsByIndex[11], "evening_star")
+	assert.Contains(t, patternsByIndex[11], "kicker_bear") // gaps below the small bullish star body
 	assert.Contains(t, patternsByIndex[12], "marubozu_bull")
 	assert.Contains(t, patternsByIndex[13], "marubozu_bear")
+	assert.Contains(t, patternsByIndex[13], "tweezer_top")
+	assert.Contains(t, patternsByIndex[14], "harami_bull")
 	assert.Contains(t, patternsByIndex[16], "piercing_line")
 	assert.Contains(t, patternsByIndex[18], "dark_cloud_cover")
+	assert.Contains(t, patternsByIndex[20], "tweezer_bottom")
+	assert.Contains(t, patternsByIndex[23], "three_white_soldiers")
+	assert.Contains(t, patternsByIndex[26], "three_black_crows")
+}
+
+func TestPatternDetectionAnchor(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 110, High: 112, Low: 100, Close: 102},
+		{Open: 104, High: 106.5, Low: 95, Close: 106}, // hammer closing below its high
+	}
+	patterns := scanForCandlestickPatterns(data, *(&CandlestickPatternConfig{}).WithHammer())
+
+	require.Len(t, patterns[1], 1)
+	result := patterns[1][0]
+	assert.Equal(t, candlestickPatternHammer, result.PatternType)
+	// the label is drawn at the close, not the candle extremes
+	assert.Equal(t, PatternAnchor{Index: 1, Price: data[1].Close}, result.Anchor)
+}
+
+func TestPatternDetectionAnchorPlacement(t *testing.T) {
+	t.Parallel()
+
+	// a hammer (bullish) at index 2 and shooting star (bearish) at index 4, each closing away from its extremes
+	data := []OHLCData{
+		{Open: 110, High: 112, Low: 105, Close: 106},
+		{Open: 106, High: 107, Low: 101, Close: 102},
+		{Open: 101, High: 101.5, Low: 92, Close: 101.2},
+		{Open: 101, High: 108, Low: 100, Close: 107},
+		{Open: 108.5, High: 118, Low: 108, Close: 108.2},
+	}
+	tests := []struct {
+		name        string
+		placement   PatternLabelPlacement
+		hammerPrice float64
+		starPrice   float64
+	}{
+		{name: "beside", placement: PatternLabelBeside, hammerPrice: data[2].Close, starPrice: data[4].Close},
+		{name: "above", placement: PatternLabelAbove, hammerPrice: data[2].High, starPrice: data[4].High},
+		{name: "below", placement: PatternLabelBelow, hammerPrice: data[2].Low, starPrice: data[4].Low},
+		{name: "auto", placement: PatternLabelAuto, hammerPrice: data[2].Low, starPrice: data[4].High},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := (&CandlestickPatternConfig{}).WithHammer().WithShootingStar().WithLabelPlacement(tt.placement)
+			patterns := scanForCandlestickPatterns(data, *config)
+
+			require.Len(t, patterns[2], 1)
+			assert.Equal(t, candlestickPatternHammer, patterns[2][0].PatternType)
+			assert.Equal(t, PatternAnchor{Index: 2, Price: tt.hammerPrice}, patterns[2][0].Anchor)
+			require.Len(t, patterns[4], 1)
+			assert.Equal(t, candlestickPatternShootingStar, patterns[4][0].PatternType)
+			assert.Equal(t, PatternAnchor{Index: 4, Price: tt.starPrice}, patterns[4][0].Anchor)
+		})
+	}
+}
+
+func TestPatternPreHistory(t *testing.T) {
+	t.Parallel()
+
+	preHistory := []OHLCData{
+		{Open: 110, High: 112, Low: 104, Close: 105},
+		{Open: 120, High: 125, Low: 105, Close: 108}, // large bearish
+	}
+	data := []OHLCData{
+		{Open: 102, High: 104, Low: 100, Close: 103}, // small body, gap down
+		{Open: 108, High: 125, Low: 106, Close: 122}, // large bullish, gap up
+		{Open: 122, High: 126, Low: 119, Close: 124},
+	}
+
+	config := (&CandlestickPatternConfig{}).WithMorningStar()
+	assert.Empty(t, scanForCandlestickPatterns(data, *config))
+
+	patterns := scanForCandlestickPatterns(data, *config.WithPreHistory(preHistory))
+	require.Len(t, patterns, 1)
+	require.Len(t, patterns[1], 1)
+	result := patterns[1][0]
+	assert.Equal(t, candlestickPatternMorningStar, result.PatternType)
+	assert.Equal(t, 1, result.Index)
+	assert.Equal(t, PatternAnchor{Index: 1, Price: data[1].Close}, result.Anchor)
+	assert.True(t, result.Partial)
+
+	// pattern fully within the charted data is not partial, and pre-history bars are never reported
+	fullData := append(slices.Clone(preHistory[1:]), data...)
+	patterns = scanForCandlestickPatterns(fullData, *config)
+	require.Len(t, patterns[2], 1)
+	assert.False(t, patterns[2][0].Partial)
+	patterns = scanForCandlestickPatterns(data, *(&CandlestickPatternConfig{}).WithPatternsAll().WithPreHistory(preHistory))
+	for index := range patterns {
+		assert.GreaterOrEqual(t, index, 0)
+	}
+}
+
+func TestPatternConfidence(t *testing.T) {
+	t.Parallel()
+
+	t.Run("doji", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithDoji()
+		exact := []OHLCData{{Open: 100, High: 105, Low: 95, Close: 100}}
+		borderline := []OHLCData{{Open: 100, High: 105, Low: 95, Close: 100.5}}
+
+		patterns := scanForCandlestickPatterns(exact, *config)
+		require.Len(t, patterns[0], 1)
+		assert.InDelta(t, 1.0, patterns[0][0].Confidence, 0.0001)
+		patterns = scanForCandlestickPatterns(borderline, *config)
+		require.Len(t, patterns[0], 1)
+		assert.InDelta(t, 0.5, patterns[0][0].Confidence, 0.0001)
+	})
+	t.Run("engulfing", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 110, High: 112, Low: 105, Close: 106},
+			{Open: 104, High: 115, Low: 103, Close: 114}, // body 2.5x the previous
+		}
+		patterns := scanForCandlestickPatterns(data, *(&CandlestickPatternConfig{}).WithEngulfingBull())
+		require.Len(t, patterns[1], 1)
+		assert.InDelta(t, 1.0, patterns[1][0].Confidence, 0.0001)
+	})
+	t.Run("all_in_range", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 108, High: 109, Low: 98, Close: 107},  // hammer
+			{Open: 106, High: 125, Low: 105, Close: 107}, // shooting star
+			{Open: 120, High: 125, Low: 105, Close: 108}, // morning star
+			{Open: 102, High: 104, Low: 100, Close: 103},
+			{Open: 108, High: 125, Low: 106, Close: 122},
+			{Open: 120, High: 135, Low: 120, Close: 135}, // bullish marubozu
+			{Open: 135, High: 135, Low: 115, Close: 115}, // bearish marubozu, tweezer top
+			{Open: 118, High: 125, Low: 110, Close: 119}, // harami
+			{Open: 125, High: 126, Low: 100, Close: 102},
+			{Open: 102, High: 108, Low: 100, Close: 107}, // tweezer bottom
+			{Open: 110, High: 115, Low: 109, Close: 114}, // soldiers
+			{Open: 113, High: 118, Low: 112, Close: 117},
+			{Open: 116, High: 121, Low: 115, Close: 120},
+			{Open: 120, High: 121, Low: 115, Close: 116}, // crows
+			{Open: 117, High: 118, Low: 112, Close: 113},
+			{Open: 114, High: 115, Low: 108, Close: 109},
+			{Open: 105, High: 108, Low: 102, Close: 105.05}, // doji
+		}
+		patterns := scanForCandlestickPatterns(data, *(&CandlestickPatternConfig{}).WithPatternsAll())
+		require.NotEmpty(t, patterns)
+		for _, results := range patterns {
+			for _, result := range results {
+				assert.GreaterOrEqual(t, result.Confidence, 0.5, result.PatternType)
+				assert.LessOrEqual(t, result.Confidence, 1.0, result.PatternType)
+			}
+		}
+	})
+	t.Run("min_confidence", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 100, High: 105, Low: 95, Close: 100},
+			{Open: 100, High: 105, Low: 95, Close: 100.4},
+		}
+		config := (&CandlestickPatternConfig{}).WithDoji()
+		assert.Len(t, scanForCandlestickPatterns(data, *config), 2)
+
+		patterns := scanForCandlestickPatterns(data, *config.WithMinConfidence(0.9))
+		require.Len(t, patterns, 1)
+		assert.Len(t, patterns[0], 1)
+	})
+}
+
+func TestPriorTrend(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 102, Low: 98, Close: 101},
+		{Open: 101, High: 104, Low: 100, Close: 103},
+		{Open: 103, High: 106, Low: 102, Close: 105},
+		{Open: 105, High: 106, Low: 100, Close: 101},
+		{Open: 101, High: 102, Low: 96, Close: 97},
+	}
+
+	assert.Equal(t, trendUp, priorTrend(data, 3, 2))
+	assert.Equal(t, trendDown, priorTrend(data, 5, 2))
+	assert.Equal(t, trendDown, priorTrend(data, 5, 4))
+	assert.Equal(t, trendFlat, priorTrend(data, 4, 3))     // 101 -> 101
+	assert.Equal(t, trendFlat, priorTrend(data, 2, 2))     // insufficient data
+	assert.Equal(t, trendFlat, priorTrend(data, 3, 0))     // disabled
+	assert.Equal(t, trendDown, priorTrend(data[1:], 4, 3)) // 103 -> 97
+}
+
+func TestPatternTrendLookback(t *testing.T) {
+	t.Parallel()
+
+	hammer := OHLCData{Open: 108, High: 109, Low: 98, Close: 107}
+	downtrend := []OHLCData{
+		{Open: 125, High: 126, Low: 119, Close: 120},
+		{Open: 120, High: 121, Low: 114, Close: 115},
+		{Open: 115, High: 116, Low: 109, Close: 110},
+		hammer,
+	}
+	uptrend := []OHLCData{
+		{Open: 95, High: 101, Low: 94, Close: 100},
+		{Open: 100, High: 106, Low: 99, Close: 105},
+		{Open: 105, High: 111, Low: 104, Close: 110},
+		hammer,
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.True(t, detectHammerAt(downtrend, 3, CandlestickPatternConfig{}))
+		assert.True(t, detectHammerAt(uptrend, 3, CandlestickPatternConfig{}))
+	})
+	t.Run("bullish_reversal", func(t *testing.T) {
+		config := CandlestickPatternConfig{TrendLookback: 2}
+		assert.True(t, detectHammerAt(downtrend, 3, config))
+		assert.False(t, detectHammerAt(uptrend, 3, config))
+		assert.False(t, detectHammerAt(downtrend[2:], 1, config)) // insufficient prior data
+	})
+	t.Run("bearish_reversal", func(t *testing.T) {
+		engulfing := []OHLCData{
+			{Open: 106, High: 112, Low: 105, Close: 110},
+			{Open: 114, High: 115, Low: 103, Close: 104},
+		}
+		config := CandlestickPatternConfig{TrendLookback: 2}
+		assert.True(t, detectBearishEngulfingAt(append(slices.Clone(uptrend[:3]), engulfing...), 4, config))
+		assert.False(t, detectBearishEngulfingAt(append(slices.Clone(downtrend[:3]), engulfing...), 4, config))
+	})
+	t.Run("continuation_not_gated", func(t *testing.T) {
+		marubozu := OHLCData{Open: 100, High: 120, Low: 100, Close: 120}
+		data := append(slices.Clone(uptrend[:3]), marubozu)
+		assert.True(t, detectBullishMarubozuAt(data, 3, CandlestickPatternConfig{TrendLookback: 2}))
+	})
+	t.Run("scan", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithHammer().WithTrendLookback(2)
+		assert.Len(t, scanForCandlestickPatterns(downtrend, *config)[3], 1)
+		assert.Empty(t, scanForCandlestickPatterns(uptrend, *config))
+	})
+}
+
+func TestPatternDirectionFilter(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 106, High: 112, Low: 105, Close: 110},
+		{Open: 114, High: 115, Low: 103, Close: 104}, // bearish engulfing
+		{Open: 103, High: 117, Low: 102, Close: 116}, // bullish engulfing
+	}
+	scanDirections := func(filter PatternDirectionFilter) map[patternDirection][]string {
+		config := (&CandlestickPatternConfig{}).WithPatternsAll().WithDirectionFilter(filter)
+		result := make(map[patternDirection][]string)
+		for _, patterns := range scanForCandlestickPatterns(data, *config) {
+			for _, pattern := range patterns {
+				direction := patternDetectors[pattern.PatternType].direction
+				result[direction] = append(result[direction], pattern.PatternType)
+			}
+		}
+		return result
+	}
+
+	all := scanDirections(DirectionAny)
+	require.Contains(t, all[patternDirectionBearish], candlestickPatternEngulfingBear)
+	require.Contains(t, all[patternDirectionBullish], candlestickPatternEngulfingBull)
+
+	bullish := scanDirections(DirectionBullishOnly)
+	assert.Empty(t, bullish[patternDirectionBearish])
+	assert.Empty(t, bullish[patternDirectionNeutral])
+	assert.Contains(t, bullish[patternDirectionBullish], candlestickPatternEngulfingBull)
+
+	bearish := scanDirections(DirectionBearishOnly)
+	assert.Empty(t, bearish[patternDirectionBullish])
+	assert.Empty(t, bearish[patternDirectionNeutral])
+	assert.Contains(t, bearish[patternDirectionBearish], candlestickPatternEngulfingBear)
+}
+
+func TestCandlestickPatternLabelStacking(t *testing.T) {
+	t.Parallel()
+
+	series := &CandlestickSeries{
+		Data: []OHLCData{
+			{Open: 100, High: 105, Low: 95, Close: 100},
+			{Open: 100, High: 105, Low: 95, Close: 100},
+		},
+		PatternConfig: (&CandlestickPatternConfig{}).WithDoji(),
+	}
+	patternMap := scanForCandlestickPatterns(series.Data, *series.PatternConfig)
+	require.Len(t, patternMap, 2)
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	label := SeriesLabel{
+		Show:           Ptr(true),
+		LabelFormatter: createPatternAwareLabelFormatter(series, 0, GetDefaultTheme(), patternMap),
+	}
+	labelPainter := newSeriesLabelPainter(p, []string{"s"}, label, GetDefaultTheme(), 0)
+	labelPainter.stackOverlapping = true
+	for i := range series.Data { // adjacent candles with the same close
+		labelPainter.Add(labelValue{index: i, dataIndex: i, value: series.Data[i].Close, x: 200 + i*12, y: 200})
+	}
+	_, err := labelPainter.Render()
+	require.NoError(t, err)
+
+	require.Len(t, labelPainter.values, 2)
+	first := labelPainter.values[0].bounds()
+	second := labelPainter.values[1].bounds()
+	require.Less(t, first.Left, second.Right) // boxes share a horizontal extent
+	require.Less(t, second.Left, first.Right)
+	assert.False(t, first.Overlaps(second))
+	assert.False(t, labelPainter.values[1].leaderLine)
+}
+
+func TestCandlestickPatternLabelStackingCluster(t *testing.T) {
+	t.Parallel()
+
+	series := &CandlestickSeries{
+		Data: []OHLCData{
+			{Open: 100, High: 105, Low: 95, Close: 100},
+			{Open: 100, High: 105, Low: 95, Close: 100},
+			{Open: 100, High: 105, Low: 95, Close: 100},
+		},
+		PatternConfig: (&CandlestickPatternConfig{}).WithDoji(),
+		Label:         SeriesLabel{LeaderLines: Ptr(true)},
+	}
+	patternMap := scanForCandlestickPatterns(series.Data, *series.PatternConfig)
+	require.Len(t, patternMap, 3)
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	label := series.Label
+	label.Show = Ptr(true)
+	label.LabelFormatter = createPatternAwareLabelFormatter(series, 0, GetDefaultTheme(), patternMap)
+	labelPainter := newSeriesLabelPainter(p, []string{"s"}, label, GetDefaultTheme(), 0)
+	labelPainter.stackOverlapping = true
+	for i := range series.Data { // adjacent candles with the same close
+		labelPainter.Add(labelValue{index: i, dataIndex: i, value: series.Data[i].Close, x: 200 + i*12, y: 200})
+	}
+	_, err := labelPainter.Render()
+	require.NoError(t, err)
+
+	require.Len(t, labelPainter.values, 3)
+	for i := range labelPainter.values {
+		for j := i + 1; j < len(labelPainter.values); j++ {
+			assert.False(t, labelPainter.values[i].bounds().Overlaps(labelPainter.values[j].bounds()), "%d and %d", i, j)
+		}
+	}
+	// moved labels are connected back to their candle
+	assert.False(t, labelPainter.values[0].leaderLine)
+	assert.True(t, labelPainter.values[1].leaderLine)
+	assert.True(t, labelPainter.values[2].leaderLine)
 }
 
 func TestCandlestickPatternSets(t *testing.T) {
@@ -513,7 +1280,13 @@ func TestCandlestickPatternSets(t *testing.T) {
 
 		assert.Contains(t, config.EnabledPatterns, "doji")
 		assert.Contains(t, config.EnabledPatterns, "hammer")
-		assert.Len(t, config.EnabledPatterns, 14)
+		assert.Contains(t, config.EnabledPatterns, "harami_bull")
+		assert.Contains(t, config.EnabledPatterns, "tweezer_top")
+		assert.Contains(t, config.EnabledPatterns, "three_white_soldiers")
+		assert.Contains(t, config.EnabledPatterns, "abandoned_baby_bull")
+		assert.Contains(t, config.EnabledPatterns, "tri_star_bear")
+		assert.Contains(t, config.EnabledPatterns, "kicker_bull")
+		assert.Len(t, config.EnabledPatterns, 26)
 	})
 
 	t.Run("core", func(t *testing.T) {
@@ -529,7 +1302,7 @@ func TestCandlestickPatternSets(t *testing.T) {
 
 		assert.Contains(t, config.EnabledPatterns, "hammer")
 		assert.NotContains(t, config.EnabledPatterns, "shooting_star")
-		assert.Len(t, config.EnabledPatterns, 7)
+		assert.Len(t, config.EnabledPatterns, 13)
 	})
 
 	t.Run("bearish", func(t *testing.T) {
@@ -537,7 +1310,25 @@ func TestCandlestickPatternSets(t *testing.T) {
 
 		assert.Contains(t, config.EnabledPatterns, "shooting_star")
 		assert.NotContains(t, config.EnabledPatterns, "hammer")
-		assert.Len(t, config.EnabledPatterns, 6)
+		assert.Len(t, config.EnabledPatterns, 12)
+	})
+
+	t.Run("direction_matches_registry", func(t *testing.T) {
+		bullish := (&CandlestickPatternConfig{}).WithPatternsBullish().EnabledPatterns
+		bearish := (&CandlestickPatternConfig{}).WithPatternsBearish().EnabledPatterns
+		for patternType, detector := range patternDetectors {
+			switch detector.direction {
+			case patternDirectionBullish:
+				assert.Contains(t, bullish, patternType)
+				assert.NotContains(t, bearish, patternType)
+			case patternDirectionBearish:
+				assert.Contains(t, bearish, patternType)
+				assert.NotContains(t, bullish, patternType)
+			default:
+				assert.NotContains(t, bullish, patternType)
+				assert.NotContains(t, bearish, patternType)
+			}
+		}
 	})
 
 	t.Run("reversal", func(t *testing.T) {
@@ -545,15 +1336,21 @@ func TestCandlestickPatternSets(t *testing.T) {
 
 		assert.Contains(t, config.EnabledPatterns, "hammer")
 		assert.NotContains(t, config.EnabledPatterns, "marubozu_bull")
-		assert.Len(t, config.EnabledPatterns, 10)
+		assert.Contains(t, config.EnabledPatterns, "harami_bear")
+		assert.Contains(t, config.EnabledPatterns, "tweezer_bottom")
+		assert.Contains(t, config.EnabledPatterns, "abandoned_baby_bear")
+		assert.Contains(t, config.EnabledPatterns, "tri_star_bull")
+		assert.Contains(t, config.EnabledPatterns, "kicker_bear")
+		assert.Len(t, config.EnabledPatterns, 20)
 	})
 
 	t.Run("trend", func(t *testing.T) {
 		config := (&CandlestickPatternConfig{}).WithPatternsTrend()
 
 		assert.Contains(t, config.EnabledPatterns, "marubozu_bull")
+		assert.Contains(t, config.EnabledPatterns, "three_black_crows")
 		assert.NotContains(t, config.EnabledPatterns, "hammer")
-		assert.Len(t, config.EnabledPatterns, 2)
+		assert.Len(t, config.EnabledPatterns, 4)
 	})
 }
 
@@ -1262,6 +2059,22 @@ func TestCandlestickPatternConfigMergePatterns(t *testing.T) {
 		assert.Equal(t, "shooting_star", merged.EnabledPatterns[2])
 	})
 
+	t.Run("merge_label_font_size", func(t *testing.T) {
+		sized := &CandlestickPatternConfig{LabelFontSize: 14}
+		assert.InDelta(t, 14, (&CandlestickPatternConfig{}).MergePatterns(sized).LabelFontSize, 0)
+		merged := (&CandlestickPatternConfig{LabelFontSize: 8}).MergePatterns(sized)
+		assert.InDelta(t, 8, merged.LabelFontSize, 0)
+	})
+
+	t.Run("merge_label_placement", func(t *testing.T) {
+		below := (&CandlestickPatternConfig{}).WithLabelPlacement(PatternLabelBelow)
+		merged := below.MergePatterns(&CandlestickPatternConfig{LabelPlacement: PatternLabelAbove})
+		assert.Equal(t, PatternLabelBelow, merged.LabelPlacement)
+
+		merged = (&CandlestickPatternConfig{}).MergePatterns(&CandlestickPatternConfig{LabelPlacement: PatternLabelAbove})
+		assert.Equal(t, PatternLabelAbove, merged.LabelPlacement)
+	})
+
 	t.Run("merge_with_nil", func(t *testing.T) {
 		config := &CandlestickPatternConfig{
 			PreferPatternLabels: true,
@@ -1334,3 +2147,202 @@ func TestCandlestickPatternConfigMergePatterns(t *testing.T) {
 		assert.Contains(t, merged.EnabledPatterns, "marubozu_bull")  // From Trend
 	})
 }
+
+func TestPatternOverlapStrategy(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 101, Low: 99, Close: 100.5},
+		{Open: 108, High: 120, Low: 107, Close: 108.1}, // gravestone doji, also a shooting star, doji, inverted hammer
+	}
+	patternTypes := func(config *CandlestickPatternConfig) []string {
+		var result []string
+		for _, pattern := range scanForCandlestickPatterns(data, *config)[1] {
+			result = append(result, pattern.PatternType)
+		}
+		return result
+	}
+
+	t.Run("show_all", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithPatternsAll()
+		assert.ElementsMatch(t, []string{candlestickPatternShootingStar, candlestickPatternGravestone,
+			candlestickPatternDoji, candlestickPatternInvertedHammer}, patternTypes(config))
+	})
+	t.Run("highest_confidence", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithPatternsAll().WithOverlapStrategy(OverlapHighestConfidence)
+		// shooting star and inverted hammer tie, the first enabled pattern is kept
+		assert.Equal(t, []string{candlestickPatternShootingStar}, patternTypes(config))
+	})
+	t.Run("priority_order", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithPatternsAll().
+			WithPatternPriority(candlestickPatternHammer, candlestickPatternGravestone, candlestickPatternDoji)
+		assert.Equal(t, []string{candlestickPatternGravestone}, patternTypes(config))
+	})
+	t.Run("priority_order_unlisted", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithPatternsAll().WithPatternPriority()
+		assert.Equal(t, []string{candlestickPatternShootingStar}, patternTypes(config))
+	})
+	t.Run("single_label_rendered", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithPatternsAll().WithPatternPriority(candlestickPatternGravestone)
+		opt := makePatternChartOption(data, *config)
+		opt.SeriesList[0].PatternConfig = config
+		svg := renderCandlestickSVG(t, opt)
+
+		assert.Contains(t, svg, "Gravestone")
+		assert.NotContains(t, svg, "Shooting Star")
+		assert.NotContains(t, svg, "Inverted Hammer")
+	})
+}
+
+func TestPatternStyleOverrides(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 110, Low: 95, Close: 105},
+		{Open: 105, High: 108, Low: 102, Close: 105.05}, // doji
+	}
+	render := func(t *testing.T, config *CandlestickPatternConfig) string {
+		t.Helper()
+
+		opt := makePatternChartOption(data, *config)
+		opt.SeriesList[0].PatternConfig = config
+		return renderCandlestickSVG(t, opt)
+	}
+
+	t.Run("default", func(t *testing.T) {
+		svg := render(t, (&CandlestickPatternConfig{}).WithDoji())
+		assert.Contains(t, svg, ">↔ Doji<")
+	})
+	t.Run("doji_override", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithDoji()
+		config.PatternStyles = map[string]LabelStyle{
+			candlestickPatternDoji: {
+				FontStyle:   FontStyle{FontColor: ColorRGB(10, 20, 30)},
+				BorderColor: ColorRGB(200, 20, 150),
+			},
+		}
+		config.PatternGlyphs = map[string]string{candlestickPatternDoji: "◎"}
+		svg := render(t, config)
+
+		assert.Contains(t, svg, ">◎ Doji<")
+		assert.NotContains(t, svg, "↔")
+		assert.Contains(t, svg, "fill:rgb(10,20,30)")
+		assert.Contains(t, svg, "stroke:rgb(200,20,150)")
+		assert.Contains(t, svg, "font-size:12.8px") // default label size retained
+	})
+	t.Run("empty_glyph", func(t *testing.T) {
+		config := (&CandlestickPatternConfig{}).WithDoji()
+		config.PatternGlyphs = map[string]string{candlestickPatternDoji: ""}
+		assert.Contains(t, render(t, config), ">Doji<")
+	})
+}
+
+func TestScanCandlestickPatterns(t *testing.T) {
+	t.Parallel()
+
+	data := makeComprehensivePatternData()
+	cfg := (&CandlestickPatternConfig{}).WithPatternsAll().WithDojiThreshold(0.01).WithEngulfingMinSize(0.8)
+
+	t.Run("matches_internal_scan", func(t *testing.T) {
+		patterns := ScanCandlestickPatterns(data, *cfg)
+		assert.NotEmpty(t, patterns)
+		assert.Equal(t, scanForCandlestickPatterns(data, *cfg), patterns)
+	})
+	t.Run("no_patterns_enabled", func(t *testing.T) {
+		assert.Nil(t, ScanCandlestickPatterns(data, CandlestickPatternConfig{}))
+	})
+	t.Run("predicates_match_detectors", func(t *testing.T) {
+		predicates := map[string]func([]OHLCData, int, CandlestickPatternConfig) bool{
+			candlestickPatternDoji:               DetectDoji,
+			candlestickPatternHammer:             DetectHammer,
+			candlestickPatternInvertedHammer:     DetectInvertedHammer,
+			candlestickPatternShootingStar:       DetectShootingStar,
+			candlestickPatternGravestone:         DetectGravestoneDoji,
+			candlestickPatternDragonfly:          DetectDragonflyDoji,
+			candlestickPatternMarubozuBull:       DetectBullishMarubozu,
+			candlestickPatternMarubozuBear:       DetectBearishMarubozu,
+			candlestickPatternEngulfingBull:      DetectBullishEngulfing,
+			candlestickPatternEngulfingBear:      DetectBearishEngulfing,
+			candlestickPatternHaramiBull:         DetectBullishHarami,
+			candlestickPatternHaramiBear:         DetectBearishHarami,
+			candlestickPatternTweezerTop:         DetectTweezerTop,
+			candlestickPatternTweezerBottom:      DetectTweezerBottom,
+			candlestickPatternPiercingLine:       DetectPiercingLine,
+			candlestickPatternDarkCloudCover:     DetectDarkCloudCover,
+			candlestickPatternMorningStar:        DetectMorningStar,
+			candlestickPatternEveningStar:        DetectEveningStar,
+			candlestickPatternThreeWhiteSoldiers: DetectThreeWhiteSoldiers,
+			candlestickPatternThreeBlackCrows:    DetectThreeBlackCrows,
+			candlestickPatternAbandonedBabyBull:  DetectBullishAbandonedBaby,
+			candlestickPatternAbandonedBabyBear:  DetectBearishAbandonedBaby,
+			candlestickPatternTriStarBull:        DetectBullishTriStar,
+			candlestickPatternTriStarBear:        DetectBearishTriStar,
+			candlestickPatternKickerBull:         DetectBullishKicker,
+			candlestickPatternKickerBear:         DetectBearishKicker,
+		}
+		require.Len(t, predicates, len(patternDetectors))
+
+		patterns := ScanCandlestickPatterns(data, *cfg)
+		for patternType, predicate := range predicates {
+			for i := range data {
+				detected := slices.ContainsFunc(patterns[i], func(r PatternDetectionResult) bool {
+					return r.PatternType == patternType
+				})
+				assert.Equal(t, detected, predicate(data, i, *cfg), "%s at %d", patternType, i)
+			}
+		}
+	})
+	t.Run("index_out_of_range", func(t *testing.T) {
+		assert.False(t, DetectDoji(data, -1, *cfg))
+		assert.False(t, DetectDoji(data, len(data), *cfg))
+		assert.False(t, DetectMorningStar(nil, 0, *cfg))
+	})
+}
+
+func TestPatternDetectorsShortData(t *testing.T) {
+	t.Parallel()
+
+	candles := []OHLCData{
+		{Open: 120, High: 121, Low: 100, Close: 101},
+		{Open: 99, High: 100, Low: 97, Close: 98.5},
+		{Open: 100, High: 122, Low: 99, Close: 121},
+	}
+	for _, cfg := range []CandlestickPatternConfig{
+		*(&CandlestickPatternConfig{}).WithPatternsAll(),
+		{TrendLookback: 3},
+	} {
+		for patternType, detector := range patternDetectors {
+			for size := 1; size < detector.minCandles; size++ {
+				data := slices.Clone(candles[:size])
+				assert.NotPanics(t, func() {
+					assert.False(t, detector.detectFunc(data, size-1, cfg), "%s with %d candles", patternType, size)
+				}, "%s with %d candles", patternType, size)
+			}
+		}
+	}
+}
+
+func TestFormatPatternsDefaultDirectionColor(t *testing.T) {
+	t.Parallel()
+
+	theme := GetTheme(ThemeLight)
+	upColor, downColor := theme.GetSeriesUpDownColors(0)
+	for patternType, detector := range patternDetectors {
+		t.Run(patternType, func(t *testing.T) {
+			_, style := formatPatternsDefault([]PatternDetectionResult{
+				{PatternName: detector.patternName, PatternType: patternType},
+			}, 0, theme, CandlestickPatternConfig{})
+			require.NotNil(t, style)
+
+			// the label color follows the same direction used by DirectionFilter
+			switch detector.direction {
+			case patternDirectionBullish:
+				assert.Equal(t, upColor, style.BorderColor)
+			case patternDirectionBearish:
+				assert.Equal(t, downColor, style.BorderColor)
+			default:
+				assert.Equal(t, Color{R: 200, G: 200, B: 200, A: 255}, style.BorderColor)
+			}
+		})
+	}
+}
@@ -1,9 +1,5 @@
 package charts
 
-import (
-	"errors"
-)
-
 // ChartOption represents a generic method of representing a chart. This can be useful when you want to render
 // different chart types with the same data and configuration.
 type ChartOption struct {
@@ -28,6 +24,8 @@ type ChartOption struct {
 	Legend LegendOption
 	// Box specifies the drawing area for the chart.
 	Box Box
+	// PlotBorder configures an optional border drawn around the plotting area.
+	PlotBorder PlotBorderOption
 	// SeriesList provides the population data for the chart, constructed through NewSeriesListGeneric.
 	SeriesList GenericSeriesList
 	// StackSeries when set to *true causes series to be layered or stacked.
@@ -62,6 +60,33 @@ type ChartOption struct {
 	ValueFormatter ValueFormatter
 }
 
+// BorderSides is a bitmask selecting the sides of a box to draw.
+type BorderSides uint8
+
+const (
+	// BorderSideTop selects the top edge.
+	BorderSideTop BorderSides = 1 << iota
+	// BorderSideRight selects the right edge.
+	BorderSideRight
+	// BorderSideBottom selects the bottom edge.
+	BorderSideBottom
+	// BorderSideLeft selects the left edge.
+	BorderSideLeft
+	// BorderSidesAll selects all four edges, drawing a full box.
+	BorderSidesAll = BorderSideTop | BorderSideRight | BorderSideBottom | BorderSideLeft
+)
+
+// PlotBorderOption configures a border around the plotting area, for example left and bottom axis spines or a
+// full box. The border is drawn only when Width is greater than zero.
+type PlotBorderOption struct {
+	// Color is the border stroke color, defaulting to the theme y-axis stroke color.
+	Color Color
+	// Width is the border stroke width.
+	Width float64
+	// Sides selects the edges to draw, combine BorderSide* values. Zero defaults to BorderSidesAll.
+	Sides BorderSides
+}
+
 // OptionFunc is a function that modifies ChartOption.
 type OptionFunc func(opt *ChartOption)
 
@@ -232,7 +257,7 @@ func (o *ChartOption) fillDefault() error {
 
 	yaxisCount := getSeriesYAxisCount(o.SeriesList)
 	if yaxisCount < 0 {
-		return errors.New("series specified invalid y-axis index")
+		return ErrInvalidYAxisIndex
 	}
 	if len(o.YAxis) < yaxisCount {
 		yAxisOptions := make([]YAxisOption, yaxisCount)
@@ -2,6 +2,7 @@ package charts
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -143,6 +144,43 @@ func TestLineRender(t *testing.T) {
 	assertTestdataSVG(t, data)
 }
 
+func TestPlotBorderRender(t *testing.T) {
+	t.Parallel()
+
+	borderColor := Color{R: 10, G: 120, B: 200, A: 255}
+	values := [][]float64{
+		{120, 132, 101, 134, 90, 230, 210},
+	}
+	tests := []struct {
+		name          string
+		sides         BorderSides
+		expectedPaths int
+	}{
+		{name: "full_box", sides: BorderSidesAll, expectedPaths: 4},
+		{name: "default_sides", expectedPaths: 4},
+		{name: "axis_spines", sides: BorderSideLeft | BorderSideBottom, expectedPaths: 2},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p, err := LineRender(values, SVGOutputOptionFunc(), func(opt *ChartOption) {
+				opt.PlotBorder = PlotBorderOption{
+					Color: borderColor,
+					Width: 2,
+					Sides: tt.sides,
+				}
+			})
+			require.NoError(t, err)
+			data, err := p.Bytes()
+			require.NoError(t, err)
+			assertTestdataSVG(t, data)
+
+			borderPaths := regexp.MustCompile(`<path [^>]*stroke:` + regexp.QuoteMeta(borderColor.String()) + `;`)
+			assert.Len(t, borderPaths.FindAllString(string(data), -1), tt.expectedPaths)
+		})
+	}
+}
+
 func TestScatterRender(t *testing.T) {
 	t.Parallel()
 
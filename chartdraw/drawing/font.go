@@ -71,6 +71,22 @@ func GetFont(fontFamily string) *truetype.Font {
 	return nil
 }
 
+// GetFontFamily returns the family name the font was installed under, or an empty string if the font is not
+// installed.
+func GetFontFamily(font *truetype.Font) string {
+	var family string
+	if font != nil {
+		fonts.Range(func(key, value any) bool {
+			if value.(*truetype.Font) == font {
+				family = key.(string)
+				return false
+			}
+			return true
+		})
+	}
+	return family
+}
+
 func gzipDecompress(data []byte) ([]byte, error) {
 	r, err := gzip.NewReader(bytes.NewReader(data))
 	if err != nil {
@@ -93,6 +93,17 @@ func TestGetFont(t *testing.T) {
 	})
 }
 
+func TestGetFontFamily(t *testing.T) {
+	t.Parallel()
+
+	fontName := "test-family-lookup"
+	require.NoError(t, InstallFont(fontName, getTestFontData(t)))
+
+	assert.Equal(t, fontName, GetFontFamily(GetFont(fontName)))
+	assert.Empty(t, GetFontFamily(nil))
+	assert.Empty(t, GetFontFamily(&truetype.Font{}))
+}
+
 func TestLoadEmbeddedFont(t *testing.T) {
 	t.Parallel()
 
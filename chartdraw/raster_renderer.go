@@ -3,12 +3,14 @@ package chartdraw
 import (
 	"fmt"
 	"image"
+	"image/color"
 	"image/jpeg"
 	"image/png"
 	"io"
 	"math"
 
 	"github.com/golang/freetype/truetype"
+	xdraw "golang.org/x/image/draw"
 
 	"github.com/go-analyze/charts/chartdraw/drawing"
 )
@@ -23,14 +25,30 @@ func PNG(width, height int) Renderer {
 	}
 }
 
+// DefaultJPGQuality is the encoding quality used by JPG renderers.
+const DefaultJPGQuality = 90
+
 // JPG returns a new jpg raster renderer.
 func JPG(width, height int) Renderer {
+	return newJPG(width, height, DefaultJPGQuality)
+}
+
+// JPGWithQuality returns a provider of jpg raster renderers encoding at the given quality. The quality is clamped to
+// the range 1 to 100.
+func JPGWithQuality(quality int) RendererProvider {
+	quality = min(max(quality, 1), 100)
+	return func(width, height int) Renderer {
+		return newJPG(width, height, quality)
+	}
+}
+
+func newJPG(width, height, quality int) Renderer {
 	i := image.NewRGBA(image.Rect(0, 0, width, height))
 	return &rasterRenderer{
 		i:  i,
 		gc: drawing.NewRasterGraphicContext(i),
 		encodeFunc: func(w io.Writer, i image.Image) error {
-			return jpeg.Encode(w, i, &jpeg.Options{Quality: 90})
+			return jpeg.Encode(w, i, &jpeg.Options{Quality: quality})
 		},
 	}
 }
@@ -47,6 +65,17 @@ type rasterRenderer struct {
 	s Style
 }
 
+// Reset clears the image to transparent so the renderer can be drawn again (for ResettableRenderer interface).
+func (rr *rasterRenderer) Reset() {
+	clear(rr.i.Pix)
+	dpi := rr.gc.GetDPI()
+	rr.gc = drawing.NewRasterGraphicContext(rr.i)
+	rr.gc.SetDPI(dpi)
+	rr.renderErrs = nil
+	rr.rotateRadians = nil
+	rr.s = Style{}
+}
+
 func (rr *rasterRenderer) ResetStyle() {
 	rr.s = Style{
 		FontStyle: FontStyle{
@@ -144,6 +173,22 @@ func (rr *rasterRenderer) Circle(radius float64, x, y int) {
 	rr.gc.ArcTo(xf, yf, radius, radius, 0, _2pi)
 }
 
+// DrawImage composites the image scaled into the box at the given opacity (for ImageRenderer interface).
+func (rr *rasterRenderer) DrawImage(img image.Image, x, y, width, height int, opacity float64) {
+	if img == nil || width <= 0 || height <= 0 || opacity <= 0 {
+		return
+	}
+	src := img
+	srcBounds := img.Bounds()
+	if srcBounds.Dx() != width || srcBounds.Dy() != height {
+		scaled := image.NewRGBA(image.Rect(0, 0, width, height))
+		xdraw.CatmullRom.Scale(scaled, scaled.Bounds(), img, srcBounds, xdraw.Src, nil)
+		src, srcBounds = scaled, scaled.Bounds()
+	}
+	mask := image.NewUniform(color.Alpha16{A: uint16(math.Round(min(opacity, 1) * 0xffff))})
+	xdraw.DrawMask(rr.i, image.Rect(x, y, x+width, y+height), src, srcBounds.Min, mask, image.Point{}, xdraw.Over)
+}
+
 // SetFont sets the font used for text drawing (for Renderer interface).
 func (rr *rasterRenderer) SetFont(f *truetype.Font) {
 	rr.s.Font = f
@@ -173,3 +173,22 @@ func TestRasterRendererSaveWithQueuedError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "boom")
 }
+
+func TestJPGWithQuality(t *testing.T) {
+	t.Parallel()
+
+	save := func(t *testing.T, r Renderer) []byte {
+		t.Helper()
+
+		r.SetFillColor(drawing.ColorNavy)
+		r.Circle(20, 25, 25)
+		r.Fill()
+		var buf bytes.Buffer
+		require.NoError(t, r.Save(&buf))
+		return buf.Bytes()
+	}
+
+	assert.Equal(t, save(t, JPG(50, 50)), save(t, JPGWithQuality(DefaultJPGQuality)(50, 50)))
+	assert.Equal(t, save(t, JPGWithQuality(1)(50, 50)), save(t, JPGWithQuality(-5)(50, 50)))
+	assert.Equal(t, save(t, JPGWithQuality(100)(50, 50)), save(t, JPGWithQuality(101)(50, 50)))
+}
@@ -1,6 +1,7 @@
 package chartdraw
 
 import (
+	"image"
 	"io"
 
 	"github.com/golang/freetype/truetype"
@@ -8,6 +9,34 @@ import (
 	"github.com/go-analyze/charts/chartdraw/drawing"
 )
 
+// ResettableRenderer is a Renderer which can clear its output to draw again, reusing the allocated buffers.
+type ResettableRenderer interface {
+	Renderer
+	// Reset clears all drawn content and style, retaining the dimensions and DPI.
+	Reset()
+}
+
+// GradientStop is a color positioned along a gradient, with Offset ranging from 0.0 (start) to 1.0 (end).
+type GradientStop struct {
+	Offset float64
+	Color  drawing.Color
+}
+
+// GradientRenderer is a Renderer which can natively fill paths with a linear gradient.
+type GradientRenderer interface {
+	Renderer
+	// FillLinearGradient fills the current path with a gradient running from (x1, y1) to (x2, y2).
+	FillLinearGradient(x1, y1, x2, y2 int, stops []GradientStop)
+}
+
+// ImageRenderer is a Renderer which can draw an image into the output.
+type ImageRenderer interface {
+	Renderer
+	// DrawImage draws the image scaled into the box with the top left corner at (x, y), blended at the opacity
+	// (0.0 to 1.0).
+	DrawImage(img image.Image, x, y, width, height int, opacity float64)
+}
+
 // Renderer represents the basic methods required to draw a chart.
 type Renderer interface {
 	// ResetStyle should reset any style related settings on the renderer.
@@ -88,3 +117,30 @@ type Renderer interface {
 	// Save writes the image to the given writer.
 	Save(w io.Writer) error
 }
+
+// TitledTextRenderer is optionally implemented by renderers which can attach a title to text, for example the SVG
+// renderer which nests a title element displayed on hover.
+type TitledTextRenderer interface {
+	// TextWithTitle draws a text blob with the associated title.
+	TextWithTitle(body, title string, x, y int)
+}
+
+// ElementMetadataRenderer is implemented by renderers which can annotate the drawn elements, such as the SVG renderer.
+// Raster renderers have no elements to annotate and do not implement it.
+type ElementMetadataRenderer interface {
+	// SetElementAttributes sets attributes written on each following drawn element. A nil map clears the attributes.
+	// Only data-* attributes are written, names with other prefixes or with characters other than letters, digits,
+	// '-', '_', ':', and '.' are skipped.
+	SetElementAttributes(attrs map[string]string)
+
+	// SetElementLink wraps each following drawn element in a link to href. An empty href clears the link, javascript:
+	// links are ignored.
+	SetElementLink(href string)
+
+	// StartElementGroup opens a group containing the following drawn elements, with the attributes written on the
+	// group and filtered like SetElementAttributes. Groups can be nested and must be closed with EndElementGroup.
+	StartElementGroup(attrs map[string]string)
+
+	// EndElementGroup closes the most recently started group.
+	EndElementGroup()
+}
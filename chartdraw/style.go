@@ -1,6 +1,7 @@
 package chartdraw
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -65,6 +66,35 @@ type FontStyle struct {
 	Font      *truetype.Font
 }
 
+// fontStyleJSON is the serialized form of FontStyle, referencing the font by its installed family name.
+type fontStyleJSON struct {
+	FontSize  float64       `json:",omitempty"`
+	FontColor drawing.Color `json:",omitzero"`
+	Font      string        `json:",omitempty"`
+}
+
+// MarshalJSON encodes the font style, with the font recorded by its installed family name.
+func (s FontStyle) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fontStyleJSON{
+		FontSize:  s.FontSize,
+		FontColor: s.FontColor,
+		Font:      drawing.GetFontFamily(s.Font),
+	})
+}
+
+// UnmarshalJSON decodes the font style, resolving the font family name against the installed fonts.
+func (s *FontStyle) UnmarshalJSON(data []byte) error {
+	var v fontStyleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = FontStyle{FontSize: v.FontSize, FontColor: v.FontColor}
+	if v.Font != "" {
+		s.Font = drawing.GetFont(v.Font)
+	}
+	return nil
+}
+
 // IsZero returns if the font style is set or not.
 func (s FontStyle) IsZero() bool {
 	return s.FontSize <= matrix.DefaultEpsilon && s.Font == nil && s.FontColor.IsZero()
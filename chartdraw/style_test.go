@@ -1,6 +1,7 @@
 package chartdraw
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/golang/freetype/truetype"
@@ -108,6 +109,23 @@ func TestStyleGetFont(t *testing.T) {
 	require.NotNil(t, set.GetFont())
 }
 
+func TestFontStyleJSON(t *testing.T) {
+	t.Parallel()
+
+	fs := FontStyle{FontSize: 12, FontColor: drawing.ColorRed, Font: GetFont("roboto")}
+	data, err := json.Marshal(fs)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"FontSize":12,"FontColor":{"R":255,"G":0,"B":0,"A":255},"Font":"roboto"}`, string(data))
+
+	var decoded FontStyle
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, fs, decoded)
+
+	data, err = json.Marshal(FontStyle{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data))
+}
+
 func TestStyleGetPadding(t *testing.T) {
 	t.Parallel()
 
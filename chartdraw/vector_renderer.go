@@ -2,9 +2,14 @@ package chartdraw
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/xml"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -201,6 +206,29 @@ func (vr *vectorRenderer) SetClassName(classname string) {
 	vr.s.ClassName = classname
 }
 
+// SetElementAttributes sets attributes for the next drawn elements (for ElementMetadataRenderer interface).
+func (vr *vectorRenderer) SetElementAttributes(attrs map[string]string) {
+	vr.c.attrs = attrs
+}
+
+// SetElementLink wraps the next drawn elements in a link (for ElementMetadataRenderer interface).
+func (vr *vectorRenderer) SetElementLink(href string) {
+	if isScriptLink(href) {
+		href = ""
+	}
+	vr.c.link = href
+}
+
+// StartElementGroup opens a group for the next drawn elements (for ElementMetadataRenderer interface).
+func (vr *vectorRenderer) StartElementGroup(attrs map[string]string) {
+	vr.c.StartGroup(attrs)
+}
+
+// EndElementGroup closes the most recently started group (for ElementMetadataRenderer interface).
+func (vr *vectorRenderer) EndElementGroup() {
+	vr.c.EndGroup()
+}
+
 // SetStrokeColor changes the stroke color for subsequent paths (for Renderer interface).
 func (vr *vectorRenderer) SetStrokeColor(c drawing.Color) {
 	vr.s.StrokeColor = c
@@ -283,6 +311,17 @@ func (vr *vectorRenderer) FillStroke() {
 	vr.drawPath()
 }
 
+// FillLinearGradient fills the path with a linear gradient and no stroke (for GradientRenderer interface).
+func (vr *vectorRenderer) FillLinearGradient(x1, y1, x2, y2 int, stops []GradientStop) {
+	vr.c.GradientPath(vr.p, x1, y1, x2, y2, stops)
+	vr.p = vr.p[:0] // clear the path
+}
+
+// DrawImage embeds the image as a base64 encoded PNG scaled into the box (for ImageRenderer interface).
+func (vr *vectorRenderer) DrawImage(img image.Image, x, y, width, height int, opacity float64) {
+	vr.c.Image(img, x, y, width, height, opacity)
+}
+
 // drawPath draws the path set into the p slice.
 func (vr *vectorRenderer) drawPath() {
 	vr.c.Path(vr.p, vr.s.GetFillAndStrokeOptions())
@@ -314,6 +353,11 @@ func (vr *vectorRenderer) Text(body string, x, y int) {
 	vr.c.Text(x, y, body, vr.s.GetTextOptions())
 }
 
+// TextWithTitle draws a text blob with a nested title element, which SVG viewers display on hover.
+func (vr *vectorRenderer) TextWithTitle(body, title string, x, y int) {
+	vr.c.TextWithTitle(x, y, body, title, vr.s.GetTextOptions())
+}
+
 // MeasureText uses the truetype font drawer to measure the width of text.
 func (vr *vectorRenderer) MeasureText(body string) (box Box) {
 	textFont := vr.s.GetFont()
@@ -357,6 +401,18 @@ func (vr *vectorRenderer) Save(w io.Writer) error {
 	return err
 }
 
+// Reset clears the drawn SVG content so the renderer can be drawn again (for ResettableRenderer interface).
+func (vr *vectorRenderer) Reset() {
+	for _, face := range vr.faceCache {
+		_ = face.Close()
+	}
+	vr.faceCache = nil
+	vr.b.Reset()
+	vr.s = &Style{}
+	vr.p = vr.p[:0]
+	vr.c.reset()
+}
+
 func newCanvas(w io.Writer) *canvas {
 	return &canvas{
 		w:   w,
@@ -374,6 +430,10 @@ type canvas struct {
 	height    int
 	css       string
 	nonce     string
+	attrs     map[string]string // attributes written on each element
+	link      string            // href each element is wrapped with
+	groups    int               // count of open groups
+	gradients int               // count of gradient definitions, used for unique ids
 }
 
 func (c *canvas) Start(width, height int) {
@@ -398,7 +458,7 @@ func (c *canvas) Path(parts []string, style Style) {
 	bb := c.bb
 	defer c.bb.Reset()
 
-	bb.WriteString(`<path`)
+	c.writeElementStart(bb, "path")
 	if len(style.StrokeDashArray) > 0 {
 		bb.WriteString(" stroke-dasharray=\"")
 		for i, v := range style.StrokeDashArray {
@@ -419,18 +479,24 @@ func (c *canvas) Path(parts []string, style Style) {
 	bb.WriteString(`" `)
 	styleAsSVG(bb, style, c.dpi, false)
 	bb.WriteString(`/>`)
+	c.writeElementEnd(bb)
 
 	_, _ = c.w.Write(bb.Bytes())
 }
 
 func (c *canvas) Text(x, y int, body string, style Style) {
+	c.TextWithTitle(x, y, body, "", style)
+}
+
+func (c *canvas) TextWithTitle(x, y int, body, title string, style Style) {
 	if body == "" {
 		return
 	}
 	bb := c.bb
 	defer c.bb.Reset()
 
-	bb.WriteString(`<text x="`)
+	c.writeElementStart(bb, "text")
+	bb.WriteString(` x="`)
 	bb.WriteString(strconv.Itoa(x))
 	bb.WriteString(`" y="`)
 	bb.WriteString(strconv.Itoa(y))
@@ -441,7 +507,13 @@ func (c *canvas) Text(x, y int, body string, style Style) {
 	}
 	bb.WriteRune('>')
 	bb.WriteString(body)
+	if title != "" {
+		bb.WriteString("<title>")
+		_ = xml.EscapeText(bb, []byte(title))
+		bb.WriteString("</title>")
+	}
 	bb.WriteString("</text>")
+	c.writeElementEnd(bb)
 
 	_, _ = c.w.Write(bb.Bytes())
 }
@@ -450,7 +522,8 @@ func (c *canvas) Circle(x, y, r int, style Style) {
 	bb := c.bb
 	defer c.bb.Reset()
 
-	bb.WriteString(`<circle cx="`)
+	c.writeElementStart(bb, "circle")
+	bb.WriteString(` cx="`)
 	bb.WriteString(strconv.Itoa(x))
 	bb.WriteString(`" cy="`)
 	bb.WriteString(strconv.Itoa(y))
@@ -459,11 +532,180 @@ func (c *canvas) Circle(x, y, r int, style Style) {
 	bb.WriteString(`" `)
 	styleAsSVG(bb, style, c.dpi, true)
 	bb.WriteString(`/>`)
+	c.writeElementEnd(bb)
+
+	_, _ = c.w.Write(bb.Bytes())
+}
+
+// Image writes an image element with the image embedded as a PNG data URI.
+func (c *canvas) Image(img image.Image, x, y, width, height int, opacity float64) {
+	if img == nil || width <= 0 || height <= 0 || opacity <= 0 {
+		return
+	}
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, img); err != nil {
+		return
+	}
+	bb := c.bb
+	defer c.bb.Reset()
+
+	c.writeElementStart(bb, "image")
+	_, _ = fmt.Fprintf(bb, ` x="%d" y="%d" width="%d" height="%d" preserveAspectRatio="none"`, x, y, width, height)
+	if opacity < 1 {
+		bb.WriteString(` opacity="`)
+		bb.WriteString(strconv.FormatFloat(math.Round(opacity*1000)/1000, 'f', -1, 64))
+		bb.WriteRune('"')
+	}
+	bb.WriteString(` href="data:image/png;base64,`)
+	bb.WriteString(base64.StdEncoding.EncodeToString(encoded.Bytes()))
+	bb.WriteString(`"/>`)
+	c.writeElementEnd(bb)
+
+	_, _ = c.w.Write(bb.Bytes())
+}
+
+// writeElementStart opens an element with the current link wrapper and attributes, leaving the tag open.
+func (c *canvas) writeElementStart(bb *bytes.Buffer, name string) {
+	if c.link != "" {
+		bb.WriteString(`<a href="`)
+		_ = xml.EscapeText(bb, []byte(c.link))
+		bb.WriteString(`">`)
+	}
+	bb.WriteRune('<')
+	bb.WriteString(name)
+	writeAttributes(bb, c.attrs)
+}
+
+// writeAttributes writes the valid attributes sorted by name.
+func writeAttributes(bb *bytes.Buffer, attrs map[string]string) {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		if validAttributeName(k) {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys) // stable output order
+	for _, k := range keys {
+		bb.WriteRune(' ')
+		bb.WriteString(k)
+		bb.WriteString(`="`)
+		_ = xml.EscapeText(bb, []byte(attrs[k]))
+		bb.WriteRune('"')
+	}
+}
+
+// writeElementEnd closes the link wrapper opened by writeElementStart.
+func (c *canvas) writeElementEnd(bb *bytes.Buffer) {
+	if c.link != "" {
+		bb.WriteString("</a>")
+	}
+}
+
+// validAttributeName returns true if the name is a data-* attribute safe to write as an XML attribute name. Other
+// names are rejected so metadata can't replace the drawn attributes (like style) or add event handlers.
+func validAttributeName(name string) bool {
+	suffix, ok := strings.CutPrefix(name, "data-")
+	if !ok || suffix == "" {
+		return false
+	}
+	for _, r := range suffix {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == ':', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isScriptLink returns true if the href uses the javascript: scheme. Whitespace and control characters are ignored
+// when reading the scheme, matching how browsers parse URLs.
+func isScriptLink(href string) bool {
+	href = strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, href)
+	scheme, _, found := strings.Cut(href, ":")
+	return found && strings.EqualFold(scheme, "javascript")
+}
+
+// GradientPath writes a linearGradient definition followed by a path filled with it.
+func (c *canvas) GradientPath(parts []string, x1, y1, x2, y2 int, stops []GradientStop) {
+	if len(parts) == 0 {
+		return
+	}
+	bb := c.bb
+	defer c.bb.Reset()
+
+	c.gradients++
+	id := "gradient-" + strconv.Itoa(c.gradients)
+	_, _ = fmt.Fprintf(bb, `<defs><linearGradient id="%s" gradientUnits="userSpaceOnUse" x1="%d" y1="%d" x2="%d" y2="%d">`,
+		id, x1, y1, x2, y2)
+	for _, stop := range stops {
+		bb.WriteString(`<stop offset="`)
+		bb.WriteString(strconv.FormatFloat(math.Round(stop.Offset*1000)/1000, 'f', -1, 64))
+		bb.WriteString(`" stop-color="`)
+		bb.WriteString(stop.Color.StringRGB())
+		bb.WriteString(`" stop-opacity="`)
+		bb.WriteString(strconv.FormatFloat(math.Round(float64(stop.Color.A)/255*1000)/1000, 'f', -1, 64))
+		bb.WriteString(`"/>`)
+	}
+	bb.WriteString(`</linearGradient></defs>`)
+
+	c.writeElementStart(bb, "path")
+	bb.WriteString(` d="`)
+	for i, p := range parts {
+		if i > 0 {
+			bb.WriteRune('\n')
+		}
+		bb.WriteString(p)
+	}
+	bb.WriteString(`" style="stroke:none;fill:url(#`)
+	bb.WriteString(id)
+	bb.WriteString(`)"/>`)
+	c.writeElementEnd(bb)
+
+	_, _ = c.w.Write(bb.Bytes())
+}
+
+// reset clears the element state and writes a new svg start tag with the existing dimensions.
+func (c *canvas) reset() {
+	c.bb.Reset()
+	c.textTheta = nil
+	c.attrs = nil
+	c.link = ""
+	c.groups = 0
+	c.gradients = 0
+	c.Start(c.width, c.height)
+}
+
+// StartGroup opens a group element with the provided attributes.
+func (c *canvas) StartGroup(attrs map[string]string) {
+	bb := c.bb
+	defer c.bb.Reset()
+
+	bb.WriteString("<g")
+	writeAttributes(bb, attrs)
+	bb.WriteRune('>')
+	c.groups++
 
 	_, _ = c.w.Write(bb.Bytes())
 }
 
+// EndGroup closes the most recently opened group element.
+func (c *canvas) EndGroup() {
+	if c.groups > 0 {
+		c.groups--
+		_, _ = c.w.Write([]byte("</g>"))
+	}
+}
+
 func (c *canvas) End() {
+	for c.groups > 0 { // close any groups left open
+		c.EndGroup()
+	}
 	_, _ = c.w.Write([]byte("</svg>"))
 }
 
@@ -167,6 +167,95 @@ func TestCanvasBasicElements(t *testing.T) {
 	assert.True(t, strings.HasSuffix(out, "</svg>"))
 }
 
+func TestCanvasElementMetadata(t *testing.T) {
+	t.Parallel()
+
+	b := strings.Builder{}
+	c := &canvas{w: &b, bb: bytes.NewBuffer(make([]byte, 0, 80))}
+	c.attrs = map[string]string{"data-b": `"quoted"`, "data-a": "1", "data-bad name": "x",
+		"style": "fill:none", "cx": "0", "onclick": "alert(1)", "data-": "x"}
+	c.link = "https://example.com/?a=1&b=2"
+	c.Circle(5, 5, 3, Style{FillColor: drawing.ColorRed})
+
+	out := b.String()
+	assert.True(t, strings.HasPrefix(out, `<a href="https://example.com/?a=1&amp;b=2"><circle data-a="1" data-b="&#34;quoted&#34;" cx="5"`))
+	assert.True(t, strings.HasSuffix(out, "/></a>"))
+	assert.NotContains(t, out, "bad name")
+	assert.NotContains(t, out, "onclick")
+	assert.NotContains(t, out, "fill:none")
+	assert.NotContains(t, out, `cx="0"`)
+	assert.NotContains(t, out, `data-="x"`)
+}
+
+func TestVectorRendererElementLink(t *testing.T) {
+	t.Parallel()
+
+	for _, href := range []string{"javascript:alert(1)", "JavaScript:alert(1)", " java\tscript:alert(1)"} {
+		vr := SVG(100, 100).(*vectorRenderer)
+		vr.SetElementLink(href)
+		vr.Circle(5, 5, 3)
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, vr.Save(buf))
+		assert.NotContains(t, buf.String(), "<a ", href)
+	}
+
+	vr := SVG(100, 100).(*vectorRenderer)
+	vr.SetElementLink("https://example.com/javascript:x")
+	vr.Circle(5, 5, 3)
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, vr.Save(buf))
+	assert.Contains(t, buf.String(), `<a href="https://example.com/javascript:x">`)
+}
+
+func TestCanvasElementGroup(t *testing.T) {
+	t.Parallel()
+
+	b := strings.Builder{}
+	c := &canvas{w: &b, bb: bytes.NewBuffer(make([]byte, 0, 80))}
+	c.StartGroup(map[string]string{"data-series": "a&b"})
+	c.Circle(5, 5, 3, Style{FillColor: drawing.ColorRed})
+	c.StartGroup(nil)
+	c.EndGroup()
+	c.EndGroup()
+	c.EndGroup() // extra close is ignored
+	c.StartGroup(nil)
+	c.End()
+
+	out := b.String()
+	assert.True(t, strings.HasPrefix(out, `<g data-series="a&amp;b"><circle `))
+	assert.True(t, strings.HasSuffix(out, "/><g></g></g><g></g></svg>"))
+}
+
+func TestVectorRendererFillLinearGradient(t *testing.T) {
+	t.Parallel()
+
+	vr := SVG(100, 100)
+	gr, ok := vr.(GradientRenderer)
+	require.True(t, ok)
+
+	for i := 0; i < 2; i++ {
+		gr.MoveTo(0, 10)
+		gr.LineTo(100, 10)
+		gr.LineTo(100, 90)
+		gr.FillLinearGradient(0, 10, 0, 90, []GradientStop{
+			{Offset: 0, Color: drawing.ColorBlue},
+			{Offset: 1, Color: drawing.ColorBlue.WithAlpha(0)},
+		})
+	}
+	gr.FillLinearGradient(0, 0, 0, 1, nil) // empty path is ignored
+
+	buffer := bytes.NewBuffer([]byte{})
+	require.NoError(t, vr.Save(buffer))
+	raw := buffer.String()
+
+	assert.Contains(t, raw, `<defs><linearGradient id="gradient-1" gradientUnits="userSpaceOnUse" x1="0" y1="10" x2="0" y2="90">`+
+		`<stop offset="0" stop-color="rgb(0,0,255)" stop-opacity="1"/>`+
+		`<stop offset="1" stop-color="rgb(0,0,255)" stop-opacity="0"/></linearGradient></defs>`+
+		`<path d="M 0 10`+"\nL 100 10\nL 100 90"+`" style="stroke:none;fill:url(#gradient-1)"/>`)
+	assert.Contains(t, raw, `style="stroke:none;fill:url(#gradient-2)"/>`)
+	assert.NotContains(t, raw, "gradient-3")
+}
+
 func TestFormatFloatMinimized(t *testing.T) {
 	t.Parallel()
 
@@ -194,3 +283,16 @@ func TestVectorRendererTextRotation(t *testing.T) {
 	assert.Contains(t, out, "rotate(90.00")
 	assert.Contains(t, out, "B</text>")
 }
+
+func TestVectorRendererTextWithTitle(t *testing.T) {
+	t.Parallel()
+
+	var r Renderer = SVG(20, 20)
+	titled, ok := r.(TitledTextRenderer)
+	require.True(t, ok)
+	titled.TextWithTitle("Lon…", "Long <name>", 5, 10)
+
+	buf := bytes.Buffer{}
+	require.NoError(t, r.Save(&buf))
+	assert.Contains(t, buf.String(), ">Lon…<title>Long &lt;name&gt;</title></text>")
+}
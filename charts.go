@@ -5,15 +5,24 @@ import (
 	"math"
 	"slices"
 	"strconv"
+	"sync/atomic"
 )
 
 const defaultLabelFontSize = 10.0
 const smallLabelFontSize = 8
 const defaultDotWidth = 2.0
 const defaultStrokeWidth = 2.0
+const footerPadding = 10
 const defaultYAxisLabelCountHigh = 10
 const defaultYAxisLabelCountLow = 3
 
+var (
+	// ErrInvalidYAxisIndex is returned when a series references a y-axis that the chart does not provide.
+	ErrInvalidYAxisIndex = errors.New("series specified invalid y-axis index")
+	// ErrMissingTimeValues is returned when a time axis has fewer time values than data points.
+	ErrMissingTimeValues = errors.New("time axis requires a time value for each data point")
+)
+
 var defaultChartWidth = 600
 var defaultChartHeight = 400
 var defaultPadding = NewBoxEqual(20)
@@ -28,9 +37,23 @@ func SetDefaultChartDimensions(width, height int) {
 	}
 }
 
+var nullValueBits atomic.Uint64
+
+func init() {
+	nullValueBits.Store(math.Float64bits(math.MaxFloat64))
+}
+
 // GetNullValue returns the null value for setting series points with "no" or "unknown" value.
+// Defaults to math.MaxFloat64 unless changed with SetNullValue.
 func GetNullValue() float64 {
-	return math.MaxFloat64
+	return math.Float64frombits(nullValueBits.Load())
+}
+
+// SetNullValue sets the sentinel value which is treated as a null ("no" or "unknown") series point. The setting
+// is global, so it should be set once during initialization before any charts are rendered. Values previously
+// used as the sentinel, including the default math.MaxFloat64, are then treated as regular data points.
+func SetNullValue(value float64) {
+	nullValueBits.Store(math.Float64bits(value))
 }
 
 type renderer interface {
@@ -75,18 +98,27 @@ type defaultRenderOption struct {
 	categoryY bool
 	// title contains options for rendering the chart title.
 	title TitleOption
+	// footer contains options for the note rendered beneath the chart.
+	footer FooterOption
 	// legend contains options for the data legend.
 	legend *LegendOption
 	// backgroundIsFilled is true if the background is filled.
 	backgroundIsFilled bool
 	// valueFormatter formats numeric values into labels.
 	valueFormatter ValueFormatter
+	// plotBorder configures the border drawn around the plotting area.
+	plotBorder PlotBorderOption
+	// seriesBottomReserve is the fraction (0.0-1.0) of the plot height reserved below the value axis range for a
+	// secondary pane sharing the category axis. Only supported when categoryY is false.
+	seriesBottomReserve float64
 }
 
 type defaultRenderResult struct {
 	valueAxisRanges   map[int]axisRange
 	categoryAxisRange axisRange
 	seriesPainter     *Painter
+	// bottomReserveHeight is the pixel height at the bottom of the seriesPainter excluded from the value axis range.
+	bottomReserveHeight int
 }
 
 func (r *defaultRenderResult) renderNoData(theme ColorPalette) {
@@ -130,13 +162,17 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 
 	theme := getPreferredTheme(opt.theme, p.theme)
 	fillThemeDefaults(theme, &opt.title, opt.legend, opt.categoryAxis, opt.valueAxis)
-	opt.categoryAxis = opt.categoryAxis.prep(theme, opt.categoryY)
+	opt.categoryAxis = opt.categoryAxis.prep(theme, p.font, opt.categoryY)
 	if !opt.backgroundIsFilled {
 		p.drawBackground(opt.theme.GetBackgroundColor())
 	}
 	if !opt.padding.IsZero() {
 		p = p.Child(PainterPaddingOption(opt.padding))
 	}
+	if footerBox := renderFooter(p, opt.footer, theme); !footerBox.IsZero() {
+		// reserve the footer space so the title, legend, and plot are laid out above it
+		p = p.Child(PainterPaddingOption(Box{Bottom: footerBox.Height() + footerPadding, IsSet: true}))
+	}
 
 	// association between legend and series name
 	if len(opt.legend.SeriesNames) == 0 {
@@ -166,8 +202,8 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 	opt.legend.seriesSymbols = make([]SymbolShape, opt.seriesList.len())
 	for index := range opt.legend.seriesSymbols {
 		symbol := opt.seriesList.getSeriesSymbol(index)
-		if symbol == symbolCandlestick && opt.legend.Symbol == SymbolNone {
-			symbol = SymbolNone // icons disabled, don't force the candlestick default
+		if (symbol == symbolCandlestick || symbol == symbolBubble) && opt.legend.Symbol == SymbolNone {
+			symbol = SymbolNone // icons disabled, don't force the automatic default
 		}
 		opt.legend.seriesSymbols[index] = symbol
 	}
@@ -247,9 +283,17 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 
 	// reserve space for the legend if not in overlay mode
 	// - horizontal legends reserve space (top or bottom)
-	// - vertical legends always overlay from the side
+	// - vertical legends overlay from the side unless overlay is explicitly disabled
 	// - skip for adjustedForBottom since title reservation handles combined space
-	if !legendResult.IsZero() && !flagIs(true, legendOpt.OverlayChart) && !adjustedForBottom &&
+	if !legendResult.IsZero() && flagIs(true, legendOpt.Vertical) && flagIs(false, legendOpt.OverlayChart) {
+		sidePadBox := Box{IsSet: true}
+		if legendResult.Left+legendResult.Width()/2 > p.Width()/2 {
+			sidePadBox.Right = p.Width() - legendResult.Left + legendTitlePadding
+		} else {
+			sidePadBox.Left = legendResult.Right + legendTitlePadding
+		}
+		p = p.Child(PainterPaddingOption(sidePadBox))
+	} else if !legendResult.IsZero() && !flagIs(true, legendOpt.OverlayChart) && !adjustedForBottom &&
 		!flagIs(true, legendOpt.Vertical) {
 		if legendResult.Bottom < p.Height()/2 {
 			// horizontal legend at top - reserve top space
@@ -294,9 +338,11 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 	var xValueAxis ValueAxisOption // prepped X-slot value axis; only populated when categoryY
 	if opt.categoryY {             // X is value axis
 		xValueAxis = opt.valueAxis[0]
-		xValueAxis.prep(getPreferredTheme(xValueAxis.Theme, theme), false)
+		xValueAxis.prep(getPreferredTheme(xValueAxis.Theme, theme), p.font, false)
+		xMin, xMax := roundedRangeBounds(xValueAxis.RangeRounding, xValueAxis.RangePaddingPercent,
+			xValueAxis.Min, xValueAxis.Max, opt.seriesList, 0, opt.stackSeries)
 		xAxisRange := calculateValueAxisRange(p, false, p.Width(),
-			xValueAxis.Min, xValueAxis.Max, xValueAxis.RangeValuePaddingScale,
+			xMin, xMax, xValueAxis.RangeValuePaddingScale,
 			xValueAxis.Labels,
 			xValueAxis.LabelCount, xValueAxis.Unit, xValueAxis.LabelCountAdjustment,
 			opt.seriesList, 0, opt.stackSeries,
@@ -304,6 +350,14 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 			xValueAxis.LabelRotation, xValueAxis.LabelFontStyle,
 			xValueAxis.PreferNiceIntervals)
 		xAxisOpts = xValueAxis.toAxisOption(xAxisRange)
+	} else if len(opt.categoryAxis.TimeValues) > 0 { // X is time axis
+		if err := validateTimeValues(opt.categoryAxis.TimeValues, getSeriesMaxDataCount(opt.seriesList)); err != nil {
+			return nil, err
+		}
+		xAxisRange := calculateTimeAxisRange(p, p.Width(), opt.categoryAxis.TimeValues, opt.categoryAxis.TimeLayout,
+			!flagIs(false, opt.categoryAxis.BoundaryGap), opt.categoryAxis.CompressGaps, opt.categoryAxis.AlwaysShowEnds,
+			opt.categoryAxis.LabelRotation, opt.categoryAxis.LabelFontStyle)
+		xAxisOpts = opt.categoryAxis.toAxisOption(xAxisRange)
 	} else { // X is category axis (typical)
 		xAxisRange := calculateCategoryAxisRange(p, p.Width(), false, flagIs(false, opt.categoryAxis.BoundaryGap),
 			opt.categoryAxis.Labels,
@@ -336,7 +390,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 	// prepare y-axis options, range data, and render
 	yAxisCount := getSeriesYAxisCount(opt.seriesList)
 	if yAxisCount < 0 {
-		return nil, errors.New("series specified invalid y-axis index")
+		return nil, ErrInvalidYAxisIndex
 	}
 
 	if opt.categoryY {
@@ -367,6 +421,10 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		}
 	} else {
 		// Y-slot renders value axis(es)
+		if opt.seriesBottomReserve > 0 && opt.seriesBottomReserve < 1 {
+			result.bottomReserveHeight = int(float64(rangeHeight) * opt.seriesBottomReserve)
+			rangeHeight -= result.bottomReserveHeight
+		}
 		type yAxisEntry struct {
 			option ValueAxisOption
 			prep   *valueAxisPrep
@@ -388,7 +446,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 				if len(opt.valueAxis) > yIndex {
 					yAxisOption = opt.valueAxis[yIndex]
 				}
-				yAxisOption = *yAxisOption.prep(getPreferredTheme(yAxisOption.Theme, theme), true)
+				yAxisOption = *yAxisOption.prep(getPreferredTheme(yAxisOption.Theme, theme), p.font, true)
 				entries[yIndex].option = yAxisOption
 				if yAxisOption.isCategoryAxis { // TODO - remove when dual category axes are supported
 					entries[yIndex].r = calculateCategoryAxisRange(p, rangeHeight, true, false,
@@ -399,11 +457,26 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 					continue
 				}
 				valueFormatter := getPreferredValueFormatter(yAxisOption.ValueFormatter, opt.valueFormatter)
+				if yAxisOption.Type == AxisTypeLog {
+					// log axes resolve independently, decade split lines can't be shared with a linear axis
+					r, err := calculateLogAxisRange(p, rangeHeight, yAxisOption.Min, yAxisOption.Max,
+						yAxisOption.Labels, yAxisOption.LabelCount,
+						opt.seriesList, yIndex,
+						valueFormatter, yAxisOption.LabelRotation, yAxisOption.LabelFontStyle)
+					if err != nil {
+						return nil, err
+					}
+					entries[yIndex].r = r
+					continue
+				}
+				stackAxis := opt.stackSeries && yIndex == 0 // only the first y-axis stacks
+				yMin, yMax := roundedRangeBounds(yAxisOption.RangeRounding, yAxisOption.RangePaddingPercent,
+					yAxisOption.Min, yAxisOption.Max, opt.seriesList, yIndex, stackAxis)
 				prep := prepareValueAxisRange(p, true, rangeHeight,
-					yAxisOption.Min, yAxisOption.Max, yAxisOption.RangeValuePaddingScale,
+					yMin, yMax, yAxisOption.RangeValuePaddingScale,
 					yAxisOption.Labels,
 					yAxisOption.LabelCount, yAxisOption.Unit, yAxisOption.LabelCountAdjustment,
-					opt.seriesList, yIndex, opt.stackSeries && yIndex == 0, // only the first y-axis stacks
+					opt.seriesList, yIndex, stackAxis,
 					valueFormatter, yAxisOption.LabelRotation, yAxisOption.LabelFontStyle,
 					yAxisOption.PreferNiceIntervals)
 				prep.maxClearancePx = markPointClearance
@@ -424,7 +497,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		// vertical overlay legend: if data (or a mark point pin) under the legend's horizontal span
 		// would collide with it, reserve top headroom to push the chart below the legend.
 		// single value axis only; dual axes share a label count, so re-resolving one shifts the other.
-		if legendTopOverlay && len(valuePreps) == 1 {
+		if legendTopOverlay && len(valuePreps) == 1 && entries[0].prep != nil {
 			legendBottomRel := legendResult.Bottom - (p.box.Top - legendFrameTop)
 			leftOffset := p.box.Left - legendFrameLeft
 			n := getSeriesMaxDataCount(opt.seriesList)
@@ -462,7 +535,7 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 			yAxisBox, err := newAxisPainter(p.Child(PainterPaddingOption(Box{
 				Left:   rangeWidthLeft,
 				Right:  rangeWidthRight,
-				Bottom: xAxisHeight,
+				Bottom: xAxisHeight + result.bottomReserveHeight,
 				IsSet:  true,
 			})), axisOpt).Render()
 			if err != nil {
@@ -510,9 +583,80 @@ func defaultRender(p *Painter, opt defaultRenderOption) (*defaultRenderResult, e
 		Bottom: xAxisHeight,
 		IsSet:  true,
 	}))
+	drawPlotBorder(result.seriesPainter, opt.plotBorder, theme)
+	if !opt.categoryY {
+		for yIndex, yAxisOption := range opt.valueAxis {
+			if yRange, ok := result.valueAxisRanges[yIndex]; ok {
+				drawValueAxisMarkLines(result.seriesPainter, yAxisOption.MarkLines, yRange, theme)
+			}
+		}
+	}
 	return &result, nil
 }
 
+// drawValueAxisMarkLines draws the horizontal reference lines for a vertical value axis, skipping lines outside the
+// axis range.
+func drawValueAxisMarkLines(p *Painter, markLines []MarkLine, yRange axisRange, theme ColorPalette) {
+	for _, markLine := range markLines {
+		if !isValidExtent(markLine.Value) || markLine.Value < yRange.min || markLine.Value > yRange.max {
+			continue
+		}
+		color := markLine.Color
+		if color.IsZero() {
+			color = theme.GetMarkTextColor()
+		}
+		width := markLine.Width
+		if width <= 0 {
+			width = 1.0
+		}
+		y := yRange.getRestHeight(markLine.Value)
+		line := []Point{{X: 0, Y: y}, {X: p.Width(), Y: y}}
+		if len(markLine.DashArray) > 0 {
+			p.DashedLineStroke(line, color, width, markLine.DashArray)
+		} else {
+			p.LineStroke(line, color, width)
+		}
+		if markLine.Label != "" {
+			fontStyle := FontStyle{
+				Font:      getPreferredFont(p.font),
+				FontColor: color,
+				FontSize:  defaultLabelFontSize,
+			}
+			textBox := p.MeasureText(markLine.Label, 0, fontStyle)
+			p.Text(markLine.Label, p.Width()-textBox.Width()-2, y-3, 0, fontStyle)
+		}
+	}
+}
+
+// drawPlotBorder strokes the configured sides around the bounds of the painter.
+func drawPlotBorder(p *Painter, border PlotBorderOption, theme ColorPalette) {
+	if border.Width <= 0 {
+		return
+	}
+	color := border.Color
+	if color.IsZero() {
+		color = theme.GetYAxisStrokeColor()
+	}
+	sides := border.Sides
+	if sides == 0 {
+		sides = BorderSidesAll
+	}
+	right := p.Width()
+	bottom := p.Height()
+	if sides&BorderSideTop != 0 {
+		p.LineStroke([]Point{{X: 0, Y: 0}, {X: right, Y: 0}}, color, border.Width)
+	}
+	if sides&BorderSideRight != 0 {
+		p.LineStroke([]Point{{X: right, Y: 0}, {X: right, Y: bottom}}, color, border.Width)
+	}
+	if sides&BorderSideBottom != 0 {
+		p.LineStroke([]Point{{X: 0, Y: bottom}, {X: right, Y: bottom}}, color, border.Width)
+	}
+	if sides&BorderSideLeft != 0 {
+		p.LineStroke([]Point{{X: 0, Y: 0}, {X: 0, Y: bottom}}, color, border.Width)
+	}
+}
+
 // legendIndexSpan maps a legend's horizontal pixel span to an inclusive data index range [lo, hi]
 // over n points across plotWidth. ok is false when there are no points or no overlap.
 func legendIndexSpan(legendLeft, legendRight, plotWidth, n int) (int, int, bool) {
@@ -612,6 +756,17 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 	if err := opt.fillDefault(); err != nil {
 		return nil, err
 	}
+	if yAxis, percentChange := percentChangeAxes(opt.YAxis); percentChange {
+		seriesList := slices.Clone(opt.SeriesList)
+		for i := range seriesList {
+			if chartTypeMatch(ChartTypeLine, seriesList[i].Type) &&
+				isPercentChangeAxis(yAxis, seriesList[i].YAxisIndex) {
+				seriesList[i].Values = percentChangeValues(seriesList[i].Values)
+			}
+		}
+		opt.SeriesList = seriesList
+		opt.YAxis = yAxis
+	}
 
 	isChild := opt.parent != nil
 	if !isChild {
@@ -685,6 +840,7 @@ func Render(opt ChartOption, opts ...OptionFunc) (*Painter, error) {
 		legend:         &opt.Legend,
 		categoryY:      categoryY,
 		valueFormatter: opt.ValueFormatter,
+		plotBorder:     opt.PlotBorder,
 		// the background color has been set
 		backgroundIsFilled: true,
 	}
@@ -3,6 +3,7 @@ package charts
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,66 @@ func TestGetNullValue(t *testing.T) {
 	assert.InDelta(t, math.MaxFloat64, GetNullValue(), 0.0)
 }
 
+func TestSetNullValue(t *testing.T) {
+	// not parallel, the null value is global state
+	t.Cleanup(func() {
+		SetNullValue(math.MaxFloat64)
+	})
+	const sentinel = -1.0
+
+	renderLine := func(t *testing.T, values []float64) []byte {
+		t.Helper()
+
+		opt := makeMinimalLineChartOption()
+		opt.SeriesList = NewSeriesListLine([][]float64{values})
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.LineChart(opt))
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return data
+	}
+	renderScatter := func(t *testing.T, values []float64) []byte {
+		t.Helper()
+
+		opt := makeMinimalScatterChartOption()
+		opt.SeriesList = NewSeriesListScatter([][]float64{values})
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.ScatterChart(opt))
+		data, err := p.Bytes()
+		require.NoError(t, err)
+		return data
+	}
+
+	// render with the default sentinel as the reference for null handling
+	defaultNullLine := renderLine(t, []float64{120, 132, math.MaxFloat64, 134, 90, math.MaxFloat64, 210})
+	defaultNullScatter := renderScatter(t, []float64{120, 132, math.MaxFloat64, 134, 90, math.MaxFloat64, 210})
+
+	SetNullValue(sentinel)
+	assert.InDelta(t, sentinel, GetNullValue(), 0.0)
+
+	t.Run("custom_sentinel_is_null", func(t *testing.T) {
+		assert.False(t, isValidExtent(sentinel))
+		assert.Equal(t, string(defaultNullLine),
+			string(renderLine(t, []float64{120, 132, sentinel, 134, 90, sentinel, 210})))
+		assert.Equal(t, string(defaultNullScatter),
+			string(renderScatter(t, []float64{120, 132, sentinel, 134, 90, sentinel, 210})))
+	})
+
+	t.Run("old_sentinel_is_value", func(t *testing.T) {
+		assert.True(t, isValidExtent(math.MaxFloat64))
+
+		lineSeries := NewSeriesListLine([][]float64{{sentinel, 10, math.MaxFloat64}})
+		minValue, maxValue, _ := getSeriesMinMaxSumMax(lineSeries, 0, false)
+		assert.InDelta(t, 10.0, minValue, 0.0)
+		assert.InDelta(t, math.MaxFloat64, maxValue, 0.0)
+
+		scatterSeries := NewSeriesListScatter([][]float64{{sentinel, 10, math.MaxFloat64}})
+		minValue, maxValue, _ = getSeriesMinMaxSumMax(scatterSeries, 0, false)
+		assert.InDelta(t, 10.0, minValue, 0.0)
+		assert.InDelta(t, math.MaxFloat64, maxValue, 0.0)
+	})
+}
+
 func TestLegendRepositioning(t *testing.T) {
 	t.Parallel()
 
@@ -358,3 +419,152 @@ func TestVerticalLegendHeadroom(t *testing.T) {
 		assert.Greater(t, withMark, withoutMark)
 	})
 }
+
+func TestPlotAreaFitsAxisLabels(t *testing.T) {
+	t.Parallel()
+
+	render := func(valueLabel, categoryLabel string, rotation float64, padding Box) Box {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		sl := NewSeriesListLine([][]float64{{1, 5, 3, 8}})
+		rr, err := defaultRender(p, defaultRenderOption{
+			theme:      GetDefaultTheme(),
+			padding:    padding,
+			seriesList: &sl,
+			legend:     &LegendOption{},
+			categoryAxis: &CategoryAxisOption{
+				Labels:        []string{categoryLabel, categoryLabel, categoryLabel, categoryLabel},
+				LabelRotation: rotation,
+			},
+			valueAxis: []ValueAxisOption{{
+				ValueFormatter: func(float64) string { return valueLabel },
+			}},
+		})
+		require.NoError(t, err)
+		return rr.seriesPainter.box
+	}
+	fontStyle := FontStyle{FontSize: defaultFontSize, FontColor: ColorBlack, Font: GetDefaultFont()}
+	measurer := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG})
+
+	shortBox := render("1", "a", 0, Box{})
+	longBox := render("1,234,567", "a", 0, Box{})
+	widthDelta := measurer.MeasureText("1,234,567", 0, fontStyle).Width() -
+		measurer.MeasureText("1", 0, fontStyle).Width()
+
+	t.Run("value_label_width", func(t *testing.T) {
+		assert.Equal(t, shortBox.Left+widthDelta, longBox.Left)
+		assert.Equal(t, shortBox.Right, longBox.Right)
+		assert.Equal(t, shortBox.Bottom, longBox.Bottom)
+	})
+
+	t.Run("category_label_height", func(t *testing.T) {
+		rotatedBox := render("1", "2024-01-01 label", -math.Pi/4, Box{})
+		labelHeight := measurer.MeasureText("2024-01-01 label", -math.Pi/4, fontStyle).Height()
+		assert.Less(t, rotatedBox.Bottom, shortBox.Bottom)
+		assert.InDelta(t, shortBox.Bottom-rotatedBox.Bottom,
+			labelHeight-measurer.MeasureText("a", 0, fontStyle).Height(), 2)
+		assert.Equal(t, shortBox.Left, rotatedBox.Left)
+	})
+
+	t.Run("padding_is_extra", func(t *testing.T) {
+		paddedBox := render("1,234,567", "a", 0, Box{Left: 30, Top: 20, Right: 20, Bottom: 20, IsSet: true})
+		assert.Equal(t, longBox.Left+30, paddedBox.Left)
+		assert.Equal(t, longBox.Right-20, paddedBox.Right)
+	})
+}
+
+func TestValueAxisMarkLines(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicLineChartOption()
+	opt.YAxis = []YAxisOption{{
+		MarkLines: []MarkLine{
+			{Value: 1000, Label: "Resistance", Color: ColorRGB(200, 40, 40), DashArray: []float64{4, 2}},
+			{Value: 5000, Label: "Out of range"},
+		},
+	}}
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.LineChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, buf)
+	svg := string(buf)
+
+	assert.Contains(t, svg, ">Resistance</text>")
+	assert.Contains(t, svg, `stroke-dasharray="4.0, 2.0"`)
+	assert.NotContains(t, svg, "Out of range")
+}
+
+func TestChartInputErrors(t *testing.T) {
+	t.Parallel()
+
+	render := func(t *testing.T, draw func(p *Painter) error) error {
+		t.Helper()
+		return draw(NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400}))
+	}
+
+	t.Run("candlestick_empty", func(t *testing.T) {
+		require.NoError(t, render(t, func(p *Painter) error {
+			return p.CandlestickChart(CandlestickChartOption{})
+		}))
+		require.NoError(t, render(t, func(p *Painter) error {
+			return p.CandlestickChart(CandlestickChartOption{SeriesList: CandlestickSeriesList{{}}})
+		}))
+	})
+	t.Run("candlestick_label_mismatch", func(t *testing.T) {
+		opt := makeMinimalCandlestickChartOption()
+		opt.XAxis.Labels = opt.XAxis.Labels[:1]
+		require.NoError(t, render(t, func(p *Painter) error { return p.CandlestickChart(opt) }))
+	})
+	t.Run("candlestick_time_mismatch", func(t *testing.T) {
+		opt := makeMinimalCandlestickChartOption()
+		opt.XAxis.TimeValues = []time.Time{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		err := render(t, func(p *Painter) error { return p.CandlestickChart(opt) })
+		require.ErrorIs(t, err, ErrMissingTimeValues)
+	})
+	t.Run("candlestick_y_axis", func(t *testing.T) {
+		opt := makeMinimalCandlestickChartOption()
+		opt.SeriesList[0].YAxisIndex = 2
+		err := render(t, func(p *Painter) error { return p.CandlestickChart(opt) })
+		require.ErrorIs(t, err, ErrInvalidYAxisIndex)
+	})
+	t.Run("scatter_empty", func(t *testing.T) {
+		require.NoError(t, render(t, func(p *Painter) error {
+			return p.ScatterChart(ScatterChartOption{})
+		}))
+		require.NoError(t, render(t, func(p *Painter) error {
+			return p.ScatterChart(NewScatterChartOptionWithData([][]float64{{}, {}}))
+		}))
+	})
+	t.Run("scatter_label_mismatch", func(t *testing.T) {
+		opt := makeBasicScatterChartOption()
+		opt.XAxis.Labels = opt.XAxis.Labels[:1]
+		opt.SeriesList[0].ErrorValues = make([][2]float64, 50)
+		opt.SeriesList[0].PointLinks = make([]string, 50)
+		require.NoError(t, render(t, func(p *Painter) error { return p.ScatterChart(opt) }))
+	})
+	t.Run("scatter_time_mismatch", func(t *testing.T) {
+		opt := makeBasicScatterChartOption()
+		opt.XAxis.TimeValues = []time.Time{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+		err := render(t, func(p *Painter) error { return p.ScatterChart(opt) })
+		require.ErrorIs(t, err, ErrMissingTimeValues)
+	})
+	t.Run("scatter_y_axis", func(t *testing.T) {
+		opt := makeBasicScatterChartOption()
+		opt.SeriesList[0].YAxisIndex = 2
+		err := render(t, func(p *Painter) error { return p.ScatterChart(opt) })
+		require.ErrorIs(t, err, ErrInvalidYAxisIndex)
+	})
+	t.Run("chart_option_y_axis", func(t *testing.T) {
+		seriesList := NewSeriesListGeneric([][]float64{{1, 2}}, ChartTypeLine)
+		seriesList[0].YAxisIndex = 2
+		_, err := Render(ChartOption{SeriesList: seriesList})
+		require.ErrorIs(t, err, ErrInvalidYAxisIndex)
+	})
+	t.Run("pattern_scan", func(t *testing.T) {
+		cfg := (&CandlestickPatternConfig{}).WithPatternsAll()
+		assert.Empty(t, ScanCandlestickPatterns(nil, *cfg))
+		assert.Empty(t, ScanCandlestickPatterns([]OHLCData{{Open: 1, High: 2, Low: 0.5, Close: 1.5}}, *cfg))
+		assert.False(t, DetectMorningStar(nil, 5, *cfg))
+	})
+}
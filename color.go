@@ -158,6 +158,25 @@ func isLightColor(c Color) bool {
 	return math.Sqrt(r+g+b) > 127.5
 }
 
+// invertLightness returns the color with its HSL lightness mirrored, keeping the hue, saturation, and alpha.
+func invertLightness(c Color) Color {
+	_, _, l := c.HSL()
+	return c.WithAdjustHSL(0, 0, 1-2*l)
+}
+
+// contrastLightness limits the lightness of a data color so it remains visible against a dark or light background,
+// keeping the hue and saturation.
+func contrastLightness(c Color, darkBackground bool) Color {
+	const minDarkLightness, maxLightLightness = 0.45, 0.6
+	_, _, l := c.HSL()
+	if darkBackground && l < minDarkLightness {
+		return c.WithAdjustHSL(0, 0, minDarkLightness-l)
+	} else if !darkBackground && l > maxLightLightness {
+		return c.WithAdjustHSL(0, 0, maxLightLightness-l)
+	}
+	return c
+}
+
 // ParseColor parses a color from a string. Supports hex with '#' prefix (e.g. '#313233'),
 // rgb(i,i,i) or rgba(i,i,i,f) format, or common names (e.g. 'red').
 func ParseColor(rawColor string) Color {
@@ -0,0 +1,56 @@
+package charts
+
+import (
+	"math"
+)
+
+// ColorInterpolation selects how a ColorScale blends between its low and high colors.
+type ColorInterpolation string
+
+const (
+	// ColorInterpolationRGB blends linearly across the red, green, and blue channels.
+	ColorInterpolationRGB ColorInterpolation = "rgb"
+	// ColorInterpolationHSL blends linearly across hue, saturation, and lightness, taking the shorter path around
+	// the hue wheel. This keeps intermediate colors saturated when the endpoints have different hues.
+	ColorInterpolationHSL ColorInterpolation = "hsl"
+)
+
+// ColorScale maps scalar values to colors by interpolating between a low and high color.
+type ColorScale struct {
+	// Low is the color for the minimum value.
+	Low Color
+	// High is the color for the maximum value.
+	High Color
+	// Interpolation selects how colors between Low and High are blended. Default is ColorInterpolationRGB.
+	Interpolation ColorInterpolation
+	// ShowLegend when set to *true renders a gradient strip beside the plot showing the value range.
+	ShowLegend *bool
+}
+
+// NewColorScale returns a ColorScale which linearly interpolates from the low to the high color.
+func NewColorScale(low, high Color) ColorScale {
+	return ColorScale{
+		Low:           low,
+		High:          high,
+		Interpolation: ColorInterpolationRGB,
+	}
+}
+
+// isZero returns true if neither color of the scale has been set.
+func (c ColorScale) isZero() bool {
+	return c.Low.IsZero() && c.High.IsZero()
+}
+
+// ColorAt returns the color at the factor position of the scale, from 0.0 (Low) to 1.0 (High).
+func (c ColorScale) ColorAt(factor float64) Color {
+	if c.Interpolation != ColorInterpolationHSL {
+		return interpolateColor(c.Low, c.High, factor)
+	}
+	factor = math.Max(0, math.Min(1, factor))
+	h1, s1, l1 := c.Low.HSL()
+	h2, s2, l2 := c.High.HSL()
+	hueDelta := math.Mod(h2-h1+540, 360) - 180 // shortest direction around the hue wheel
+	result := c.Low.WithAdjustHSL(hueDelta*factor, (s2-s1)*factor, (l2-l1)*factor)
+	result.A = uint8(float64(c.Low.A) + factor*(float64(c.High.A)-float64(c.Low.A)))
+	return result
+}
@@ -0,0 +1,42 @@
+package charts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorScaleColorAt(t *testing.T) {
+	t.Parallel()
+
+	blue := Color{R: 0, G: 0, B: 255, A: 255}
+	red := Color{R: 255, G: 0, B: 0, A: 255}
+
+	t.Run("rgb", func(t *testing.T) {
+		scale := NewColorScale(blue, red)
+
+		assert.Equal(t, blue, scale.ColorAt(0))
+		assert.Equal(t, Color{R: 127, G: 0, B: 127, A: 255}, scale.ColorAt(0.5))
+		assert.Equal(t, red, scale.ColorAt(1))
+		assert.Equal(t, blue, scale.ColorAt(-1))
+		assert.Equal(t, red, scale.ColorAt(2))
+	})
+	t.Run("hsl", func(t *testing.T) {
+		scale := NewColorScale(blue, red)
+		scale.Interpolation = ColorInterpolationHSL
+
+		assert.Equal(t, blue, scale.ColorAt(0))
+		mid := scale.ColorAt(0.5) // magenta, remaining fully saturated
+		assert.Equal(t, uint8(255), mid.R)
+		assert.Equal(t, uint8(0), mid.G)
+		assert.InDelta(t, 255, mid.B, 1)
+		assert.Equal(t, red, scale.ColorAt(1))
+	})
+	t.Run("alpha", func(t *testing.T) {
+		scale := NewColorScale(blue.WithAlpha(0), blue)
+
+		assert.Equal(t, uint8(127), scale.ColorAt(0.5).A)
+		scale.Interpolation = ColorInterpolationHSL
+		assert.Equal(t, uint8(127), scale.ColorAt(0.5).A)
+	})
+}
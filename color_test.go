@@ -104,6 +104,32 @@ func TestIsLightColor(t *testing.T) {
 	assert.False(t, isLightColor(Color{R: 16, G: 12, B: 42}))
 }
 
+func TestInvertLightness(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ColorBlack, invertLightness(ColorWhite))
+	assert.Equal(t, ColorWhite, invertLightness(ColorBlack))
+	inverted := invertLightness(ColorBlue.WithAlpha(100))
+	assert.Equal(t, uint8(100), inverted.A)
+	h, _, _ := ColorBlue.HSL()
+	invertedH, _, _ := inverted.HSL()
+	assert.InDelta(t, h, invertedH, 1)
+}
+
+func TestContrastLightness(t *testing.T) {
+	t.Parallel()
+
+	navy := Color{R: 0, G: 0, B: 80, A: 255}
+	lightened := contrastLightness(navy, true)
+	_, _, l := lightened.HSL()
+	assert.InDelta(t, 0.45, l, 0.01)
+	assert.Equal(t, navy, contrastLightness(navy, false))
+
+	_, _, l = contrastLightness(ColorDesertSand, false).HSL()
+	assert.InDelta(t, 0.6, l, 0.01)
+	assert.Equal(t, ColorDesertSand, contrastLightness(ColorDesertSand, true))
+}
+
 func TestParseColor(t *testing.T) {
 	t.Parallel()
 
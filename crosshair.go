@@ -0,0 +1,91 @@
+package charts
+
+import (
+	"time"
+)
+
+// crosshairLabelPadding is the inset of the crosshair edge labels from the plot edge, matching the label background
+// padding of drawLabelWithBackground.
+const crosshairLabelPadding = 4
+
+// CrosshairOption configures a static crosshair, drawing dashed guide lines across the plot through a candle with
+// the x-axis label and price labeled at the plot edges. This is useful for calling out a specific bar in a report.
+type CrosshairOption struct {
+	// Index is the data index of the candle the vertical guide is drawn through.
+	Index int
+	// SeriesIndex selects the series whose candle and y-axis the crosshair is positioned against.
+	SeriesIndex int
+	// Value is the y-axis value the horizontal guide is drawn at. When nil, the candle close is used.
+	Value *float64
+	// StrokeColor sets the guide line color, defaulting to the theme x-axis stroke color.
+	StrokeColor Color
+	// StrokeWidth sets the guide line width (default 1).
+	StrokeWidth float64
+	// StrokeDashArray sets the guide line dash pattern (default 4, 2).
+	StrokeDashArray []float64
+	// LabelStyle overrides the edge label style, unset fields default to text on a background of the stroke color.
+	LabelStyle LabelStyle
+	// ValueFormatter formats the price label, defaulting to the chart ValueFormatter.
+	ValueFormatter ValueFormatter
+}
+
+// crosshairRenderOption is a resolved crosshair ready to be drawn.
+type crosshairRenderOption struct {
+	point           Point
+	xText           string
+	yText           string
+	strokeColor     Color
+	strokeWidth     float64
+	strokeDashArray []float64
+	style           LabelStyle
+}
+
+type crosshairPainter struct {
+	p   *Painter
+	opt crosshairRenderOption
+}
+
+func newCrosshairPainter(p *Painter, opt crosshairRenderOption) *crosshairPainter {
+	return &crosshairPainter{p: p, opt: opt}
+}
+
+func (c *crosshairPainter) Render() (Box, error) {
+	opt := c.opt
+	width, height := c.p.Width(), c.p.Height()
+	c.p.DashedLineStroke([]Point{{X: opt.point.X, Y: 0}, {X: opt.point.X, Y: height}},
+		opt.strokeColor, opt.strokeWidth, opt.strokeDashArray)
+	c.p.DashedLineStroke([]Point{{X: 0, Y: opt.point.Y}, {X: width, Y: opt.point.Y}},
+		opt.strokeColor, opt.strokeWidth, opt.strokeDashArray)
+
+	style := opt.style
+	fontStyle := fillFontStyleDefaults(style.FontStyle, defaultLabelFontSize, defaultLightFontColor, c.p.font)
+	if opt.yText != "" {
+		textBox := c.p.MeasureText(opt.yText, 0, fontStyle)
+		x := width - textBox.Width() - crosshairLabelPadding
+		drawLabelWithBackground(c.p, opt.yText, x, opt.point.Y+textBox.Height()/2, 0, fontStyle,
+			style.BackgroundColor, style.CornerRadius, style.BorderColor, style.BorderWidth)
+	}
+	if opt.xText != "" {
+		textBox := c.p.MeasureText(opt.xText, 0, fontStyle)
+		// centered on the guide, kept within the plot
+		x := opt.point.X - textBox.Width()/2
+		x = max(crosshairLabelPadding, min(x, width-textBox.Width()-crosshairLabelPadding))
+		drawLabelWithBackground(c.p, opt.xText, x, height-crosshairLabelPadding, 0, fontStyle,
+			style.BackgroundColor, style.CornerRadius, style.BorderColor, style.BorderWidth)
+	}
+	return BoxZero, nil
+}
+
+// crosshairXText returns the x-axis label for the data index, formatting the time value on time axes.
+func crosshairXText(xAxis XAxisOption, index int) string {
+	if index < len(xAxis.TimeValues) {
+		layout := xAxis.TimeLayout
+		if layout == "" {
+			layout = time.DateOnly
+		}
+		return xAxis.TimeValues[index].Format(layout)
+	} else if index < len(xAxis.Labels) {
+		return xAxis.Labels[index]
+	}
+	return ""
+}
@@ -183,9 +183,17 @@ func (d *doughnutChart) renderChart(result *defaultRenderResult) (Box, error) {
 			seriesPainter.lineTo(connectX, connectY)
 			seriesPainter.stroke(s.color, defaultStrokeWidth)
 
+			// labels are drawn over the center circle, or the slice if they extend onto the ring
+			labelX, labelY := lp.box.Center()
+			fill := circleColor
+			if math.Hypot(float64(labelX-cx), float64(labelY-cy)) > radiusCenter {
+				fill = sliceColorAt(sectors, cx, cy, labelX, labelY)
+			}
+
 			// finally, render the label text at its resolved position
 			fontStyle := fillFontStyleDefaults(mergeFontStyles(s.seriesLabel.FontStyle, opt.CenterValuesFontStyle),
-				defaultLabelFontSize, opt.Theme.GetLabelTextColor(), seriesPainter.font)
+				defaultLabelFontSize, labelFontColor(s.seriesLabel, s.labelStyle, fill, opt.Theme.GetLabelTextColor()),
+				seriesPainter.font)
 
 			// Apply label style overrides if present
 			var backgroundColor Color
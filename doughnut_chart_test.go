@@ -2,6 +2,7 @@ package charts
 
 import (
 	"math"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -317,6 +318,39 @@ func validateDoughnutChartRender(t *testing.T, svgP, pngP *Painter, opt Doughnut
 	assertEqualPNGCRC(t, expectedCRC, rasterData)
 }
 
+func TestDoughnutChartCenterLabelAutoContrast(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		theme    string
+		expected Color
+	}{
+		{theme: ThemeLight, expected: defaultLightFontColor},
+		{theme: ThemeDark, expected: defaultDarkFontColor},
+	} {
+		t.Run(tc.theme, func(t *testing.T) {
+			opt := makeMinimalDoughnutChartOption()
+			opt.Theme = GetTheme(tc.theme).WithLabelTextColor(ColorRed) // differs from both contrast colors
+			opt.CenterValues = "labels"
+			for i := range opt.SeriesList {
+				opt.SeriesList[i].Label.Show = Ptr(true)
+				opt.SeriesList[i].Label.AutoContrast = Ptr(true)
+			}
+
+			p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+			require.NoError(t, p.DoughnutChart(opt))
+			data, err := p.Bytes()
+			require.NoError(t, err)
+
+			matches := regexp.MustCompile(`<text[^>]*fill:(rgb\([0-9,]+\))`).FindAllStringSubmatch(string(data), -1)
+			require.Len(t, matches, len(opt.SeriesList))
+			for _, m := range matches {
+				assert.Equal(t, tc.expected.String(), m[1])
+			}
+		})
+	}
+}
+
 func TestDoughnutChartError(t *testing.T) {
 	t.Parallel()
 
@@ -170,6 +170,7 @@ func (al EChartsAxisLabel) makeFontStyle() FontStyle {
 
 // EChartsYAxisData holds a single y-axis configuration block.
 type EChartsYAxisData struct {
+	Type      string           `json:"type,omitempty"`
 	Min       *float64         `json:"min,omitempty"`
 	Max       *float64         `json:"max,omitempty"`
 	AxisLabel EChartsAxisLabel `json:"axisLabel,omitempty"`
@@ -611,7 +612,12 @@ func (eo *EChartsOption) ToOption() ChartOption {
 		if fallbackFont != nil && yLabelFontStyle.Font == nil {
 			yLabelFontStyle.Font = fallbackFont
 		}
+		var axisType AxisType
+		if item.Type == string(AxisTypeLog) {
+			axisType = AxisTypeLog
+		}
 		yAxisOptions[index] = YAxisOption{
+			Type:           axisType,
 			Min:            item.Min,
 			Max:            item.Max,
 			ValueFormatter: valFormatter,
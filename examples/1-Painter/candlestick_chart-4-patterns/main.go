@@ -44,6 +44,23 @@ func main() {
 	// Create and render all pattern configuration examples
 	examples := createPatternExamples(ohlcData)
 	generateExampleCharts(examples, ohlcData)
+
+	// Patterns can also be detected without rendering, for example to trigger alerts
+	printDetectedPatterns(ohlcData)
+}
+
+// printDetectedPatterns runs pattern detection headlessly and prints each detection
+func printDetectedPatterns(ohlcData []charts.OHLCData) {
+	config := (&charts.CandlestickPatternConfig{}).WithPatternsCore()
+	detections := charts.ScanCandlestickPatterns(ohlcData, *config)
+	for i := range ohlcData {
+		for _, pattern := range detections[i] {
+			fmt.Printf("candle %d: %s (confidence %.2f)\n", i, pattern.PatternName, pattern.Confidence)
+		}
+	}
+	if charts.DetectDoji(ohlcData, len(ohlcData)-2, *config) {
+		fmt.Println("second to last candle is a doji")
+	}
 }
 
 // createPatternExamples creates various pattern configuration examples
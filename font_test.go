@@ -14,14 +14,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-//go:embed chartdraw/drawing/fonts/Roboto-Medium.ttf.gz
-var testRobotoFont embed.FS
+//go:embed chartdraw/drawing/fonts/Roboto-Medium.ttf.gz chartdraw/drawing/fonts/NotoSans-Bold.ttf.gz
+var testFonts embed.FS
 
 // getTestFontData loads the Roboto font for testing.
 func getTestFontData(t *testing.T) []byte {
 	t.Helper()
 
-	compressed, err := testRobotoFont.ReadFile("chartdraw/drawing/fonts/Roboto-Medium.ttf.gz")
+	return getTestFontFile(t, "Roboto-Medium.ttf.gz")
+}
+
+// getTestFontFile loads and decompresses an embedded font file for testing.
+func getTestFontFile(t *testing.T, name string) []byte {
+	t.Helper()
+
+	compressed, err := testFonts.ReadFile("chartdraw/drawing/fonts/" + name)
 	require.NoError(t, err)
 
 	r, err := gzip.NewReader(bytes.NewReader(compressed))
@@ -501,3 +508,57 @@ func TestFontRenderPNG(t *testing.T) {
 
 	assertEqualPNGCRC(t, 0x0, data)
 }
+
+// renderPainterFontSVG renders the basic line chart with series labels shown using the painter options, returning the
+// SVG and the box of a text sample measured with the painter font.
+func renderPainterFontSVG(t *testing.T, opts PainterOptions) (string, Box) {
+	t.Helper()
+
+	opts.OutputFormat = ChartOutputSVG
+	opts.Width, opts.Height = 600, 400
+	p := NewPainter(opts)
+	textBox := p.MeasureText("Measured Text", 0, FontStyle{FontSize: 12, FontColor: ColorBlack})
+	opt := makeBasicLineChartOption()
+	opt.SeriesList[0].Label.Show = Ptr(true)
+	require.NoError(t, p.LineChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	return string(buf), textBox
+}
+
+func TestPainterFontFamily(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, InstallFont("painter-family-test", getTestFontFile(t, "NotoSans-Bold.ttf.gz")))
+
+	defaultSVG, defaultBox := renderPainterFontSVG(t, PainterOptions{})
+	customSVG, customBox := renderPainterFontSVG(t, PainterOptions{FontFamily: "Painter-Family-Test"})
+
+	assert.Contains(t, defaultSVG, "font-family:'Roboto")
+	assert.NotContains(t, customSVG, "font-family:'Roboto")
+	assert.Contains(t, customSVG, "font-family:'Noto Sans")
+	// the bold face is wider, shifting the text layout
+	assert.Greater(t, customBox.Width(), defaultBox.Width())
+	assert.NotEqual(t, defaultSVG, customSVG)
+
+	t.Run("font_precedence", func(t *testing.T) {
+		svg, _ := renderPainterFontSVG(t, PainterOptions{Font: GetDefaultFont(), FontFamily: "painter-family-test"})
+		assert.Equal(t, defaultSVG, svg)
+	})
+	t.Run("missing_family", func(t *testing.T) {
+		svg, _ := renderPainterFontSVG(t, PainterOptions{FontFamily: "not-installed"})
+		assert.Equal(t, defaultSVG, svg)
+	})
+	t.Run("table", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400, FontFamily: "painter-family-test"})
+		require.NoError(t, p.TableChart(TableChartOption{
+			Header: []string{"Name", "Value"},
+			Data:   [][]string{{"a", "1"}, {"b", "2"}},
+		}))
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+
+		assert.NotContains(t, string(buf), "font-family:'Roboto")
+		assert.Contains(t, string(buf), "font-family:'Noto Sans")
+	})
+}
@@ -119,11 +119,13 @@ func (f *funnelChart) renderChart(result *defaultRenderResult) (Box, error) {
 			},
 		}
 
-		seriesPainter.FillArea(points, theme.GetSeriesColor(index))
+		seriesColor := theme.GetSeriesColor(index)
+		seriesPainter.FillArea(points, seriesColor)
 
 		text := textList[index]
-		fontStyle := fillFontStyleDefaults(opt.SeriesList[index].Label.FontStyle,
-			defaultLabelFontSize, theme.GetLabelTextColor(), seriesPainter.font)
+		fontStyle := fillFontStyleDefaults(opt.SeriesList[index].Label.FontStyle, defaultLabelFontSize,
+			labelFontColor(opt.SeriesList[index].Label, labelStyleList[index], seriesColor, theme.GetLabelTextColor()),
+			seriesPainter.font)
 
 		// Apply label style overrides if present
 		var backgroundColor Color
@@ -1,6 +1,7 @@
 package charts
 
 import (
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -250,3 +251,32 @@ func TestFunnelChartError(t *testing.T) {
 		})
 	}
 }
+
+func TestFunnelChartLabelAutoContrast(t *testing.T) {
+	t.Parallel()
+
+	opt := NewFunnelChartOptionWithData([]float64{100, 50})
+	opt.Legend.Show = Ptr(false)
+	opt.Theme = GetDefaultTheme().WithSeriesColors([]Color{
+		{R: 20, G: 30, B: 60, A: 255},    // dark slice
+		{R: 250, G: 230, B: 140, A: 255}, // light slice
+	})
+	for i := range opt.SeriesList {
+		opt.SeriesList[i].Label.AutoContrast = Ptr(true)
+	}
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	})
+	require.NoError(t, p.FunnelChart(opt))
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+
+	matches := regexp.MustCompile(`<text[^>]*fill:(rgb\([0-9,]+\))`).FindAllStringSubmatch(string(data), -1)
+	require.Len(t, matches, 2)
+	assert.Equal(t, defaultDarkFontColor.String(), matches[0][1])
+	assert.Equal(t, defaultLightFontColor.String(), matches[1][1])
+}
@@ -2,6 +2,9 @@ package charts
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 )
 
 const (
@@ -16,9 +19,9 @@ func iconWidthForSymbol(symbol SymbolShape) int {
 	switch symbol {
 	case SymbolNone:
 		return 0
-	case SymbolDiamond:
+	case SymbolDiamond, SymbolTriangle, SymbolCross:
 		return 20
-	case SymbolSquare, SymbolCircle, SymbolDot, symbolCandlestick:
+	case SymbolSquare, SymbolCircle, SymbolDot, symbolCandlestick, symbolBubble:
 		return legendIconStandardWidth
 	default:
 		return legendIconStandardWidth
@@ -50,10 +53,18 @@ type LegendOption struct {
 	Vertical *bool
 	// Symbol overrides the legend icon shape. Empty (default) mirrors the series/chart symbols.
 	Symbol SymbolShape
-	// OverlayChart when set to *true renders the legend over the chart. Ignored if Vertical is true (Vertical always forces overlay).
+	// OverlayChart when set to *true renders the legend over the chart. Vertical legends overlay the chart by default,
+	// set to *false to instead reserve space beside the plot on the side the legend is positioned.
 	OverlayChart *bool
 	// BorderWidth can be set to a non-zero value to render a box around the legend.
 	BorderWidth float64
+	// MaxLabelChars when greater than zero truncates longer series names with an ellipsis. SVG output retains the
+	// full name as a title, displayed on hover.
+	MaxLabelChars int
+	// SeriesGroups when set to *true tags SVG output for interactive legends. Each legend entry, and the elements of
+	// the matching series, are wrapped in a group with a shared data-series attribute, allowing front-end code to
+	// toggle series visibility. Series elements are currently grouped for scatter charts.
+	SeriesGroups *bool
 	// seriesSymbols provides custom symbols for each series.
 	seriesSymbols []SymbolShape
 }
@@ -68,6 +79,24 @@ func (opt *LegendOption) IsEmpty() bool {
 	return true
 }
 
+// seriesGroupAttributes returns the attributes shared by the SVG groups of a series and its legend entry. The series
+// index is used when the series is unnamed.
+func seriesGroupAttributes(index int, name string) map[string]string {
+	if name == "" {
+		name = strconv.Itoa(index)
+	}
+	return map[string]string{"data-series": name}
+}
+
+// truncateLegendLabel shortens text to at most maxChars characters, ending with an ellipsis when truncated.
+func truncateLegendLabel(text string, maxChars int) string {
+	if maxChars <= 0 || utf8.RuneCountInString(text) <= maxChars {
+		return text
+	}
+	runes := []rune(text)
+	return strings.TrimSpace(string(runes[:max(maxChars-1, 0)])) + "…"
+}
+
 // newLegendPainter returns a legend renderer.
 func newLegendPainter(p *Painter, opt LegendOption) *legendPainter {
 	return &legendPainter{
@@ -118,7 +147,7 @@ func (l *legendPainter) computeLayoutParams() (
 	iconWidths = make([]int, len(opt.SeriesNames))
 	var totalIconWidth, maxIconWidth int
 	for index, text := range opt.SeriesNames {
-		b := p.MeasureText(text, 0, fontStyle)
+		b := p.MeasureText(truncateLegendLabel(text, opt.MaxLabelChars), 0, fontStyle)
 		if b.Width() > maxTextWidth {
 			maxTextWidth = b.Width()
 		}
@@ -314,15 +343,25 @@ func (l *legendPainter) Render() (Box, error) {
 
 			drawIcon := l.makeIconDrawer(p, theme, index, seriesSymbol)
 
-			if opt.Align != AlignRight {
-				drawIcon(y0, x0)
-				x0 += iconWidth + legendTextOffset
-			}
-			p.Text(text, x0, y0, 0, fontStyle)
-			if opt.Align == AlignRight {
-				x0 += measureList[index].Width() + legendTextOffset
-				drawIcon(y0, x0)
+			var groupAttrs map[string]string
+			if flagIs(true, opt.SeriesGroups) {
+				groupAttrs = seriesGroupAttributes(index, text)
 			}
+			p.withElementGroup(groupAttrs, func() {
+				if opt.Align != AlignRight {
+					drawIcon(y0, x0)
+					x0 += iconWidth + legendTextOffset
+				}
+				if displayText := truncateLegendLabel(text, opt.MaxLabelChars); displayText != text {
+					p.textWithTitle(displayText, text, x0, y0, 0, fontStyle)
+				} else {
+					p.Text(text, x0, y0, 0, fontStyle)
+				}
+				if opt.Align == AlignRight {
+					x0 += measureList[index].Width() + legendTextOffset
+					drawIcon(y0, x0)
+				}
+			})
 		})
 	bottom := y0 + padding.Bottom - 10
 	if !vertical {
@@ -357,8 +396,25 @@ func (l *legendPainter) makeIconDrawer(p *Painter, theme ColorPalette, index int
 			color := theme.GetSeriesColor(index)
 			p.FilledDiamond(left+5, top-5, 15, 20, color, color, 0)
 		}
+	case SymbolTriangle:
+		return func(top, left int) {
+			color := theme.GetSeriesColor(index)
+			p.triangleMoveLine(left+8, top-5, 16)
+			p.fillStroke(color, color, 0)
+		}
+	case SymbolCross:
+		return func(top, left int) {
+			p.crossMoveLine(left+8, top-5, 14)
+			p.stroke(theme.GetSeriesColor(index), 3)
+		}
 	case SymbolNone:
 		return func(top, left int) {}
+	case symbolBubble:
+		return func(top, left int) {
+			color := theme.GetSeriesColor(index)
+			p.Circle(bubbleLegendRadius, left+legendIconStandardWidth/2, top-5,
+				color.WithAlpha(bubbleFillAlpha), color, 1)
+		}
 	case symbolCandlestick:
 		return func(top, left int) {
 			upColor, downColor := theme.GetSeriesUpDownColors(index)
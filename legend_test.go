@@ -358,6 +358,34 @@ func TestNewLegend(t *testing.T) {
 	}
 }
 
+func TestLegendMaxLabelChars(t *testing.T) {
+	t.Parallel()
+
+	longName := "Quarterly Revenue Across All Regions"
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	}, PainterThemeOption(GetTheme(ThemeLight)))
+	_, err := newLegendPainter(p, LegendOption{
+		SeriesNames:   []string{"Short", longName},
+		MaxLabelChars: 12,
+	}).Render()
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	assertTestdataSVG(t, data)
+
+	svg := string(data)
+	assert.Contains(t, svg, ">Short</text>")
+	assert.Contains(t, svg, ">Quarterly R…<title>"+longName+"</title></text>")
+	assert.NotContains(t, svg, `">`+longName) // visible text directly follows the text element attributes
+
+	assert.Equal(t, "Short", truncateLegendLabel("Short", 12))
+	assert.Equal(t, "Short", truncateLegendLabel("Short", 0))
+	assert.Equal(t, "Data…", truncateLegendLabel("Data Series", 6)) // trailing space is trimmed
+}
+
 func TestLegendCalculateBox(t *testing.T) {
 	t.Parallel()
 
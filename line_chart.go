@@ -70,6 +70,10 @@ type LineChartOption struct {
 	FillArea *bool
 	// FillOpacity is the opacity/alpha (0-255) of the area fill.
 	FillOpacity uint8
+	// SimplifyTolerance when set above zero simplifies the rendered line path, removing points that deviate less
+	// than this many pixels from the path. This reduces output size for dense series without modifying the data,
+	// symbols and labels are still rendered for every data point.
+	SimplifyTolerance float64
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
 }
@@ -121,7 +125,12 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 		// Although label changes can be forced to center, this behavior is unconditional for the line
 		boundaryGap = false
 	}
-	xValues := boundaryGapAxisPositions(seriesPainter.Width(), boundaryGap, xDivideCount)
+	var xValues []int
+	if result.categoryAxisRange.isTimeAxis() {
+		xValues = result.categoryAxisRange.timeDataPositions(seriesPainter.Width())
+	} else {
+		xValues = boundaryGapAxisPositions(seriesPainter.Width(), boundaryGap, xDivideCount)
+	}
 	// accumulatedValues is used for stacking: it holds the summed data values at each X index
 	var accumulatedValues []float64
 	if stackedSeries {
@@ -196,8 +205,9 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 			}
 		}
 
+		linePoints := simplifyPoints(points, opt.SimplifyTolerance)
 		if (series.YAxisIndex == 0 && fillAreaY0) || fillAreaY1 {
-			areaPoints := slices.Clone(points)
+			areaPoints := slices.Clone(linePoints)
 			for i, p := range areaPoints {
 				if p.Y != math.MaxInt32 {
 					if i > 0 {
@@ -244,9 +254,9 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 
 		// Draw the line
 		if opt.StrokeSmoothingTension > 0 {
-			seriesPainter.SmoothLineStroke(points, opt.StrokeSmoothingTension, seriesColor, strokeWidth)
+			seriesPainter.SmoothLineStroke(linePoints, opt.StrokeSmoothingTension, seriesColor, strokeWidth)
 		} else {
-			seriesPainter.LineStroke(points, seriesColor, strokeWidth)
+			seriesPainter.LineStroke(linePoints, seriesColor, strokeWidth)
 		}
 
 		// Draw symbols if enabled
@@ -293,6 +303,22 @@ func (l *lineChart) renderChart(result *defaultRenderResult) (Box, error) {
 				size = ceilFloatToInt(strokeWidth * 4.0)
 			}
 			seriesPainter.diamonds(points, seriesColor, seriesColor, 1, size)
+		case SymbolTriangle:
+			var size int
+			if symbolSize > 0 {
+				size = ceilFloatToInt(symbolSize * 2.4)
+			} else if size = 4; strokeWidth > 1 {
+				size = ceilFloatToInt(strokeWidth * 3.4)
+			}
+			seriesPainter.triangles(points, seriesColor, seriesColor, 1, size)
+		case SymbolCross:
+			var size int
+			if symbolSize > 0 {
+				size = ceilFloatToInt(symbolSize * 2.8)
+			} else if size = 4; strokeWidth > 1 {
+				size = ceilFloatToInt(strokeWidth * 4.0)
+			}
+			seriesPainter.crosses(points, seriesColor, max(1, strokeWidth), size)
 		}
 
 		var globalSeriesData []float64 // lazily initialized
@@ -420,6 +446,17 @@ func (l *lineChart) Render() (Box, error) {
 		}
 	}
 
+	if yAxis, percentChange := percentChangeAxes(opt.YAxis); percentChange {
+		seriesList := slices.Clone(opt.SeriesList)
+		for i := range seriesList {
+			if isPercentChangeAxis(yAxis, seriesList[i].YAxisIndex) {
+				seriesList[i].Values = percentChangeValues(seriesList[i].Values)
+			}
+		}
+		opt.SeriesList = seriesList
+		opt.YAxis = yAxis
+	}
+
 	renderResult, err := defaultRender(p, defaultRenderOption{
 		theme:          opt.Theme,
 		padding:        opt.Padding,
@@ -1,8 +1,12 @@
 package charts
 
 import (
+	"math"
+	"regexp"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -115,6 +119,16 @@ func makeFullLineChartStackedOption() LineChartOption {
 	}
 }
 
+func renderLineSVG(t *testing.T, opt LineChartOption) string {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.LineChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	return string(buf)
+}
+
 func TestNewLineChartOptionWithData(t *testing.T) {
 	t.Parallel()
 
@@ -1357,3 +1371,179 @@ func TestLineChartOptionNotMutated(t *testing.T) {
 	assert.Nil(t, opt.YAxis[0].Theme)
 	assert.Nil(t, opt.YAxis[1].Theme)
 }
+
+func TestLineChartSimplifyTolerance(t *testing.T) {
+	t.Parallel()
+
+	values := make([]float64, 200)
+	for i := range values {
+		values[i] = 100*math.Sin(float64(i)/30) + float64(i%2)*0.1 // smooth with small noise
+	}
+	render := func(tolerance float64) string {
+		opt := NewLineChartOptionWithData([][]float64{values})
+		opt.SimplifyTolerance = tolerance
+		opt.XAxis.Show = Ptr(false)
+		opt.YAxis[0].Show = Ptr(false)
+		return renderLineSVG(t, opt)
+	}
+
+	full := render(0)
+	simplified := render(1)
+	assert.Less(t, strings.Count(simplified, "\nL "), strings.Count(full, "\nL ")/2)
+	assertTestdataSVG(t, []byte(simplified))
+}
+
+func TestLineChartTimeAxis(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC)
+	opt := makeBasicLineChartOption()
+	opt.XAxis.Labels = nil
+	// uneven sampling within a single hour
+	for _, minutes := range []int{0, 2, 5, 12, 30, 35, 55} {
+		opt.XAxis.TimeValues = append(opt.XAxis.TimeValues, start.Add(time.Duration(minutes)*time.Minute))
+	}
+
+	svg := renderLineSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+	assert.Contains(t, svg, ">10:00<")
+}
+
+func TestLineChartYAxisLabelRotation(t *testing.T) {
+	t.Parallel()
+
+	makeRotatedOption := func(position string) LineChartOption {
+		opt := makeBasicLineChartOption()
+		opt.YAxis = []YAxisOption{{
+			Position:      position,
+			LabelRotation: DegreesToRadians(45),
+			ValueFormatter: func(v float64) string {
+				return FormatValueHumanize(v*1000, 0, false) + " units"
+			},
+		}}
+		return opt
+	}
+
+	for _, position := range []string{PositionLeft, PositionRight} {
+		t.Run("rotate_45_"+position, func(t *testing.T) {
+			svg := renderLineSVG(t, makeRotatedOption(position))
+			assertTestdataSVG(t, []byte(svg))
+
+			assert.Contains(t, svg, `transform="rotate(45.00,`)
+			assert.Contains(t, svg, ">0 units</text>")
+		})
+	}
+}
+
+func TestLineChartSplitLines(t *testing.T) {
+	t.Parallel()
+
+	const splitStroke = "stroke-width:1;stroke:rgb(224,230,242);fill:none"
+
+	t.Run("dashed", func(t *testing.T) {
+		opt := makeBasicLineChartOption()
+		opt.YAxis[0].SplitLineStyle = SplitLineStyle{
+			Color:     ColorRGB(180, 180, 180),
+			Width:     2,
+			DashArray: []float64{4, 4},
+		}
+		svg := renderLineSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Contains(t, svg, `stroke-dasharray="4.0, 4.0"`)
+		assert.Contains(t, svg, "stroke-width:2;stroke:rgb(180,180,180);fill:none")
+		assert.NotContains(t, svg, splitStroke)
+	})
+	t.Run("split_number", func(t *testing.T) {
+		opt := makeBasicLineChartOption()
+		base := strings.Count(renderLineSVG(t, opt), splitStroke)
+		opt.YAxis[0].SplitNumber = 2
+		assert.Equal(t, 2, strings.Count(renderLineSVG(t, opt), splitStroke))
+		opt.YAxis[0].SplitNumber = base * 2
+		assert.Equal(t, base*2, strings.Count(renderLineSVG(t, opt), splitStroke))
+	})
+	t.Run("vertical", func(t *testing.T) {
+		opt := makeBasicLineChartOption()
+		base := strings.Count(renderLineSVG(t, opt), splitStroke)
+		opt.XAxis.SplitLineShow = Ptr(true)
+		opt.XAxis.SplitLineStyle = SplitLineStyle{DashArray: []float64{2, 2}}
+		svg := renderLineSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		// vertical lines share the default split line stroke, adding one per label to the horizontal lines
+		assert.Equal(t, base+len(opt.XAxis.Labels), strings.Count(svg, splitStroke))
+		assert.Equal(t, len(opt.XAxis.Labels), strings.Count(svg, `stroke-dasharray="2.0, 2.0"`))
+	})
+}
+
+func TestLineChartPercentChangeAxis(t *testing.T) {
+	t.Parallel()
+
+	makeOption := func() LineChartOption {
+		opt := NewLineChartOptionWithData([][]float64{
+			{100, 110, 90, 125, 120},
+			{1000, 1050, 1200, 900, 1100},
+		})
+		opt.XAxis.Labels = []string{"Mon", "Tue", "Wed", "Thu", "Fri"}
+		opt.YAxis = []YAxisOption{{Mode: AxisModePercentChange}}
+		return opt
+	}
+	labelPattern := regexp.MustCompile(`<text x="\d+" y="\d+" style="[^"]*">([^<]*)</text>`)
+	seriesStartPattern := regexp.MustCompile(`<path d="M (\d+) (\d+)\nL[^"]*" style="stroke-width:2;`)
+
+	t.Run("rebase", func(t *testing.T) {
+		opt := makeOption()
+		svg := renderLineSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		var percentLabels []string
+		for _, m := range labelPattern.FindAllStringSubmatch(svg, -1) {
+			if strings.HasSuffix(m[1], "%") {
+				percentLabels = append(percentLabels, m[1])
+			}
+		}
+		assert.Contains(t, percentLabels, "0%")
+		assert.Contains(t, percentLabels, "20%")
+		assert.NotContains(t, svg, ">1k<")
+		// both series start at 0% so share the first point
+		starts := seriesStartPattern.FindAllStringSubmatch(svg, -1)
+		require.Len(t, starts, 2)
+		assert.Equal(t, starts[0][1:], starts[1][1:])
+		// input option is not mutated
+		assert.InDelta(t, 100.0, opt.SeriesList[0].Values[0], 0)
+		assert.Nil(t, opt.YAxis[0].ValueFormatter)
+	})
+	t.Run("custom_formatter", func(t *testing.T) {
+		opt := makeOption()
+		opt.YAxis[0].ValueFormatter = func(v float64) string {
+			return strconv.FormatFloat(v, 'f', 0, 64) + "pct"
+		}
+		svg := renderLineSVG(t, opt)
+		assert.Contains(t, svg, ">0pct<")
+		assert.NotContains(t, svg, "%<")
+	})
+	t.Run("value_axis_unchanged", func(t *testing.T) {
+		opt := makeOption()
+		opt.SeriesList[1].YAxisIndex = 1
+		opt.YAxis = append(opt.YAxis, YAxisOption{Mode: AxisModeValue})
+		svg := renderLineSVG(t, opt)
+		assert.Contains(t, svg, ">-10%<")
+		assert.Contains(t, svg, ">1.2k<") // second axis retains the series values
+	})
+	t.Run("chart_option", func(t *testing.T) {
+		p, err := Render(ChartOption{
+			OutputFormat: ChartOutputSVG,
+			SeriesList: NewSeriesListGeneric([][]float64{
+				{100, 110, 90, 125, 120},
+				{1000, 1050, 1200, 900, 1100},
+			}, ChartTypeLine),
+			YAxis: []YAxisOption{{Mode: AxisModePercentChange}},
+		})
+		require.NoError(t, err)
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		svg := string(buf)
+		assert.Contains(t, svg, ">0%<")
+		assert.NotContains(t, svg, ">1k<")
+	})
+}
@@ -76,7 +76,7 @@ func (m *markLinePainter) Render() (Box, error) {
 			continue // no valid data (all null)
 		}
 		fontStyle := FontStyle{
-			Font:      getPreferredFont(opt.font),
+			Font:      getPreferredFont(opt.font, painter.font),
 			FontColor: opt.fontColor,
 			FontSize:  defaultLabelFontSize,
 		}
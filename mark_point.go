@@ -87,13 +87,9 @@ func (m *markPointPainter) Render() (Box, error) {
 		}
 		summary := summarizePopulationData(opt.seriesValues)
 		textStyle := FontStyle{
-			FontSize: defaultLabelFontSize,
-			Font:     getPreferredFont(opt.font),
-		}
-		if isLightColor(opt.fillColor) {
-			textStyle.FontColor = defaultLightFontColor
-		} else {
-			textStyle.FontColor = defaultDarkFontColor
+			FontSize:  defaultLabelFontSize,
+			Font:      getPreferredFont(opt.font, painter.font),
+			FontColor: contrastFontColor(opt.fillColor),
 		}
 		for _, markPointData := range opt.markpoints {
 			textStyle.FontSize = defaultLabelFontSize
@@ -0,0 +1,128 @@
+package charts
+
+import "slices"
+
+// OHLCBarChartOption defines options for rendering OHLC bar charts, drawing each period as a vertical high-low line
+// with a tick to the left at the open and a tick to the right at the close. Axes, overlays, and pattern detection
+// behave the same as CandlestickChartOption. Render the chart using Painter.OHLCBarChart.
+type OHLCBarChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// SeriesList provides the OHLC data population for the chart. Typically constructed using NewSeriesListCandlestick.
+	// CandleStyle and ShowWicks are not used by OHLC bars.
+	SeriesList CandlestickSeriesList
+	// XAxis contains options for the x-axis.
+	XAxis XAxisOption
+	// YAxis contains options for the y-axis. At most two y-axes are supported.
+	YAxis []YAxisOption
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the data legend.
+	Legend LegendOption
+	// BarWidth sets the ratio (0.0–1.0) of the available space spanned by the open and close ticks (default 0.6).
+	BarWidth float64
+	// LineWidth sets the stroke width of the bar lines in pixels (default 1.0).
+	LineWidth float64
+	// BarMargin sets inter-series spacing ratio (0.0–1.0, auto by default).
+	// Only applies with multiple series.
+	BarMargin *float64
+	// InvertColors when true swaps the theme up and down colors, for markets where rising prices are shown in red
+	// and falling prices in green.
+	InvertColors *bool
+	// RightMarginBars reserves empty space equal to this many bar slots on the right side of the chart.
+	RightMarginBars int
+	// PercentAxis when true rebases all OHLC values to the percentage change from a reference price, labeling the
+	// y-axis as percentages.
+	PercentAxis *bool
+	// PercentReference is the price PercentAxis values are relative to. When zero, each series is rebased against
+	// the close of its first bar.
+	PercentReference float64
+	// VolumePane when true draws a volume histogram in a strip below the bars, sharing the x-axis.
+	VolumePane *bool
+	// VolumePaneHeight sets the fraction (0.0–1.0) of the plot height used by the volume pane (default 0.2).
+	VolumePaneHeight float64
+	// Overlays draws a moving average line over the close prices of each series.
+	Overlays []MovingAverage
+	// Annotations places text callouts on the chart, for example to mark news events or trade entries.
+	Annotations []ChartAnnotation
+	// HighlightGaps when true shades price gaps between consecutive bars.
+	HighlightGaps *bool
+	// GapMinPercent is the minimum gap size, as a percentage of the prior close, for a gap to be highlighted.
+	GapMinPercent float64
+	// ValueFormatter formats numeric values.
+	ValueFormatter ValueFormatter
+}
+
+// NewOHLCBarOptionWithData creates an OHLCBarChartOption from OHLC data slices.
+func NewOHLCBarOptionWithData(data ...[]OHLCData) OHLCBarChartOption {
+	seriesList := make(CandlestickSeriesList, len(data))
+	for i, ohlcData := range data {
+		seriesList[i] = CandlestickSeries{Data: ohlcData}
+	}
+	return NewOHLCBarOptionWithSeries(seriesList...)
+}
+
+// NewOHLCBarOptionWithSeries returns an initialized OHLCBarChartOption with the provided Series.
+func NewOHLCBarOptionWithSeries(series ...CandlestickSeries) OHLCBarChartOption {
+	seriesList := CandlestickSeriesList(slices.Clone(series))
+	return OHLCBarChartOption{
+		SeriesList:     seriesList,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		YAxis:          make([]YAxisOption, getSeriesYAxisCount(seriesList)),
+		ValueFormatter: defaultValueFormatter,
+		BarWidth:       0.6,
+		LineWidth:      1.0,
+	}
+}
+
+// candlestickOption returns the candlestick configuration which renders these OHLC bars.
+func (o OHLCBarChartOption) candlestickOption() CandlestickChartOption {
+	barWidth := o.BarWidth
+	if barWidth <= 0 {
+		barWidth = 0.6
+	}
+	return CandlestickChartOption{
+		Theme:            o.Theme,
+		Padding:          o.Padding,
+		SeriesList:       o.SeriesList,
+		XAxis:            o.XAxis,
+		YAxis:            o.YAxis,
+		Title:            o.Title,
+		Legend:           o.Legend,
+		CandleWidth:      barWidth,
+		WickWidth:        o.LineWidth,
+		CandleMargin:     o.BarMargin,
+		InvertColors:     o.InvertColors,
+		RightMarginBars:  o.RightMarginBars,
+		PercentAxis:      o.PercentAxis,
+		PercentReference: o.PercentReference,
+		VolumePane:       o.VolumePane,
+		VolumePaneHeight: o.VolumePaneHeight,
+		Overlays:         o.Overlays,
+		Annotations:      o.Annotations,
+		HighlightGaps:    o.HighlightGaps,
+		GapMinPercent:    o.GapMinPercent,
+		ValueFormatter:   o.ValueFormatter,
+		ohlcBars:         true,
+	}
+}
+
+// drawOHLCBar draws a vertical line from the high to the low, with the open ticked to the left and the close ticked
+// to the right.
+func drawOHLCBar(p *Painter, leftX, centerX, rightX, highY, lowY, openY, closeY int, color Color, width float64) {
+	p.LineStroke([]Point{
+		{X: centerX, Y: highY},
+		{X: centerX, Y: lowY},
+	}, color, width)
+	p.LineStroke([]Point{
+		{X: leftX, Y: openY},
+		{X: centerX, Y: openY},
+	}, color, width)
+	p.LineStroke([]Point{
+		{X: centerX, Y: closeY},
+		{X: rightX, Y: closeY},
+	}, color, width)
+}
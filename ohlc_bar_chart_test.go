@@ -0,0 +1,64 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBasicOHLCBarChartOption() OHLCBarChartOption {
+	return OHLCBarChartOption{
+		Padding: NewBoxEqual(10),
+		XAxis: XAxisOption{
+			Labels: []string{"Mon", "Tue", "Wed"},
+		},
+		YAxis: make([]YAxisOption, 1),
+		SeriesList: NewSeriesListCandlestick([][]OHLCData{{
+			{Open: 100, High: 112, Low: 96, Close: 110}, // up
+			{Open: 111, High: 114, Low: 97, Close: 98},  // down, bearish engulfing
+			{Open: 103, High: 109, Low: 99, Close: 107}, // up
+		}}),
+	}
+}
+
+func renderOHLCBarSVG(t *testing.T, opt OHLCBarChartOption) string {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.OHLCBarChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestOHLCBarChart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("basic", func(t *testing.T) {
+		svg := renderOHLCBarSVG(t, makeBasicOHLCBarChartOption())
+		assertTestdataSVG(t, []byte(svg))
+
+		upColor, downColor := GetDefaultTheme().GetSeriesUpDownColors(0)
+		// each bar is a high-low line, an open tick, and a close tick
+		assert.Equal(t, 6, strings.Count(svg, "stroke:"+upColor.String()+";fill:none"))
+		assert.Equal(t, 3, strings.Count(svg, "stroke:"+downColor.String()+";fill:none"))
+	})
+	t.Run("line_width", func(t *testing.T) {
+		opt := makeBasicOHLCBarChartOption()
+		opt.LineWidth = 3
+		assert.Equal(t, 9, strings.Count(renderOHLCBarSVG(t, opt), "stroke-width:3;"))
+	})
+	t.Run("with_data", func(t *testing.T) {
+		opt := NewOHLCBarOptionWithData(makeBasicOHLCBarChartOption().SeriesList[0].Data)
+		opt.XAxis.Labels = []string{"Mon", "Tue", "Wed"}
+		upColor, _ := opt.Theme.GetSeriesUpDownColors(0)
+		assert.Equal(t, 6, strings.Count(renderOHLCBarSVG(t, opt), "stroke:"+upColor.String()+";fill:none"))
+	})
+	t.Run("patterns", func(t *testing.T) {
+		opt := makeBasicOHLCBarChartOption()
+		opt.SeriesList[0].PatternConfig = (&CandlestickPatternConfig{}).WithEngulfingBear()
+		assert.Contains(t, renderOHLCBarSVG(t, opt), "Bear Engulfing")
+	})
+}
@@ -0,0 +1,198 @@
+package charts
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OHLCColumnMapping describes how CSV columns map to OHLCData fields. Each column is identified either by a header
+// name (matched case-insensitively) or by a zero-based column index such as "2". Header names take precedence when
+// the file has a header row.
+type OHLCColumnMapping struct {
+	// Open identifies the open price column, defaults to "open".
+	Open string
+	// High identifies the high price column, defaults to "high".
+	High string
+	// Low identifies the low price column, defaults to "low".
+	Low string
+	// Close identifies the close price column, defaults to "close".
+	Close string
+	// Volume optionally identifies the volume column, volume is not parsed when empty.
+	Volume string
+	// Time optionally identifies the time column, times are not parsed when empty.
+	Time string
+	// TimeLayout is the layout used to parse the time column. When empty RFC3339, "2006-01-02 15:04:05", and
+	// "2006-01-02" are attempted.
+	TimeLayout string
+	// Comma sets the field delimiter, defaults to ','.
+	Comma rune
+}
+
+var defaultCSVTimeLayouts = []string{time.RFC3339, time.DateTime, time.DateOnly}
+
+// csvColumn is a mapped column resolved to its index within each record.
+type csvColumn struct {
+	field string
+	id    string
+	index int
+}
+
+// ParseOHLCCSV reads OHLC data from CSV, returning the samples in file order ready for use in a CandlestickSeries.
+// A header row is detected automatically when the first row does not contain numeric prices. Empty price cells are
+// set to the null value so the candle is skipped when rendered.
+func ParseOHLCCSV(r io.Reader, mapping OHLCColumnMapping) ([]OHLCData, error) {
+	data, _, err := ParseOHLCCSVWithTime(r, mapping)
+	return data, err
+}
+
+// ParseOHLCCSVWithTime reads OHLC data from CSV like ParseOHLCCSV, additionally returning the parsed values from the
+// Time column, suitable for XAxisOption.TimeValues. The returned times are nil when no Time column is mapped.
+func ParseOHLCCSVWithTime(r io.Reader, mapping OHLCColumnMapping) ([]OHLCData, []time.Time, error) {
+	reader := csv.NewReader(r)
+	if mapping.Comma != 0 {
+		reader.Comma = mapping.Comma
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read csv: %w", err)
+	} else if len(records) == 0 {
+		return nil, nil, errors.New("csv contains no rows")
+	}
+
+	open := csvColumn{field: "open", id: mapping.Open}
+	high := csvColumn{field: "high", id: mapping.High}
+	low := csvColumn{field: "low", id: mapping.Low}
+	closeCol := csvColumn{field: "close", id: mapping.Close}
+	volume := csvColumn{field: "volume", id: mapping.Volume, index: -1}
+	timeCol := csvColumn{field: "time", id: mapping.Time, index: -1}
+	priceColumns := []*csvColumn{&open, &high, &low, &closeCol}
+	for _, c := range priceColumns {
+		if c.id == "" {
+			c.id = c.field
+		}
+	}
+
+	header := records[0]
+	hasHeader := csvIsHeader(header, priceColumns)
+	for _, c := range append(priceColumns, &volume, &timeCol) {
+		if c.id == "" {
+			continue
+		}
+		c.index = -1
+		if hasHeader {
+			for i, name := range header {
+				if strings.EqualFold(strings.TrimSpace(name), c.id) {
+					c.index = i
+					break
+				}
+			}
+		}
+		if c.index < 0 {
+			if i, err := strconv.Atoi(c.id); err == nil && i >= 0 {
+				c.index = i
+			} else {
+				return nil, nil, fmt.Errorf("%s column %q not found", c.field, c.id)
+			}
+		}
+	}
+	line := 1 // line number of the first data record, used for error reporting
+	if hasHeader {
+		records = records[1:]
+		line = 2
+	}
+
+	data := make([]OHLCData, 0, len(records))
+	var times []time.Time
+	if timeCol.index >= 0 {
+		times = make([]time.Time, 0, len(records))
+	}
+	for i, record := range records {
+		var ohlc OHLCData
+		prices := []*float64{&ohlc.Open, &ohlc.High, &ohlc.Low, &ohlc.Close}
+		for j, c := range priceColumns {
+			v, err := csvFloat(record, *c, line+i, true)
+			if err != nil {
+				return nil, nil, err
+			}
+			*prices[j] = v
+		}
+		if volume.index >= 0 {
+			if ohlc.Volume, err = csvFloat(record, volume, line+i, false); err != nil {
+				return nil, nil, err
+			}
+		}
+		if timeCol.index >= 0 {
+			t, err := csvTime(record, timeCol, mapping.TimeLayout, line+i)
+			if err != nil {
+				return nil, nil, err
+			}
+			times = append(times, t)
+		}
+		data = append(data, ohlc)
+	}
+	return data, times, nil
+}
+
+// csvIsHeader returns true if any of the price columns in the row are not numeric.
+func csvIsHeader(row []string, priceColumns []*csvColumn) bool {
+	for _, c := range priceColumns {
+		if i, err := strconv.Atoi(c.id); err == nil && i >= 0 && i < len(row) {
+			if _, err := strconv.ParseFloat(strings.TrimSpace(row[i]), 64); err != nil {
+				return true
+			}
+			continue
+		}
+		// a column identified by name requires a header
+		return true
+	}
+	return false
+}
+
+func csvCell(record []string, c csvColumn, line int) (string, error) {
+	if c.index >= len(record) {
+		return "", fmt.Errorf("line %d: missing %s column %d", line, c.field, c.index)
+	}
+	return strings.TrimSpace(record[c.index]), nil
+}
+
+// csvFloat parses a numeric cell. Empty cells produce the null value for prices, or zero otherwise.
+func csvFloat(record []string, c csvColumn, line int, price bool) (float64, error) {
+	cell, err := csvCell(record, c, line)
+	if err != nil {
+		return 0, err
+	} else if cell == "" {
+		if price {
+			return GetNullValue(), nil
+		}
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(cell, 64)
+	if err != nil {
+		return 0, fmt.Errorf("line %d: invalid %s value %q", line, c.field, cell)
+	}
+	return v, nil
+}
+
+func csvTime(record []string, c csvColumn, layout string, line int) (time.Time, error) {
+	cell, err := csvCell(record, c, line)
+	if err != nil {
+		return time.Time{}, err
+	}
+	layouts := defaultCSVTimeLayouts
+	if layout != "" {
+		layouts = []string{layout}
+	}
+	for _, l := range layouts {
+		if t, err := time.Parse(l, cell); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("line %d: invalid time value %q", line, cell)
+}
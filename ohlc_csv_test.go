@@ -0,0 +1,88 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOHLCCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("index_mapping", func(t *testing.T) {
+		csv := "100,110,95,105,1200\n105,112,101,103,900\n"
+		data, err := ParseOHLCCSV(strings.NewReader(csv), OHLCColumnMapping{
+			Open: "0", High: "1", Low: "2", Close: "3", Volume: "4",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []OHLCData{
+			{Open: 100, High: 110, Low: 95, Close: 105, Volume: 1200},
+			{Open: 105, High: 112, Low: 101, Close: 103, Volume: 900},
+		}, data)
+	})
+	t.Run("header", func(t *testing.T) {
+		csv := "Date,Open,High,Low,Close,Volume\n" +
+			"2024-01-02,100,110,95,105,1200\n" +
+			"2024-01-03,105,112,101,103,900\n"
+		data, times, err := ParseOHLCCSVWithTime(strings.NewReader(csv), OHLCColumnMapping{
+			Volume: "volume", Time: "date",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []OHLCData{
+			{Open: 100, High: 110, Low: 95, Close: 105, Volume: 1200},
+			{Open: 105, High: 112, Low: 101, Close: 103, Volume: 900},
+		}, data)
+		assert.Equal(t, []time.Time{
+			time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		}, times)
+	})
+	t.Run("header_index_mapping", func(t *testing.T) {
+		csv := "o;h;l;c\n100;110;95;105\n"
+		data, err := ParseOHLCCSV(strings.NewReader(csv), OHLCColumnMapping{
+			Open: "0", High: "1", Low: "2", Close: "3", Comma: ';',
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []OHLCData{{Open: 100, High: 110, Low: 95, Close: 105}}, data)
+	})
+	t.Run("empty_price", func(t *testing.T) {
+		csv := "open,high,low,close\n100,110,95,105\n,,,\n"
+		data, err := ParseOHLCCSV(strings.NewReader(csv), OHLCColumnMapping{})
+		require.NoError(t, err)
+		require.Len(t, data, 2)
+		assert.False(t, validateOHLCData(data[1]))
+	})
+	t.Run("bad_number", func(t *testing.T) {
+		csv := "open,high,low,close\n100,110,95,105\n105,abc,101,103\n"
+		_, err := ParseOHLCCSV(strings.NewReader(csv), OHLCColumnMapping{})
+		require.Error(t, err)
+		assert.Equal(t, `line 3: invalid high value "abc"`, err.Error())
+	})
+	t.Run("missing_column", func(t *testing.T) {
+		csv := "open,high,low\n100,110,95\n"
+		_, err := ParseOHLCCSV(strings.NewReader(csv), OHLCColumnMapping{})
+		require.Error(t, err)
+		assert.Equal(t, `close column "close" not found`, err.Error())
+	})
+	t.Run("short_row", func(t *testing.T) {
+		csv := "100,110,95,105\n100,110,95\n"
+		_, err := ParseOHLCCSV(strings.NewReader(csv), OHLCColumnMapping{
+			Open: "0", High: "1", Low: "2", Close: "3",
+		})
+		require.Error(t, err)
+		assert.Equal(t, "line 2: missing close column 3", err.Error())
+	})
+	t.Run("bad_time", func(t *testing.T) {
+		csv := "time,open,high,low,close\nyesterday,100,110,95,105\n"
+		_, _, err := ParseOHLCCSVWithTime(strings.NewReader(csv), OHLCColumnMapping{Time: "time"})
+		require.Error(t, err)
+		assert.Equal(t, `line 2: invalid time value "yesterday"`, err.Error())
+	})
+	t.Run("empty", func(t *testing.T) {
+		_, err := ParseOHLCCSV(strings.NewReader(""), OHLCColumnMapping{})
+		require.Error(t, err)
+	})
+}
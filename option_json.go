@@ -0,0 +1,318 @@
+package charts
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Chart options can be stored as JSON and reloaded. Function fields such as value formatters can't be serialized and
+// are omitted, they must be set again after unmarshalling. Installed themes are encoded by name, so custom themes should
+// be registered with InstallTheme before unmarshalling; an unknown theme name results in an error. Themes which can't
+// be referenced by name, such as those modified by a With method, are encoded with their colors.
+
+// MarshalJSON encodes the formatter as null, functions are not serialized.
+func (f ValueFormatter) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON ignores the encoded value, leaving the formatter unset.
+func (f *ValueFormatter) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+// MarshalJSON encodes the formatter as null, functions are not serialized.
+func (f SeriesLabelFormatter) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON ignores the encoded value, leaving the formatter unset.
+func (f *SeriesLabelFormatter) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+// MarshalJSON encodes the formatter as null, functions are not serialized.
+func (f PatternFormatter) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON ignores the encoded value, leaving the formatter unset.
+func (f *PatternFormatter) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+// MarshalJSON encodes the callback as null, functions are not serialized.
+func (f CandlestickLayoutCallback) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON ignores the encoded value, leaving the callback unset.
+func (f *CandlestickLayoutCallback) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+// axisRangeRoundingNames maps each rounding mode to its serialized name.
+var axisRangeRoundingNames = map[axisRangeRoundingMode]string{
+	axisRangeRoundNice:     "nice",
+	axisRangeRoundData:     "data",
+	axisRangeRoundMultiple: "multiple",
+}
+
+// axisRangeRoundingJSON is the serialized form of AxisRangeRounding.
+type axisRangeRoundingJSON struct {
+	Mode     string
+	Multiple float64 `json:",omitempty"`
+}
+
+// MarshalJSON encodes the rounding by mode name, including the multiple for RoundToMultiple.
+func (r AxisRangeRounding) MarshalJSON() ([]byte, error) {
+	return json.Marshal(axisRangeRoundingJSON{Mode: axisRangeRoundingNames[r.mode], Multiple: r.multiple})
+}
+
+// UnmarshalJSON decodes a rounding encoded by MarshalJSON. An empty mode results in RoundNice.
+func (r *AxisRangeRounding) UnmarshalJSON(data []byte) error {
+	var v axisRangeRoundingJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v.Mode {
+	case "", axisRangeRoundingNames[axisRangeRoundNice]:
+		*r = RoundNice
+	case axisRangeRoundingNames[axisRangeRoundData]:
+		*r = RoundToData
+	case axisRangeRoundingNames[axisRangeRoundMultiple]:
+		*r = RoundToMultiple(v.Multiple)
+	default:
+		return fmt.Errorf("unknown axis range rounding mode: '%s'", v.Mode)
+	}
+	return nil
+}
+
+// themeJSON returns the serialized theme. Installed themes are referenced by name, other themes (such as those
+// modified by a With method) are encoded with their colors.
+func themeJSON(theme ColorPalette) (json.RawMessage, error) {
+	if theme == nil {
+		return nil, nil
+	}
+	if s, ok := theme.(fmt.Stringer); ok {
+		if installed, ok := palettes.Load(s.String()); ok && installed == theme {
+			return json.Marshal(s.String())
+		}
+	}
+	tcp, ok := theme.(*themeColorPalette)
+	if !ok {
+		return nil, fmt.Errorf("unable to serialize theme '%v', install it with InstallTheme", theme)
+	}
+	return json.Marshal(ThemeOption{
+		IsDarkMode:         tcp.isDarkMode,
+		XAxisStrokeColor:   tcp.xaxisStrokeColor,
+		YAxisStrokeColor:   tcp.yaxisStrokeColor,
+		AxisSplitLineColor: tcp.axisSplitLineColor,
+		BackgroundColor:    tcp.backgroundColor,
+		TextColorTitle:     tcp.titleTextColor,
+		TextColorMark:      tcp.markTextColor,
+		TextColorLabel:     tcp.labelTextColor,
+		TextColorLegend:    tcp.legendTextColor,
+		TextColorXAxis:     tcp.xaxisTextColor,
+		TextColorYAxis:     tcp.yaxisTextColor,
+		TitleBorderColor:   tcp.titleBorderColor,
+		LegendBorderColor:  tcp.legendBorderColor,
+		SeriesColors:       tcp.seriesColors,
+		SeriesTrendColors:  tcp.seriesTrendColors,
+		CandleWickColor:    tcp.candleWickColor,
+		SeriesUpDownColors: tcp.seriesUpDownColors,
+	})
+}
+
+// themeFromJSON returns the theme encoded by themeJSON, or nil if no theme was set. An error is returned if the
+// theme name is not installed.
+func themeFromJSON(data json.RawMessage) (ColorPalette, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	if data[0] == '"' {
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return nil, err
+		} else if name == "" {
+			return nil, nil
+		} else if value, ok := palettes.Load(name); ok {
+			if cp, ok := value.(ColorPalette); ok {
+				return cp, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown theme: '%s'", name)
+	}
+	var opt ThemeOption
+	if err := json.Unmarshal(data, &opt); err != nil {
+		return nil, err
+	}
+	return MakeTheme(opt), nil
+}
+
+// MarshalJSON encodes the option with the theme referenced by name, or by its colors if it is not installed.
+func (o CandlestickChartOption) MarshalJSON() ([]byte, error) {
+	type plain CandlestickChartOption
+	theme, err := themeJSON(o.Theme)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		plain
+		Theme json.RawMessage `json:",omitempty"`
+	}{plain: plain(o), Theme: theme})
+}
+
+// UnmarshalJSON decodes the option, resolving the theme by name or from its colors.
+func (o *CandlestickChartOption) UnmarshalJSON(data []byte) error {
+	type plain CandlestickChartOption
+	v := struct {
+		*plain
+		Theme json.RawMessage
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var err error
+	o.Theme, err = themeFromJSON(v.Theme)
+	return err
+}
+
+// MarshalJSON encodes the option with the theme referenced by name, or by its colors if it is not installed.
+func (o ScatterChartOption) MarshalJSON() ([]byte, error) {
+	type plain ScatterChartOption
+	theme, err := themeJSON(o.Theme)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		plain
+		Theme json.RawMessage `json:",omitempty"`
+	}{plain: plain(o), Theme: theme})
+}
+
+// UnmarshalJSON decodes the option, resolving the theme by name or from its colors.
+func (o *ScatterChartOption) UnmarshalJSON(data []byte) error {
+	type plain ScatterChartOption
+	v := struct {
+		*plain
+		Theme json.RawMessage
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var err error
+	o.Theme, err = themeFromJSON(v.Theme)
+	return err
+}
+
+// MarshalJSON encodes the option with the theme referenced by name, or by its colors if it is not installed.
+func (o CategoryAxisOption) MarshalJSON() ([]byte, error) {
+	type plain CategoryAxisOption
+	theme, err := themeJSON(o.Theme)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		plain
+		Theme json.RawMessage `json:",omitempty"`
+	}{plain: plain(o), Theme: theme})
+}
+
+// UnmarshalJSON decodes the option, resolving the theme by name or from its colors.
+func (o *CategoryAxisOption) UnmarshalJSON(data []byte) error {
+	type plain CategoryAxisOption
+	v := struct {
+		*plain
+		Theme json.RawMessage
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var err error
+	o.Theme, err = themeFromJSON(v.Theme)
+	return err
+}
+
+// MarshalJSON encodes the option with the theme referenced by name, or by its colors if it is not installed.
+func (o ValueAxisOption) MarshalJSON() ([]byte, error) {
+	type plain ValueAxisOption
+	theme, err := themeJSON(o.Theme)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		plain
+		Theme json.RawMessage `json:",omitempty"`
+	}{plain: plain(o), Theme: theme})
+}
+
+// UnmarshalJSON decodes the option, resolving the theme by name or from its colors.
+func (o *ValueAxisOption) UnmarshalJSON(data []byte) error {
+	type plain ValueAxisOption
+	v := struct {
+		*plain
+		Theme json.RawMessage
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var err error
+	o.Theme, err = themeFromJSON(v.Theme)
+	return err
+}
+
+// MarshalJSON encodes the option with the theme referenced by name, or by its colors if it is not installed.
+func (o TitleOption) MarshalJSON() ([]byte, error) {
+	type plain TitleOption
+	theme, err := themeJSON(o.Theme)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		plain
+		Theme json.RawMessage `json:",omitempty"`
+	}{plain: plain(o), Theme: theme})
+}
+
+// UnmarshalJSON decodes the option, resolving the theme by name or from its colors.
+func (o *TitleOption) UnmarshalJSON(data []byte) error {
+	type plain TitleOption
+	v := struct {
+		*plain
+		Theme json.RawMessage
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var err error
+	o.Theme, err = themeFromJSON(v.Theme)
+	return err
+}
+
+// MarshalJSON encodes the option with the theme referenced by name, or by its colors if it is not installed.
+func (o LegendOption) MarshalJSON() ([]byte, error) {
+	type plain LegendOption
+	theme, err := themeJSON(o.Theme)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		plain
+		Theme json.RawMessage `json:",omitempty"`
+	}{plain: plain(o), Theme: theme})
+}
+
+// UnmarshalJSON decodes the option, resolving the theme by name or from its colors.
+func (o *LegendOption) UnmarshalJSON(data []byte) error {
+	type plain LegendOption
+	v := struct {
+		*plain
+		Theme json.RawMessage
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var err error
+	o.Theme, err = themeFromJSON(v.Theme)
+	return err
+}
@@ -0,0 +1,109 @@
+package charts
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("candlestick", func(t *testing.T) {
+		opt := makeMinimalCandlestickChartOption()
+		opt.Theme = GetTheme(ThemeVividDark)
+		opt.Title = TitleOption{
+			Text:      "Price",
+			FontStyle: FontStyle{FontSize: 14, FontColor: ColorRed, Font: GetFont(FontFamilyNotoSans)},
+		}
+		opt.Legend = LegendOption{SeriesNames: []string{"Price"}, Theme: GetTheme(ThemeLight)}
+		opt.YAxis[0].ValueFormatter = func(v float64) string { return "$" + FormatValueHumanize(v, 0, false) }
+		opt.HollowUpCandles = Ptr(true)
+		opt.Annotations = []ChartAnnotation{{Index: 2, Text: "Event"}}
+		opt.SeriesList[0].PatternConfig = (&CandlestickPatternConfig{}).WithPatternsAll()
+		opt.SeriesList[0].Label.ValueFormatter = func(v float64) string { return "" }
+		opt.LayoutCallback = func(CandlestickLayout) {}
+
+		expected := renderCandlestickSVG(t, opt)
+		data, err := json.Marshal(opt)
+		require.NoError(t, err)
+		var decoded CandlestickChartOption
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		assert.Equal(t, opt.Theme, decoded.Theme)
+		assert.Equal(t, opt.Legend.Theme, decoded.Legend.Theme)
+		assert.Equal(t, opt.Title.FontStyle, decoded.Title.FontStyle)
+		assert.Nil(t, decoded.YAxis[0].ValueFormatter)
+		assert.Nil(t, decoded.LayoutCallback)
+		decoded.YAxis[0].ValueFormatter = opt.YAxis[0].ValueFormatter
+		assert.Equal(t, expected, renderCandlestickSVG(t, decoded))
+	})
+	t.Run("scatter", func(t *testing.T) {
+		opt := makeBasicScatterChartOption()
+		opt.Theme = GetTheme(ThemeAnt)
+		opt.Title.Text = "Scatter"
+		opt.Symbol = Symbol{Shape: SymbolDiamond, Size: 4}
+
+		expected := renderScatterSVG(t, opt)
+		data, err := json.Marshal(opt)
+		require.NoError(t, err)
+		var decoded ScatterChartOption
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		assert.Equal(t, expected, renderScatterSVG(t, decoded))
+	})
+	t.Run("range_rounding", func(t *testing.T) {
+		for _, rounding := range []AxisRangeRounding{RoundNice, RoundToData, RoundToMultiple(5)} {
+			opt := makeMinimalCandlestickChartOption()
+			opt.YAxis[0].RangeRounding = rounding
+
+			expected := renderCandlestickSVG(t, opt)
+			data, err := json.Marshal(opt)
+			require.NoError(t, err)
+			var decoded CandlestickChartOption
+			require.NoError(t, json.Unmarshal(data, &decoded))
+
+			assert.Equal(t, rounding, decoded.YAxis[0].RangeRounding)
+			assert.Equal(t, expected, renderCandlestickSVG(t, decoded))
+		}
+
+		data, err := json.Marshal(RoundToMultiple(2.5))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Mode":"multiple","Multiple":2.5}`, string(data))
+
+		var decoded AxisRangeRounding
+		require.NoError(t, json.Unmarshal([]byte(`{}`), &decoded))
+		assert.Equal(t, RoundNice, decoded)
+		assert.Error(t, json.Unmarshal([]byte(`{"Mode":"unknown"}`), &decoded))
+	})
+	t.Run("modified_theme", func(t *testing.T) {
+		opt := makeMinimalCandlestickChartOption()
+		opt.Theme = GetTheme(ThemeDark).WithTitleTextColor(ColorRed)
+		opt.Title.Text = "Price"
+
+		expected := renderCandlestickSVG(t, opt)
+		data, err := json.Marshal(opt)
+		require.NoError(t, err)
+		var decoded CandlestickChartOption
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		require.NotNil(t, decoded.Theme)
+		assert.True(t, decoded.Theme.IsDark())
+		assert.Equal(t, ColorRed, decoded.Theme.GetTitleTextColor())
+		assert.Equal(t, expected, renderCandlestickSVG(t, decoded))
+	})
+	t.Run("unknown_theme", func(t *testing.T) {
+		var decoded CandlestickChartOption
+		assert.Error(t, json.Unmarshal([]byte(`{"Theme":"missing"}`), &decoded))
+	})
+	t.Run("unset_theme", func(t *testing.T) {
+		data, err := json.Marshal(CandlestickChartOption{})
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), `"Theme"`)
+		var decoded CandlestickChartOption
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Nil(t, decoded.Theme)
+	})
+}
@@ -2,9 +2,14 @@ package charts
 
 import (
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"image"
+	"image/draw"
+	"io"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -37,6 +42,9 @@ type Painter struct {
 	box          Box
 	theme        ColorPalette
 	font         *truetype.Font
+	watermark    *WatermarkOption
+	// watermarkDrawn is set once the watermark is drawn, preventing it from being drawn again on repeated output.
+	watermarkDrawn bool
 }
 
 // PainterOptions contains parameters for creating a new Painter.
@@ -47,10 +55,21 @@ type PainterOptions struct {
 	Width int
 	// Height is the height of the painter canvas.
 	Height int
+	// AspectRatio sets the width to height ratio (for example 16.0/9.0) of the drawing area. When only Width or
+	// only Height is set, the other dimension is computed from the ratio. When both are set the canvas retains
+	// the explicit dimensions, and the drawing area is letterboxed to the largest centered box matching the ratio.
+	AspectRatio float64
 	// Font is the default font for rendering text.
 	Font *truetype.Font
+	// FontFamily selects the default font by the name it was installed under (see InstallFont) when Font is nil.
+	// Families that are not installed fall back to the default font.
+	FontFamily string
 	// Theme is the default theme used when charts don't specify one.
 	Theme ColorPalette
+	// JPEGQuality sets the encoding quality (1-100) for "jpg" output. Default is 90.
+	JPEGQuality int
+	// Watermark sets a semi-transparent text or image drawn over the chart when the output is written.
+	Watermark *WatermarkOption
 }
 
 // PainterOptionFunc defines a function that can modify a Painter after creation.
@@ -63,6 +82,8 @@ type ticksOption struct {
 	tickSpaces  int
 	strokeWidth float64
 	strokeColor Color
+	// positions when set draws a tick at each offset instead of dividing the tick spaces evenly.
+	positions []int
 }
 
 type multiTextOption struct {
@@ -115,6 +136,17 @@ func PainterFontOption(font *truetype.Font) PainterOptionFunc {
 
 // NewPainter creates a painter for rendering charts.
 func NewPainter(opts PainterOptions, opt ...PainterOptionFunc) *Painter {
+	ratio := opts.AspectRatio
+	if ratio <= 0 || math.IsInf(ratio, 0) || math.IsNaN(ratio) {
+		ratio = 0
+	} else if opts.Width <= 0 && opts.Height > 0 {
+		opts.Width = max(int(math.Round(float64(opts.Height)*ratio)), 1)
+	} else if opts.Height <= 0 {
+		if opts.Width <= 0 {
+			opts.Width = defaultChartWidth
+		}
+		opts.Height = max(int(math.Round(float64(opts.Width)/ratio)), 1)
+	}
 	if opts.Width <= 0 {
 		opts.Width = defaultChartWidth
 	}
@@ -124,26 +156,59 @@ func NewPainter(opts PainterOptions, opt ...PainterOptionFunc) *Painter {
 	fn := chartdraw.PNG
 	switch opts.OutputFormat {
 	case ChartOutputJPG:
-		fn = chartdraw.JPG
+		if opts.JPEGQuality > 0 {
+			fn = chartdraw.JPGWithQuality(opts.JPEGQuality)
+		} else {
+			fn = chartdraw.JPG
+		}
 	case ChartOutputSVG:
 		fn = chartdraw.SVG
 	}
 
+	font := opts.Font
+	if font == nil && opts.FontFamily != "" {
+		font = GetFont(opts.FontFamily)
+	}
+
 	p := &Painter{
 		outputFormat: opts.OutputFormat,
 		render:       fn(opts.Width, opts.Height),
-		box: Box{
-			Right:  opts.Width,
-			Bottom: opts.Height,
-			IsSet:  true,
-		},
-		font:  opts.Font,
-		theme: opts.Theme,
+		box:          letterboxBox(opts.Width, opts.Height, ratio),
+		font:         font,
+		theme:        opts.Theme,
+		watermark:    opts.Watermark,
 	}
 	p.setOptions(opt...)
 	return p
 }
 
+// Reset clears the canvas so the painter can render another chart, reusing the allocated buffers. The dimensions,
+// output format, font, and theme are retained. Reset must be called on the painter returned from NewPainter, not on
+// a child painter.
+func (p *Painter) Reset() {
+	if r, ok := p.render.(chartdraw.ResettableRenderer); ok {
+		r.Reset()
+	}
+	p.watermarkDrawn = false
+}
+
+// letterboxBox returns the largest box centered within the canvas which matches the aspect ratio.
+// A ratio of zero returns the full canvas.
+func letterboxBox(width, height int, ratio float64) Box {
+	box := Box{Right: width, Bottom: height, IsSet: true}
+	if ratio <= 0 {
+		return box
+	}
+	if targetHeight := int(math.Round(float64(width) / ratio)); targetHeight < height {
+		box.Top = (height - targetHeight) / 2
+		box.Bottom = box.Top + targetHeight
+	} else if targetWidth := int(math.Round(float64(height) * ratio)); targetWidth < width {
+		box.Left = (width - targetWidth) / 2
+		box.Right = box.Left + targetWidth
+	}
+	return box
+}
+
 func (p *Painter) setOptions(opts ...PainterOptionFunc) {
 	for _, fn := range opts {
 		fn(p)
@@ -163,15 +228,113 @@ func (p *Painter) Child(opt ...PainterOptionFunc) *Painter {
 	return child
 }
 
+// withElementMetadata invokes draw with the attributes and link applied to each drawn element. Renderers which can't
+// annotate elements (raster output) invoke draw unchanged.
+func (p *Painter) withElementMetadata(attrs map[string]string, link string, draw func()) {
+	annotator, ok := p.render.(chartdraw.ElementMetadataRenderer)
+	if !ok || (len(attrs) == 0 && link == "") {
+		draw()
+		return
+	}
+	annotator.SetElementAttributes(attrs)
+	annotator.SetElementLink(link)
+	draw()
+	annotator.SetElementAttributes(nil)
+	annotator.SetElementLink("")
+}
+
+// withElementGroup invokes draw with the drawn elements contained in a group carrying the attributes. Renderers which
+// can't group elements (raster output) invoke draw unchanged.
+func (p *Painter) withElementGroup(attrs map[string]string, draw func()) {
+	grouper, ok := p.render.(chartdraw.ElementMetadataRenderer)
+	if !ok || len(attrs) == 0 {
+		draw()
+		return
+	}
+	grouper.StartElementGroup(attrs)
+	draw()
+	grouper.EndElementGroup()
+}
+
 // Bytes returns the final rendered data as a byte slice.
 func (p *Painter) Bytes() ([]byte, error) {
 	buffer := bytes.Buffer{}
-	if err := p.render.Save(&buffer); err != nil {
+	if _, err := p.WriteTo(&buffer); err != nil {
 		return nil, err
 	}
 	return buffer.Bytes(), nil
 }
 
+// WriteTo encodes the final rendered data directly to the writer, implementing io.WriterTo. The returned count is the
+// number of bytes written.
+func (p *Painter) WriteTo(w io.Writer) (int64, error) {
+	if _, isCollector := w.(chartdraw.RGBACollector); isCollector {
+		return 0, p.save(w) // raster image is handed over directly rather than encoded
+	}
+	cw := &countingWriter{w: w}
+	err := p.save(cw)
+	return cw.n, err
+}
+
+// save draws the watermark over the finished chart and writes the renderer output.
+func (p *Painter) save(w io.Writer) error {
+	p.drawWatermark()
+	return p.render.Save(w)
+}
+
+// DrawOnto composites the rendered raster chart into the caller owned image, with the top left corner of the chart
+// placed at offset. Pixels falling outside the image bounds are clipped. This allows several charts to be stitched
+// into a single image without encoding each one. An error is returned for SVG output.
+func (p *Painter) DrawOnto(img *image.RGBA, offset image.Point) error {
+	if p.outputFormat == ChartOutputSVG {
+		return errors.New("DrawOnto requires raster output, svg painters can not be drawn onto an image")
+	} else if img == nil {
+		return errors.New("nil destination image")
+	}
+	var w chartdraw.ImageWriter
+	if err := p.save(&w); err != nil {
+		return err
+	}
+	src, err := w.Image()
+	if err != nil {
+		return err
+	}
+	bounds := src.Bounds()
+	draw.Draw(img, image.Rectangle{Min: offset, Max: offset.Add(bounds.Size())}, src, bounds.Min, draw.Over)
+	return nil
+}
+
+// DataURI returns the final rendered data as a base64 encoded data URI, with the MIME type matching the output format.
+// The result can be used directly as an HTML img src.
+func (p *Painter) DataURI() (string, error) {
+	data, err := p.Bytes()
+	if err != nil {
+		return "", err
+	}
+	var mimeType string
+	switch p.outputFormat {
+	case ChartOutputSVG:
+		mimeType = "image/svg+xml"
+	case ChartOutputJPG:
+		mimeType = "image/jpeg"
+	default:
+		mimeType = "image/png"
+	}
+	return "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// countingWriter tracks the number of bytes written to the wrapped writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
+
 // moveTo sets the current path cursor to a given point.
 func (p *Painter) moveTo(x, y int) {
 	p.render.MoveTo(x+p.box.Left, y+p.box.Top)
@@ -325,6 +488,69 @@ func eachPointSegment(points []Point, render func(segment []Point)) {
 	render(valid)
 }
 
+// simplifyPoints reduces the points of a polyline using the Ramer-Douglas-Peucker algorithm, removing points
+// which deviate less than tolerance pixels from the simplified path. Null break markers are preserved, with each
+// contiguous segment simplified independently.
+func simplifyPoints(points []Point, tolerance float64) []Point {
+	if tolerance <= 0 || len(points) < 3 {
+		return points
+	}
+	result := make([]Point, 0, len(points))
+	start := 0
+	for i := 0; i <= len(points); i++ {
+		if i < len(points) && points[i].Y != math.MaxInt32 {
+			continue
+		}
+		if segment := points[start:i]; len(segment) > 0 {
+			keep := make([]bool, len(segment))
+			keep[0], keep[len(segment)-1] = true, true
+			markSimplifiedPoints(segment, 0, len(segment)-1, tolerance, keep)
+			for j, pt := range segment {
+				if keep[j] {
+					result = append(result, pt)
+				}
+			}
+		}
+		if i < len(points) {
+			result = append(result, points[i]) // retain the break marker
+		}
+		start = i + 1
+	}
+	return result
+}
+
+// markSimplifiedPoints recursively marks the points between first and last that must be kept to remain within
+// tolerance of the simplified line.
+func markSimplifiedPoints(points []Point, first, last int, tolerance float64, keep []bool) {
+	if last-first < 2 {
+		return
+	}
+	x1, y1 := float64(points[first].X), float64(points[first].Y)
+	x2, y2 := float64(points[last].X), float64(points[last].Y)
+	dx, dy := x2-x1, y2-y1
+	length := math.Hypot(dx, dy)
+	maxDist := -1.0
+	maxIndex := first
+	for i := first + 1; i < last; i++ {
+		px, py := float64(points[i].X), float64(points[i].Y)
+		var dist float64
+		if length == 0 {
+			dist = math.Hypot(px-x1, py-y1)
+		} else {
+			dist = math.Abs(dy*px-dx*py+x2*y1-y2*x1) / length
+		}
+		if dist > maxDist {
+			maxDist = dist
+			maxIndex = i
+		}
+	}
+	if maxDist > tolerance {
+		keep[maxIndex] = true
+		markSimplifiedPoints(points, first, maxIndex, tolerance, keep)
+		markSimplifiedPoints(points, maxIndex, last, tolerance, keep)
+	}
+}
+
 // drawStraightPath draws a simple (non-curved) path for the given points.
 // If dotForSinglePoint is true, single points are drawn as 2 px radius dots.
 func (p *Painter) drawStraightPath(points []Point, dotForSinglePoint bool) {
@@ -665,6 +891,66 @@ func (p *Painter) FillArea(points []Point, fillColor Color) {
 	})
 }
 
+// gradientBandHeight is the pixel height of each solid band used to approximate gradients on raster output.
+const gradientBandHeight = 2
+
+// fillAreaVerticalGradient fills the polygon with a vertical gradient from the scale High color at the top y position
+// to the Low color at the bottom y position. SVG output uses a native linear gradient, while raster output
+// approximates the gradient with horizontal bands of solid color.
+func (p *Painter) fillAreaVerticalGradient(points []Point, top, bottom int, scale ColorScale) {
+	if len(points) < 3 || bottom <= top {
+		return
+	}
+	if gr, ok := p.render.(chartdraw.GradientRenderer); ok {
+		stopCount := 2
+		if scale.Interpolation == ColorInterpolationHSL {
+			stopCount = 9 // linear svg interpolation between stops approximates the hsl path
+		}
+		stops := make([]chartdraw.GradientStop, stopCount)
+		for i := range stops {
+			offset := float64(i) / float64(stopCount-1)
+			stops[i] = chartdraw.GradientStop{Offset: offset, Color: scale.ColorAt(1 - offset)}
+		}
+		p.drawStraightPath(points, false)
+		gr.FillLinearGradient(p.box.Left, top+p.box.Top, p.box.Left, bottom+p.box.Top, stops)
+		return
+	}
+	for y := top; y < bottom; y += gradientBandHeight {
+		bandBottom := min(y+gradientBandHeight, bottom)
+		band := clipPolygonY(points, y, bandBottom)
+		if len(band) < 3 {
+			continue
+		}
+		factor := (float64(y+bandBottom)/2 - float64(top)) / float64(bottom-top)
+		p.FillArea(band, scale.ColorAt(1-factor))
+	}
+}
+
+// clipPolygonY returns the polygon clipped to the horizontal band between minY and maxY.
+func clipPolygonY(points []Point, minY, maxY int) []Point {
+	clip := func(points []Point, y int, keepBelow bool) []Point {
+		inside := func(pt Point) bool {
+			if keepBelow {
+				return pt.Y >= y
+			}
+			return pt.Y <= y
+		}
+		result := make([]Point, 0, len(points)+2)
+		for i, cur := range points {
+			prev := points[(i+len(points)-1)%len(points)]
+			if inside(cur) != inside(prev) {
+				ratio := float64(y-prev.Y) / float64(cur.Y-prev.Y)
+				result = append(result, Point{X: prev.X + int(math.Round(ratio*float64(cur.X-prev.X))), Y: y})
+			}
+			if inside(cur) {
+				result = append(result, cur)
+			}
+		}
+		return result
+	}
+	return clip(clip(points, minY, true), maxY, false)
+}
+
 // smoothFillChartArea draws a smooth curve for the "top" portion of points but uses straight lines for
 // the bottom corners, producing a fill with sharp corners.
 func (p *Painter) smoothFillChartArea(points []Point, tension float64, fillColor Color) {
@@ -726,6 +1012,11 @@ func (p *Painter) smoothFillChartArea(points []Point, tension float64, fillColor
 // Text draws the given string at the specified position using the given font style.
 // Specifying radians rotates the text.
 func (p *Painter) Text(body string, x, y int, radians float64, fontStyle FontStyle) {
+	p.textWithTitle(body, "", x, y, radians, fontStyle)
+}
+
+// textWithTitle draws text like Text, attaching a title for renderers which support it (displayed on hover in SVG).
+func (p *Painter) textWithTitle(body, title string, x, y int, radians float64, fontStyle FontStyle) {
 	if fontStyle.Font == nil {
 		fontStyle.Font = getPreferredFont(p.font)
 	}
@@ -738,7 +1029,11 @@ func (p *Painter) Text(body string, x, y int, radians float64, fontStyle FontSty
 		defer p.render.ClearTextRotation()
 		p.render.SetTextRotation(radians)
 	}
-	p.render.Text(body, x+p.box.Left, y+p.box.Top)
+	if titled, ok := p.render.(chartdraw.TitledTextRenderer); ok && title != "" {
+		titled.TextWithTitle(body, title, x+p.box.Left, y+p.box.Top)
+	} else {
+		p.render.Text(body, x+p.box.Left, y+p.box.Top)
+	}
 }
 
 // TextFit draws multi-line text constrained to a given width.
@@ -815,13 +1110,15 @@ func (p *Painter) ticks(opt ticksOption) {
 		return
 	}
 	var values []int
-	if opt.vertical {
+	if len(opt.positions) > 0 {
+		values = opt.positions
+	} else if opt.vertical {
 		values = autoDivide(p.Height(), opt.tickSpaces)
 	} else {
 		values = autoDivide(p.Width(), opt.tickSpaces)
 	}
 	for index, value := range values {
-		if !isTick(len(values), opt.tickCount, index) {
+		if len(opt.positions) == 0 && !isTick(len(values), opt.tickCount, index) {
 			continue
 		}
 		if opt.vertical {
@@ -894,14 +1191,18 @@ func (p *Painter) multiText(opt multiTextOption) {
 			} else {
 				start = positions[index]
 			}
-			y = start + (box.Height() >> 1)
-			switch opt.align {
-			case AlignRight:
-				x = width - box.Width()
-			case AlignCenter:
-				x = width - (box.Width() >> 1)
-			default:
-				x = 0
+			if opt.textRotation != 0 {
+				x, y = rotatedVerticalLabelPosition(p, text, start, width, height, opt)
+			} else {
+				y = start + (box.Height() >> 1)
+				switch opt.align {
+				case AlignRight:
+					x = width - box.Width()
+				case AlignCenter:
+					x = width - (box.Width() >> 1)
+				default:
+					x = 0
+				}
 			}
 		} else {
 			if opt.centerLabels {
@@ -931,6 +1232,39 @@ func (p *Painter) multiText(opt multiTextOption) {
 	}
 }
 
+// rotatedVerticalLabelPosition returns the text anchor for a rotated label on a vertical axis. Text rotates around
+// its baseline start, so the anchor is offset by the rotated corner extents to vertically center the label on the
+// tick, kept within the axis height, and place its nearest edge against the aligned side.
+func rotatedVerticalLabelPosition(p *Painter, text string, tickY, width, height int,
+	opt multiTextOption) (int, int) {
+	flat := p.MeasureText(text, 0, opt.fontStyle)
+	minX, maxX, minY, maxY := rotatedTextExtents(flat.Width(), flat.Height(), opt.textRotation)
+
+	y := tickY - int(math.Round((minY+maxY)/2))
+	y = max(min(y, height-int(math.Round(maxY))), -int(math.Round(minY)))
+	var x int
+	switch opt.align {
+	case AlignRight:
+		x = width - int(math.Round(maxX))
+	case AlignCenter:
+		x = width - int(math.Round((minX+maxX)/2))
+	default:
+		x = -int(math.Round(minX))
+	}
+	return x, y
+}
+
+// rotatedTextExtents returns the bounds of text with the given unrotated size after rotating it around its baseline
+// start, relative to that anchor.
+func rotatedTextExtents(width, height int, radians float64) (minX, maxX, minY, maxY float64) {
+	w, h := float64(width), float64(height)
+	sin, cos := math.Sincos(radians)
+	// corners relative to the anchor: baseline start and end, then the top of each (text up is (sin, -cos))
+	xs := []float64{0, w * cos, h * sin, w*cos + h*sin}
+	ys := []float64{0, w * sin, -h * cos, w*sin - h*cos}
+	return slices.Min(xs), slices.Max(xs), slices.Min(ys), slices.Max(ys)
+}
+
 // textRotationHeightAdjustment calculates how much vertical adjustment is needed
 // after rotating the text around the bottom-right corner.
 //
@@ -1012,6 +1346,50 @@ func (p *Painter) diamonds(points []Point, fillColor, strokeColor Color, strokeW
 	p.render.FillStroke()
 }
 
+// triangles prints filled upward pointing triangles for the given points.
+// Points with a Y of math.MaxInt32 are skipped.
+func (p *Painter) triangles(points []Point, fillColor, strokeColor Color, strokeWidth float64, size int) {
+	defer p.render.ResetStyle()
+	p.render.SetFillColor(fillColor)
+	p.render.SetStrokeColor(strokeColor)
+	p.render.SetStrokeWidth(strokeWidth)
+	for _, item := range points {
+		if item.Y == math.MaxInt32 {
+			continue
+		}
+		p.triangleMoveLine(item.X, item.Y, size)
+	}
+	p.render.FillStroke()
+}
+
+func (p *Painter) triangleMoveLine(cx, cy, size int) {
+	half := size / 2
+	p.moveTo(cx, cy-half)
+	p.lineTo(cx+half, cy+half)
+	p.lineTo(cx-half, cy+half)
+	p.lineTo(cx, cy-half)
+}
+
+// crosses prints plus shaped crosses for the given points.
+// Points with a Y of math.MaxInt32 are skipped.
+func (p *Painter) crosses(points []Point, strokeColor Color, strokeWidth float64, size int) {
+	for _, item := range points {
+		if item.Y == math.MaxInt32 {
+			continue
+		}
+		p.crossMoveLine(item.X, item.Y, size)
+	}
+	p.stroke(strokeColor, strokeWidth)
+}
+
+func (p *Painter) crossMoveLine(cx, cy, size int) {
+	half := size / 2
+	p.moveTo(cx-half, cy)
+	p.lineTo(cx+half, cy)
+	p.moveTo(cx, cy-half)
+	p.lineTo(cx, cy+half)
+}
+
 const (
 	roundTopLeft = 1 << iota
 	roundTopRight
@@ -1168,6 +1546,19 @@ func (p *Painter) CandlestickChart(opt CandlestickChartOption) error {
 	return err
 }
 
+// OHLCBarChart renders an OHLC bar chart with the provided configuration to the painter.
+func (p *Painter) OHLCBarChart(opt OHLCBarChartOption) error {
+	_, err := newCandlestickChart(p, opt.candlestickOption()).Render()
+	return err
+}
+
+// RenkoChart renders a Renko brick chart derived from the OHLC close prices with the provided configuration to the
+// painter.
+func (p *Painter) RenkoChart(opt RenkoChartOption) error {
+	_, err := newCandlestickChart(p, opt.candlestickOption()).Render()
+	return err
+}
+
 // ViolinChart renders a violin chart with the provided configuration to the painter.
 func (p *Painter) ViolinChart(opt ViolinChartOption) error {
 	_, err := newViolinChart(p, opt).Render()
@@ -2,15 +2,21 @@ package charts
 
 import (
 	"bytes"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"image"
 	"image/color"
+	"image/jpeg"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/go-analyze/charts/chartdraw"
 )
 
 func TestPainterOption(t *testing.T) {
@@ -56,6 +62,36 @@ func TestPainterOption(t *testing.T) {
 
 		assert.Equal(t, font, p.font)
 	})
+	t.Run("aspect_ratio_width", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 1600, AspectRatio: 16.0 / 9.0})
+
+		assert.Equal(t, 1600, p.Width())
+		assert.Equal(t, 900, p.Height())
+	})
+	t.Run("aspect_ratio_height", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Height: 300, AspectRatio: 2})
+
+		assert.Equal(t, 600, p.Width())
+		assert.Equal(t, 300, p.Height())
+	})
+	t.Run("aspect_ratio_default_width", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, AspectRatio: 4.0 / 3.0})
+
+		assert.Equal(t, defaultChartWidth, p.Width())
+		assert.Equal(t, 450, p.Height())
+	})
+	t.Run("aspect_ratio_letterbox", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 800, Height: 800, AspectRatio: 2})
+
+		assert.Equal(t, Box{Left: 0, Top: 200, Right: 800, Bottom: 600, IsSet: true}, p.box)
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		assert.Contains(t, string(buf), `viewBox="0 0 800 800"`)
+
+		p = NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 800, Height: 200, AspectRatio: 2})
+
+		assert.Equal(t, Box{Left: 200, Top: 0, Right: 600, Bottom: 200, IsSet: true}, p.box)
+	})
 }
 
 func TestBytesFormat(t *testing.T) {
@@ -95,6 +131,217 @@ func TestBytesFormat(t *testing.T) {
 	}
 }
 
+func TestBytesJPEGQuality(t *testing.T) {
+	t.Parallel()
+
+	render := func(t *testing.T, quality int) []byte {
+		t.Helper()
+
+		p := NewPainter(PainterOptions{
+			OutputFormat: ChartOutputJPG,
+			Width:        300,
+			Height:       200,
+			JPEGQuality:  quality,
+		})
+		require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+		content, err := p.Bytes()
+		require.NoError(t, err)
+		return content
+	}
+
+	t.Run("decode", func(t *testing.T) {
+		img, err := jpeg.Decode(bytes.NewReader(render(t, 75)))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 300, 200), img.Bounds())
+	})
+	t.Run("default", func(t *testing.T) {
+		assert.Equal(t, render(t, 0), render(t, chartdraw.DefaultJPGQuality))
+	})
+	t.Run("clamp", func(t *testing.T) {
+		assert.Equal(t, render(t, 100), render(t, 500))
+		assert.Less(t, len(render(t, 1)), len(render(t, 100)))
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestPainterWriteTo(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{ChartOutputSVG, ChartOutputPNG, ChartOutputJPG} {
+		t.Run(format, func(t *testing.T) {
+			newPainter := func() *Painter {
+				p := NewPainter(PainterOptions{OutputFormat: format, Width: 200, Height: 100})
+				require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+				return p
+			}
+
+			var buf bytes.Buffer
+			n, err := newPainter().WriteTo(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, int64(buf.Len()), n)
+			content, err := newPainter().Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, content, buf.Bytes())
+		})
+	}
+	t.Run("failing_writer", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+		require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+		n, err := p.WriteTo(failingWriter{})
+		require.EqualError(t, err, "write failed")
+		assert.Equal(t, int64(0), n)
+	})
+	t.Run("image_writer", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputPNG, Width: 200, Height: 100})
+		require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+		var w chartdraw.ImageWriter
+		_, err := p.WriteTo(&w)
+		require.NoError(t, err)
+		img, err := w.Image()
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 200, 100), img.Bounds())
+	})
+}
+
+func TestPainterDataURI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		outputFormat string
+		prefix       string
+	}{
+		{outputFormat: ChartOutputSVG, prefix: "data:image/svg+xml;base64,"},
+		{outputFormat: ChartOutputPNG, prefix: "data:image/png;base64,"},
+		{outputFormat: ChartOutputJPG, prefix: "data:image/jpeg;base64,"},
+		{outputFormat: "", prefix: "data:image/png;base64,"},
+	}
+	for _, tc := range tests {
+		t.Run("format_"+tc.outputFormat, func(t *testing.T) {
+			newPainter := func() *Painter {
+				p := NewPainter(PainterOptions{OutputFormat: tc.outputFormat, Width: 200, Height: 100})
+				require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+				return p
+			}
+
+			uri, err := newPainter().DataURI()
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(uri, tc.prefix))
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, tc.prefix))
+			require.NoError(t, err)
+			content, err := newPainter().Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, content, decoded)
+		})
+	}
+}
+
+func TestPainterReset(t *testing.T) {
+	t.Parallel()
+
+	optA := makeBasicCandlestickChartOption()
+	optB := makeMinimalCandlestickChartOption()
+	optB.Title.Text = "Second"
+
+	for _, format := range []string{ChartOutputSVG, ChartOutputPNG, ChartOutputJPG} {
+		t.Run(format, func(t *testing.T) {
+			render := func(p *Painter, opt CandlestickChartOption) []byte {
+				require.NoError(t, p.CandlestickChart(opt))
+				buf, err := p.Bytes()
+				require.NoError(t, err)
+				return buf
+			}
+			newPainter := func() *Painter {
+				return NewPainter(PainterOptions{OutputFormat: format, Width: 600, Height: 400})
+			}
+			expectedA := render(newPainter(), optA)
+			expectedB := render(newPainter(), optB)
+
+			p := newPainter()
+			bufA := render(p, optA)
+			p.Reset()
+			bufB := render(p, optB)
+			p.Reset()
+			bufA2 := render(p, optA)
+
+			assert.Equal(t, expectedA, bufA)
+			assert.Equal(t, expectedB, bufB)
+			assert.Equal(t, expectedA, bufA2)
+			assert.NotEqual(t, bufA, bufB)
+			assert.Equal(t, 600, p.Width())
+			assert.Equal(t, 400, p.Height())
+		})
+	}
+	t.Run("empty_after_reset", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.CandlestickChart(optA))
+		p.Reset()
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 600 400"></svg>`,
+			string(buf))
+	})
+}
+
+func TestPainterDrawOnto(t *testing.T) {
+	t.Parallel()
+
+	marker := color.RGBA{R: 1, G: 2, B: 3, A: 255}
+	newCanvas := func() *image.RGBA {
+		img := image.NewRGBA(image.Rect(0, 0, 400, 120))
+		for i := 0; i < len(img.Pix); i += 4 {
+			img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = marker.R, marker.G, marker.B, marker.A
+		}
+		return img
+	}
+	renderChart := func(theme string) *Painter {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputPNG, Width: 200, Height: 100})
+		opt := makeFullLineChartStackedOption()
+		opt.Theme = GetTheme(theme)
+		require.NoError(t, p.LineChart(opt))
+		return p
+	}
+	backgroundRGBA := func(theme string) color.RGBA {
+		bg := GetTheme(theme).GetBackgroundColor()
+		return color.RGBA{R: bg.R, G: bg.G, B: bg.B, A: bg.A}
+	}
+
+	t.Run("side_by_side", func(t *testing.T) {
+		img := newCanvas()
+		require.NoError(t, renderChart(ThemeLight).DrawOnto(img, image.Point{}))
+		require.NoError(t, renderChart(ThemeDark).DrawOnto(img, image.Pt(200, 0)))
+
+		assert.Equal(t, backgroundRGBA(ThemeLight), img.RGBAAt(2, 2))
+		assert.Equal(t, backgroundRGBA(ThemeLight), img.RGBAAt(199, 99))
+		assert.Equal(t, backgroundRGBA(ThemeDark), img.RGBAAt(200, 0))
+		assert.Equal(t, backgroundRGBA(ThemeDark), img.RGBAAt(399, 99))
+		// rows below the charts are untouched
+		assert.Equal(t, marker, img.RGBAAt(0, 100))
+		assert.Equal(t, marker, img.RGBAAt(399, 119))
+	})
+	t.Run("clipped", func(t *testing.T) {
+		img := newCanvas()
+		require.NoError(t, renderChart(ThemeDark).DrawOnto(img, image.Pt(300, 60)))
+
+		assert.Equal(t, marker, img.RGBAAt(299, 60))
+		assert.Equal(t, marker, img.RGBAAt(300, 59))
+		assert.Equal(t, backgroundRGBA(ThemeDark), img.RGBAAt(300, 60))
+		assert.Equal(t, backgroundRGBA(ThemeDark), img.RGBAAt(301, 61))
+	})
+	t.Run("svg_error", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 200, Height: 100})
+		require.NoError(t, p.LineChart(makeFullLineChartStackedOption()))
+		require.Error(t, p.DrawOnto(newCanvas(), image.Point{}))
+	})
+	t.Run("nil_image", func(t *testing.T) {
+		require.Error(t, renderChart(ThemeLight).DrawOnto(nil, image.Point{}))
+	})
+}
+
 func TestBytesCompareRenderedOutputs(t *testing.T) {
 	t.Parallel()
 
@@ -646,6 +893,47 @@ func TestPainterSymbolsSkipBreakPoints(t *testing.T) {
 	}
 }
 
+func TestClipPolygonY(t *testing.T) {
+	t.Parallel()
+
+	triangle := []Point{{X: 0, Y: 100}, {X: 50, Y: 0}, {X: 100, Y: 100}}
+
+	t.Run("band", func(t *testing.T) {
+		assert.Equal(t, []Point{{X: 13, Y: 75}, {X: 25, Y: 50}, {X: 75, Y: 50}, {X: 88, Y: 75}},
+			clipPolygonY(triangle, 50, 75))
+	})
+	t.Run("contains", func(t *testing.T) {
+		assert.Equal(t, triangle, clipPolygonY(triangle, -10, 110))
+	})
+	t.Run("outside", func(t *testing.T) {
+		assert.Empty(t, clipPolygonY(triangle, 120, 140))
+	})
+}
+
+func TestSimplifyPoints(t *testing.T) {
+	t.Parallel()
+
+	t.Run("collinear", func(t *testing.T) {
+		points := []Point{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 20, Y: 20}, {X: 30, Y: 30}}
+		assert.Equal(t, []Point{{X: 0, Y: 0}, {X: 30, Y: 30}}, simplifyPoints(points, 0.5))
+	})
+	t.Run("keeps_peak", func(t *testing.T) {
+		points := []Point{{X: 0, Y: 0}, {X: 10, Y: 11}, {X: 20, Y: 20}, {X: 30, Y: 9}, {X: 40, Y: 0}}
+		assert.Equal(t, []Point{{X: 0, Y: 0}, {X: 20, Y: 20}, {X: 40, Y: 0}}, simplifyPoints(points, 2))
+	})
+	t.Run("break_markers", func(t *testing.T) {
+		points := []Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 20, Y: 0},
+			{X: 30, Y: math.MaxInt32}, {X: 40, Y: 5}, {X: 50, Y: 5}, {X: 60, Y: 5}}
+		expected := []Point{{X: 0, Y: 0}, {X: 20, Y: 0},
+			{X: 30, Y: math.MaxInt32}, {X: 40, Y: 5}, {X: 60, Y: 5}}
+		assert.Equal(t, expected, simplifyPoints(points, 1))
+	})
+	t.Run("disabled", func(t *testing.T) {
+		points := []Point{{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 20, Y: 20}}
+		assert.Equal(t, points, simplifyPoints(points, 0))
+	})
+}
+
 func TestPainterMeasureText(t *testing.T) {
 	t.Parallel()
 
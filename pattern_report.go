@@ -0,0 +1,70 @@
+package charts
+
+import (
+	"encoding/csv"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// PatternReport summarizes the candlestick patterns detected in a data series, for analysis without rendering a
+// chart. The report can be marshaled to JSON directly, or written as CSV using WriteCSV.
+type PatternReport struct {
+	// Counts is the number of occurrences of each pattern type, keyed by the PatternType identifier.
+	Counts map[string]int
+	// Occurrences lists every detected pattern ordered by data index.
+	Occurrences []PatternDetectionResult
+}
+
+// BuildPatternReport scans the data for the patterns enabled in the config and aggregates the results. Detection
+// matches ScanCandlestickPatterns, including DirectionFilter, MinConfidence, and PreHistory.
+func BuildPatternReport(data []OHLCData, cfg CandlestickPatternConfig) PatternReport {
+	patternMap := scanForCandlestickPatterns(data, cfg)
+	report := PatternReport{Counts: make(map[string]int)}
+	for _, index := range slices.Sorted(maps.Keys(patternMap)) {
+		report.Occurrences = append(report.Occurrences, patternMap[index]...)
+	}
+	for _, occurrence := range report.Occurrences {
+		report.Counts[occurrence.PatternType]++
+	}
+	return report
+}
+
+// Filter returns a new report containing only the occurrences with a confidence of at least minConfidence, with the
+// counts recomputed.
+func (r PatternReport) Filter(minConfidence float64) PatternReport {
+	result := PatternReport{Counts: make(map[string]int)}
+	for _, occurrence := range r.Occurrences {
+		if occurrence.Confidence >= minConfidence {
+			result.Occurrences = append(result.Occurrences, occurrence)
+			result.Counts[occurrence.PatternType]++
+		}
+	}
+	return result
+}
+
+// WriteCSV writes one row per occurrence, preceded by a header row, with the columns index, pattern_type,
+// pattern_name, confidence, partial, and anchor_price.
+func (r PatternReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"index", "pattern_type", "pattern_name", "confidence", "partial", "anchor_price",
+	}); err != nil {
+		return err
+	}
+	for _, occurrence := range r.Occurrences {
+		if err := writer.Write([]string{
+			strconv.Itoa(occurrence.Index),
+			occurrence.PatternType,
+			occurrence.PatternName,
+			strconv.FormatFloat(occurrence.Confidence, 'f', -1, 64),
+			strconv.FormatBool(occurrence.Partial),
+			strconv.FormatFloat(occurrence.Anchor.Price, 'f', -1, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
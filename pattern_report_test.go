@@ -0,0 +1,88 @@
+package charts
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPatternReport(t *testing.T) {
+	t.Parallel()
+
+	data := makeComprehensivePatternData()
+	cfg := (&CandlestickPatternConfig{}).WithPatternsAll().WithDojiThreshold(0.01).WithEngulfingMinSize(0.8)
+	report := BuildPatternReport(data, *cfg)
+
+	t.Run("counts", func(t *testing.T) {
+		patterns := ScanCandlestickPatterns(data, *cfg)
+		expectedCounts := make(map[string]int)
+		var expectedTotal int
+		for _, results := range patterns {
+			for _, r := range results {
+				expectedCounts[r.PatternType]++
+				expectedTotal++
+			}
+		}
+		require.NotEmpty(t, expectedCounts)
+		assert.Equal(t, expectedCounts, report.Counts)
+		assert.Len(t, report.Occurrences, expectedTotal)
+		for i := 1; i < len(report.Occurrences); i++ {
+			assert.LessOrEqual(t, report.Occurrences[i-1].Index, report.Occurrences[i].Index)
+		}
+	})
+	t.Run("filter", func(t *testing.T) {
+		const minConfidence = 0.75
+		filtered := report.Filter(minConfidence)
+		var total int
+		for _, r := range report.Occurrences {
+			if r.Confidence >= minConfidence {
+				total++
+			}
+		}
+		assert.Len(t, filtered.Occurrences, total)
+		var countTotal int
+		for _, count := range filtered.Counts {
+			countTotal += count
+		}
+		assert.Equal(t, total, countTotal)
+		for _, r := range filtered.Occurrences {
+			assert.GreaterOrEqual(t, r.Confidence, minConfidence)
+		}
+		assert.Empty(t, report.Filter(1.1).Occurrences)
+		assert.Equal(t, report, report.Filter(0))
+	})
+	t.Run("json_round_trip", func(t *testing.T) {
+		encoded, err := json.Marshal(report)
+		require.NoError(t, err)
+		var decoded PatternReport
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		assert.Equal(t, report, decoded)
+	})
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteCSV(&buf))
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+
+		require.Len(t, records, len(report.Occurrences)+1)
+		assert.Equal(t, []string{"index", "pattern_type", "pattern_name", "confidence", "partial", "anchor_price"},
+			records[0])
+		first := report.Occurrences[0]
+		assert.Equal(t, strconv.Itoa(first.Index), records[1][0])
+		assert.Equal(t, first.PatternType, records[1][1])
+		assert.Equal(t, first.PatternName, records[1][2])
+		confidence, err := strconv.ParseFloat(records[1][3], 64)
+		require.NoError(t, err)
+		assert.InDelta(t, first.Confidence, confidence, 0)
+	})
+	t.Run("no_patterns", func(t *testing.T) {
+		empty := BuildPatternReport(data, CandlestickPatternConfig{})
+		assert.Empty(t, empty.Occurrences)
+		assert.Empty(t, empty.Counts)
+	})
+}
@@ -103,6 +103,25 @@ func newSector(radius float64, index int, value, currentValue, totalValue float6
 	return s
 }
 
+// containsAngle returns true if the angle falls within the sweep of the sector.
+func (s *sector) containsAngle(radians float64) bool {
+	return s.delta >= _2pi || normalizeAngle(radians-s.startAngle) <= s.delta
+}
+
+// sliceColorAt returns the color of the slice drawn under the point, or ColorTransparent when the point is outside
+// the pie.
+func sliceColorAt(sectors []sector, cx, cy, x, y int) Color {
+	dx, dy := float64(x-cx), float64(y-cy)
+	distance := math.Hypot(dx, dy)
+	angle := math.Atan2(dy, dx)
+	for i := range sectors {
+		if distance <= sectors[i].radius && sectors[i].containsAngle(angle) {
+			return sectors[i].color
+		}
+	}
+	return ColorTransparent
+}
+
 // calculateOuterLabelLines computes the basic line positions for an outer label.
 func (s *sector) calculateOuterLabelLines(cx, cy int, outerRadius, labelRadius float64, labelLineLength int) (lineStartX, lineStartY, lineBranchX, lineBranchY, lineEndX, lineEndY int) {
 	lineStartX = cx + int(outerRadius*math.Cos(s.midAngle))
@@ -139,11 +158,10 @@ func (s *sector) calculateAdjustedOuterLabelPosition(cx, cy int, outerRadius, la
 	leY := adjustedBranchY
 
 	// compute text position
-	const textMargin = 3
-	textX = leX + textMargin
+	textX = leX + leaderLineTextMargin
 	textY = leY + (textBox.Height() >> 1) - 1
 	if leX <= cx {
-		textX = leX - textBox.Width() - textMargin
+		textX = leX - textBox.Width() - leaderLineTextMargin
 	}
 	return lsX, lsY, lbX, adjustedBranchY, leX, leY, textX, textY
 }
@@ -274,12 +292,16 @@ func renderPie(p *Painter, cx, cy int, space, radius, total float64, renderLabel
 				prevY = maxY
 			}
 		}
-		fontStyle := fillFontStyleDefaults(s.seriesLabel.FontStyle,
-			defaultLabelFontSize, theme.GetLabelTextColor(), p.font)
+		fontStyle := fillFontStyleDefaults(s.seriesLabel.FontStyle, defaultLabelFontSize, theme.GetLabelTextColor(), p.font)
 		textBox := p.MeasureText(s.label, 0, fontStyle)
 		// for outer labels use the adjusted positions
 		lsX, lsY, lbX, lbY, leX, leY, textX, textY :=
 			s.calculateAdjustedOuterLabelPosition(cx, cy, s.radius, labelRadius, labelLineWidth, prevY, fontStyle.FontSize, textBox)
+		if s.seriesLabel.FontStyle.FontColor.IsZero() {
+			// contrast with the slice when a label extends over the pie
+			fill := sliceColorAt(sectors, cx, cy, textX+(textBox.Width()>>1), textY-(textBox.Height()>>1))
+			fontStyle.FontColor = labelFontColor(s.seriesLabel, s.labelStyle, fill, theme.GetLabelTextColor())
+		}
 		prevY = leY
 		if prevY > maxY {
 			maxY = prevY
@@ -287,11 +309,7 @@ func renderPie(p *Painter, cx, cy int, space, radius, total float64, renderLabel
 		if prevY < minY {
 			minY = prevY
 		}
-		p.moveTo(lsX, lsY)
-		p.lineTo(lbX, lbY)
-		p.moveTo(lbX, lbY)
-		p.lineTo(leX, leY)
-		p.stroke(s.color, 1)
+		labelLeaderLine{startX: lsX, startY: lsY, branchX: lbX, branchY: lbY, endX: leX, endY: leY}.render(p, s.color)
 
 		// Apply label style overrides if present
 		var backgroundColor Color
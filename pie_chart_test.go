@@ -649,6 +649,38 @@ func TestSectorOuterLabelLines(t *testing.T) {
 	assert.Equal(t, 50, leY)
 }
 
+func TestSliceColorAt(t *testing.T) {
+	t.Parallel()
+
+	dark := Color{R: 20, G: 30, B: 60, A: 255}
+	light := Color{R: 250, G: 230, B: 140, A: 255}
+	sectors := []sector{
+		newSector(50, 0, 1, 0, 2, "dark", SeriesLabel{}, dark),   // right half
+		newSector(40, 1, 1, 1, 2, "light", SeriesLabel{}, light), // left half
+	}
+	label := SeriesLabel{AutoContrast: Ptr(true)}
+
+	t.Run("dark_slice", func(t *testing.T) {
+		fill := sliceColorAt(sectors, 100, 100, 130, 110)
+		assert.Equal(t, dark, fill)
+		assert.Equal(t, defaultDarkFontColor, labelFontColor(label, nil, fill, ColorBlack))
+	})
+	t.Run("light_slice", func(t *testing.T) {
+		fill := sliceColorAt(sectors, 100, 100, 70, 90)
+		assert.Equal(t, light, fill)
+		assert.Equal(t, defaultLightFontColor, labelFontColor(label, nil, fill, ColorBlack))
+	})
+	t.Run("outside_pie", func(t *testing.T) {
+		fill := sliceColorAt(sectors, 100, 100, 55, 100) // within the dark radius, beyond the light slice
+		assert.True(t, fill.IsTransparent())
+		assert.Equal(t, ColorBlack, labelFontColor(label, nil, fill, ColorBlack))
+	})
+	t.Run("full_circle", func(t *testing.T) {
+		whole := []sector{newSector(50, 0, 1, 0, 1, "all", SeriesLabel{}, dark)}
+		assert.Equal(t, dark, sliceColorAt(whole, 100, 100, 80, 60))
+	})
+}
+
 func TestSectorAdjustedOuterLabelPosition(t *testing.T) {
 	t.Parallel()
 
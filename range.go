@@ -1,8 +1,10 @@
 package charts
 
 import (
+	"errors"
 	"math"
 	"strconv"
+	"time"
 
 	"github.com/go-analyze/charts/chartdraw/matrix"
 )
@@ -19,16 +21,26 @@ type axisRange struct {
 	// reversed indicates the axis renders its range in reverse order.
 	reversed bool
 	// labels are the rendered labels: 1:1 for categories or range value labels to render.
-	labels         []string
-	tickCount      int
-	divideCount    int
-	labelCount     int
-	min, max       float64 // only valid if !isCategory
+	labels      []string
+	tickCount   int
+	divideCount int
+	labelCount  int
+	min, max    float64 // only valid if !isCategory
+	// logScale maps values by their base 10 logarithm, min and max must be positive.
+	logScale       bool
 	size           int
 	textMaxWidth   int
 	textMaxHeight  int
 	labelRotation  float64
 	labelFontStyle FontStyle
+	// timeValues are the sample times of a time category axis, data is positioned proportionally to time.
+	timeValues []time.Time
+	// timeTicks are the times labeled on a time category axis, 1:1 with labels.
+	timeTicks []time.Time
+	// timeBoundaryGap insets time positions by half a sample slot on each side.
+	timeBoundaryGap bool
+	// timeCompressGaps positions time samples at equal spacing, ignoring calendar gaps between them.
+	timeCompressGaps bool
 }
 
 // valueAxisPrep captures intermediate state between preparation and resolution of a value axis range.
@@ -54,6 +66,56 @@ type valueAxisPrep struct {
 	labelW, labelH int
 }
 
+// roundedRangeBounds returns the min and max config for the axis after applying the padding percent and
+// RangeRounding mode. Explicit min and max values are returned unchanged, the rounded bounds only fill in unset values.
+func roundedRangeBounds(rounding AxisRangeRounding, paddingPercent float64, minCfg, maxCfg *float64,
+	seriesList seriesList, yAxisIndex int, stackSeries bool) (*float64, *float64) {
+	if (rounding.mode == axisRangeRoundNice && paddingPercent <= 0) || (minCfg != nil && maxCfg != nil) {
+		return minCfg, maxCfg
+	}
+	minVal, maxVal, sumMax := getSeriesMinMaxSumMax(seriesList, yAxisIndex, stackSeries)
+	if stackSeries {
+		maxVal = sumMax
+	}
+	minVal, maxVal = expandRangePercent(minVal, maxVal, paddingPercent)
+	if rounding.mode == axisRangeRoundMultiple {
+		m := rounding.multiple
+		minVal = math.Floor(minVal/m) * m
+		maxVal = math.Ceil(maxVal/m) * m
+		if maxVal == minVal {
+			maxVal += m
+		}
+	} else if maxVal == minVal {
+		return minCfg, maxCfg // no span to hug, fall back to the default padding
+	}
+	if minCfg == nil {
+		minCfg = &minVal
+	}
+	if maxCfg == nil {
+		maxCfg = &maxVal
+	}
+	return minCfg, maxCfg
+}
+
+// expandRangePercent widens the range by the percent of its span on each side, without crossing zero.
+func expandRangePercent(minVal, maxVal, percent float64) (float64, float64) {
+	if percent <= 0 || maxVal <= minVal {
+		return minVal, maxVal
+	}
+	padding := (maxVal - minVal) * percent / 100
+	if minVal >= 0 {
+		minVal = max(0, minVal-padding)
+	} else {
+		minVal -= padding
+	}
+	if maxVal <= 0 {
+		maxVal = min(0, maxVal+padding)
+	} else {
+		maxVal += padding
+	}
+	return minVal, maxVal
+}
+
 // prepareValueAxisRange gathers data range and estimates label count, returning intermediate state.
 func prepareValueAxisRange(p *Painter, isVertical bool, axisSize int,
 	minCfg, maxCfg, rangeValuePaddingScale *float64,
@@ -600,6 +662,118 @@ func calculateValueAxisRange(p *Painter, isVertical bool, axisSize int,
 	return finalizeValueAxisRange(p, &prep, minPadded, maxPadded, labelCount)
 }
 
+// calculateLogAxisRange computes a base 10 logarithmic value axis range. The range is extended outward to whole
+// decades (unless Min or Max are set), with labels placed at powers of ten. An error is returned if the axis has no
+// positive values or if a non-positive bound is configured.
+func calculateLogAxisRange(p *Painter, axisSize int,
+	minCfg, maxCfg *float64,
+	labelsCfg []string, labelCountCfg int,
+	seriesList seriesList, yAxisIndex int,
+	valueFormatter ValueFormatter,
+	labelRotation float64, fontStyle FontStyle) (axisRange, error) {
+	if (minCfg != nil && *minCfg <= 0) || (maxCfg != nil && *maxCfg <= 0) {
+		return axisRange{}, errors.New("log axis min and max must be positive")
+	}
+	minVal, maxVal := math.MaxFloat64, -math.MaxFloat64
+	for i := 0; i < seriesList.len(); i++ {
+		series := seriesList.getSeries(i)
+		if series.getYAxisIndex() != yAxisIndex {
+			continue
+		}
+		for _, v := range series.getValues() {
+			if v <= 0 || !isValidExtent(v) {
+				continue // non-positive values are clamped to the axis minimum when rendered
+			}
+			minVal = min(minVal, v)
+			maxVal = max(maxVal, v)
+		}
+	}
+	if minVal > maxVal {
+		if minCfg == nil || maxCfg == nil {
+			return axisRange{}, errors.New("log axis requires positive values")
+		}
+		minVal, maxVal = *minCfg, *maxCfg
+	}
+
+	logMin := math.Floor(math.Log10(minVal))
+	logMax := math.Ceil(math.Log10(maxVal))
+	if minCfg != nil {
+		logMin = math.Log10(*minCfg)
+	}
+	if maxCfg != nil {
+		logMax = math.Log10(*maxCfg)
+	}
+	if logMax <= logMin {
+		if maxCfg != nil {
+			logMin = logMax - 1
+		} else {
+			logMax = logMin + 1
+		}
+	}
+
+	labelCount := labelCountCfg
+	if labelCount < minimumAxisLabels {
+		// one label per decade, stepping over decades when they would not fit the axis
+		decades := ceilFloatToInt(logMax - logMin - matrix.DefaultEpsilon)
+		_, textH := p.measureTextMaxWidthHeight([]string{valueFormatter(math.Pow(10, logMax))}, labelRotation, fontStyle)
+		maxLabelCount := decades + 1
+		if textH > 0 {
+			maxLabelCount = max(axisSize/(textH*2), minimumAxisLabels)
+		}
+		step := 1
+		for decades/step+1 > maxLabelCount {
+			step++
+		}
+		if maxCfg == nil && decades%step != 0 {
+			decades += step - decades%step
+			logMax = logMin + float64(decades)
+		}
+		labelCount = max(ceilFloatToInt(float64(decades)/float64(step))+1, minimumAxisLabels)
+	}
+
+	labels := make([]string, labelCount)
+	offset := (logMax - logMin) / float64(labelCount-1)
+	for i := range labels {
+		if i < len(labelsCfg) {
+			labels[i] = labelsCfg[i]
+		} else {
+			labels[i] = valueFormatter(math.Pow(10, logMin+float64(i)*offset))
+		}
+	}
+	labelW, labelH := p.measureTextMaxWidthHeight(labels, labelRotation, fontStyle)
+
+	return axisRange{
+		labels:         labels,
+		divideCount:    labelCount,
+		tickCount:      labelCount,
+		labelCount:     labelCount,
+		min:            math.Pow(10, logMin),
+		max:            math.Pow(10, logMax),
+		logScale:       true,
+		size:           axisSize,
+		textMaxWidth:   labelW,
+		textMaxHeight:  labelH,
+		labelRotation:  labelRotation,
+		labelFontStyle: fontStyle,
+	}, nil
+}
+
+// logMinorValues returns the values at 2 through 9 times each power of ten within the min and max range.
+func logMinorValues(minVal, maxVal float64) []float64 {
+	if minVal <= 0 || maxVal <= minVal {
+		return nil
+	}
+	var values []float64
+	for decade := math.Pow(10, math.Floor(math.Log10(minVal))); decade < maxVal; decade *= 10 {
+		for m := 2.0; m <= 9; m++ {
+			if v := m * decade; v > minVal && v < maxVal {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
 // calculateCategoryAxisRange does the same for category axes (common for x-axis in line/bar charts).
 func calculateCategoryAxisRange(p *Painter, axisSize int, isVertical bool, extraSpace bool,
 	labels []string,
@@ -960,7 +1134,16 @@ func (r axisRange) getHeight(value float64) int {
 	if r.max <= r.min {
 		return 0
 	}
-	v := (value - r.min) / (r.max - r.min)
+	var v float64
+	if r.logScale {
+		if value <= 0 {
+			return 0 // non-positive values can't be represented, clamp to the axis minimum
+		}
+		logMin := math.Log10(r.min)
+		v = (math.Log10(value) - logMin) / (math.Log10(r.max) - logMin)
+	} else {
+		v = (value - r.min) / (r.max - r.min)
+	}
 	// Clamp the result to valid range to prevent infinite loops with extreme values
 	result := int(v * float64(r.size))
 	if result < 0 {
@@ -975,6 +1158,19 @@ func (r axisRange) getRestHeight(value float64) int {
 	return r.size - r.getHeight(value)
 }
 
+// getRestValue is the inverse of getRestHeight, returning the value at the pixel offset from the top of the axis.
+func (r axisRange) getRestValue(offset int) float64 {
+	if r.size <= 0 {
+		return r.min
+	}
+	v := float64(r.size-offset) / float64(r.size)
+	if r.logScale && r.min > 0 {
+		logMin := math.Log10(r.min)
+		return math.Pow(10, logMin+v*(math.Log10(r.max)-logMin))
+	}
+	return r.min + v*(r.max-r.min)
+}
+
 // valuePosition returns the pixel offset (along the axis) of value, respecting r.reversed.
 // Prefer this over getHeight when drawing at a position rather than measuring a magnitude.
 func (r axisRange) valuePosition(value float64) int {
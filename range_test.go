@@ -1,12 +1,16 @@
 package charts
 
 import (
+	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/go-analyze/charts/chartdraw/matrix"
 )
 
 func newTestRange(size, divideCount int, min, max, minPaddingScale, maxPaddingScale float64) axisRange {
@@ -1343,3 +1347,255 @@ func TestAxisLabelQuality(t *testing.T) {
 		assert.Equal(t, 0, s.friendlyInterval)
 	})
 }
+
+func TestAxisRangeRounding(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{{13.2, 27.5, 41.8, 36.1, 18.9}}
+	tests := []struct {
+		name     string
+		rounding AxisRangeRounding
+		padding  float64
+		min      *float64
+		expMin   float64
+		expMax   float64
+	}{
+		{
+			name:     "nice",
+			rounding: RoundNice,
+			expMin:   10,
+			expMax:   45,
+		},
+		{
+			name:     "data",
+			rounding: RoundToData,
+			expMin:   13.2,
+			expMax:   41.8,
+		},
+		{
+			name:     "multiple",
+			rounding: RoundToMultiple(4),
+			expMin:   12,
+			expMax:   44,
+		},
+		{
+			name:     "multiple_explicit_min",
+			rounding: RoundToMultiple(4),
+			min:      Ptr(0.0),
+			expMin:   0,
+			expMax:   44,
+		},
+		{
+			name:     "invalid_multiple",
+			rounding: RoundToMultiple(-1),
+			expMin:   10,
+			expMax:   45,
+		},
+		{
+			name:     "padding_percent",
+			rounding: RoundNice,
+			padding:  10,
+			expMin:   10.34,
+			expMax:   44.66,
+		},
+		{
+			name:     "padding_percent_data",
+			rounding: RoundToData,
+			padding:  25,
+			expMin:   6.05,
+			expMax:   48.95,
+		},
+		{
+			name:     "padding_percent_multiple",
+			rounding: RoundToMultiple(4),
+			padding:  10,
+			expMin:   8,
+			expMax:   48,
+		},
+		{
+			name:     "padding_percent_explicit_min",
+			rounding: RoundNice,
+			padding:  10,
+			min:      Ptr(5.0),
+			expMin:   5,
+			expMax:   44.66,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i)+"-"+tt.name, func(t *testing.T) {
+			p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+			opt := NewLineChartOptionWithData(values)
+			opt.YAxis[0].RangeRounding = tt.rounding
+			opt.YAxis[0].RangePaddingPercent = tt.padding
+			opt.YAxis[0].Min = tt.min
+
+			result, err := defaultRender(p, defaultRenderOption{
+				theme:          opt.Theme,
+				padding:        opt.Padding,
+				seriesList:     opt.SeriesList,
+				categoryAxis:   &opt.XAxis,
+				valueAxis:      opt.YAxis,
+				legend:         &opt.Legend,
+				valueFormatter: opt.ValueFormatter,
+			})
+			require.NoError(t, err)
+			r := result.valueAxisRanges[0]
+			assert.InDelta(t, tt.expMin, r.min, matrix.DefaultEpsilon)
+			assert.InDelta(t, tt.expMax, r.max, matrix.DefaultEpsilon)
+		})
+	}
+
+	t.Run("flat_data", func(t *testing.T) {
+		minCfg, maxCfg := roundedRangeBounds(RoundToData, 0, nil, nil,
+			NewSeriesListLine([][]float64{{5, 5, 5}}), 0, false)
+		assert.Nil(t, minCfg)
+		assert.Nil(t, maxCfg)
+		minCfg, maxCfg = roundedRangeBounds(RoundToMultiple(2), 0, nil, nil,
+			NewSeriesListLine([][]float64{{5, 5, 5}}), 0, false)
+		require.NotNil(t, minCfg)
+		require.NotNil(t, maxCfg)
+		assert.InDelta(t, 4.0, *minCfg, matrix.DefaultEpsilon)
+		assert.InDelta(t, 6.0, *maxCfg, matrix.DefaultEpsilon)
+	})
+	t.Run("candlestick_padding", func(t *testing.T) {
+		seriesList := NewCandlestickOptionWithData([]OHLCData{
+			{Open: 100, High: 120, Low: 90, Close: 110},
+			{Open: 110, High: 130, Low: 105, Close: 108},
+		}).SeriesList
+		// the padded range is derived from the lowest low and highest high
+		minCfg, maxCfg := roundedRangeBounds(RoundNice, 10, nil, nil, seriesList, 0, false)
+		require.NotNil(t, minCfg)
+		require.NotNil(t, maxCfg)
+		assert.InDelta(t, 86.0, *minCfg, matrix.DefaultEpsilon)
+		assert.InDelta(t, 134.0, *maxCfg, matrix.DefaultEpsilon)
+	})
+}
+
+func TestExpandRangePercent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		min, max       float64
+		percent        float64
+		expMin, expMax float64
+	}{
+		{"disabled", 10, 20, 0, 10, 20},
+		{"positive", 10, 20, 10, 9, 21},
+		{"negative", -20, -10, 10, -21, -9},
+		{"spanning_zero", -10, 10, 5, -11, 11},
+		{"positive_clamped", 1, 11, 50, 0, 16},
+		{"negative_clamped", -11, -1, 50, -16, 0},
+		{"flat", 5, 5, 10, 5, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minVal, maxVal := expandRangePercent(tt.min, tt.max, tt.percent)
+			assert.InDelta(t, tt.expMin, minVal, matrix.DefaultEpsilon)
+			assert.InDelta(t, tt.expMax, maxVal, matrix.DefaultEpsilon)
+		})
+	}
+}
+
+func TestCalculateLogAxisRange(t *testing.T) {
+	t.Parallel()
+
+	fontStyle := fillFontStyleDefaults(FontStyle{}, defaultFontSize, ColorBlack)
+	calc := func(minCfg, maxCfg *float64, seriesList seriesList) (axisRange, error) {
+		return calculateLogAxisRange(NewPainter(PainterOptions{}), 300, minCfg, maxCfg,
+			nil, 0, seriesList, 0, defaultValueFormatter, 0, fontStyle)
+	}
+
+	t.Run("decades", func(t *testing.T) {
+		r, err := calc(nil, nil, NewSeriesListScatter([][]float64{{12, 90, 820}}))
+		require.NoError(t, err)
+		assert.True(t, r.logScale)
+		assert.InDelta(t, 10.0, r.min, matrix.DefaultEpsilon)
+		assert.InDelta(t, 1000.0, r.max, matrix.DefaultEpsilon)
+		assert.Equal(t, []string{"10", "100", "1k"}, r.labels)
+		assert.Equal(t, 0, r.getHeight(10))
+		assert.Equal(t, 150, r.getHeight(100))
+		assert.Equal(t, 300, r.getHeight(1000))
+	})
+	t.Run("non_positive_skipped", func(t *testing.T) {
+		r, err := calc(nil, nil, NewSeriesListScatter([][]float64{{0, -5, 3, 40}}))
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, r.min, matrix.DefaultEpsilon)
+		assert.InDelta(t, 100.0, r.max, matrix.DefaultEpsilon)
+		assert.Equal(t, 0, r.getHeight(0))
+		assert.Equal(t, 0, r.getHeight(-5))
+	})
+	t.Run("single_decade", func(t *testing.T) {
+		r, err := calc(nil, nil, NewSeriesListScatter([][]float64{{100, 100}}))
+		require.NoError(t, err)
+		assert.InDelta(t, 100.0, r.min, matrix.DefaultEpsilon)
+		assert.InDelta(t, 1000.0, r.max, matrix.DefaultEpsilon)
+	})
+	t.Run("many_decades_step", func(t *testing.T) {
+		r, err := calc(nil, nil, NewSeriesListScatter([][]float64{{1e-12, 1e12}}))
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(r.labels), 300/(r.textMaxHeight*2)+1)
+		assert.GreaterOrEqual(t, r.max, 1e12)
+	})
+	t.Run("configured_bounds", func(t *testing.T) {
+		r, err := calc(Ptr(1.0), Ptr(10000.0), NewSeriesListScatter([][]float64{{50, 60}}))
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, r.min, matrix.DefaultEpsilon)
+		assert.InDelta(t, 10000.0, r.max, matrix.DefaultEpsilon)
+		assert.Len(t, r.labels, 5)
+	})
+	t.Run("no_positive_values", func(t *testing.T) {
+		_, err := calc(nil, nil, NewSeriesListScatter([][]float64{{0, -1}}))
+		require.Error(t, err)
+	})
+	t.Run("non_positive_min", func(t *testing.T) {
+		_, err := calc(Ptr(0.0), nil, NewSeriesListScatter([][]float64{{1, 10}}))
+		require.Error(t, err)
+	})
+}
+
+func TestLogMinorValues(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []float64{2, 3, 4, 5, 6, 7, 8, 9}, logMinorValues(1, 10))
+	assert.Len(t, logMinorValues(10, 1000), 16)
+	assert.Nil(t, logMinorValues(0, 10))
+}
+
+func TestValueAxisNiceIntervals(t *testing.T) {
+	t.Parallel()
+
+	for _, values := range [][]float64{
+		{47, 94, 141},
+		{-37, 12, 88},
+		{-141, -47},
+		{0.013, 0.047},
+		{3.3, 7.9},
+		{1017, 1093},
+		{1234, 9876},
+	} {
+		t.Run(fmt.Sprint(values), func(t *testing.T) {
+			p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+			opt := NewScatterChartOptionWithData([][]float64{values})
+			result, err := defaultRender(p, defaultRenderOption{
+				theme:        opt.Theme,
+				padding:      opt.Padding,
+				seriesList:   opt.SeriesList,
+				categoryAxis: &opt.XAxis,
+				valueAxis:    opt.YAxis,
+				legend:       &opt.Legend,
+			})
+			require.NoError(t, err)
+			r := result.valueAxisRanges[0]
+			assert.LessOrEqual(t, r.min, slices.Min(values))
+			assert.GreaterOrEqual(t, r.max, slices.Max(values))
+
+			// the interval is 1, 2, or 5 times a power of ten
+			interval := (r.max - r.min) / float64(r.divideCount-1)
+			magnitude := math.Pow(10, math.Floor(math.Log10(interval)))
+			mantissa := math.Round(interval/magnitude*1000) / 1000
+			assert.Contains(t, []float64{1, 2, 5}, mantissa, "interval %v", interval)
+		})
+	}
+}
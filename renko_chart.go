@@ -0,0 +1,164 @@
+package charts
+
+import (
+	"math"
+	"slices"
+)
+
+// RenkoChartOption defines options for rendering Renko charts. Renko charts filter out small price movements by
+// drawing a fixed size brick each time the close moves BoxSize beyond the prior brick, ignoring time. A reversal
+// requires the close to move two boxes, past the opposite edge of the prior brick. Axes and series options behave
+// the same as CandlestickChartOption. Render the chart using Painter.RenkoChart.
+type RenkoChartOption struct {
+	// Theme specifies the colors used for the chart.
+	Theme ColorPalette
+	// Padding specifies the padding around the chart.
+	Padding Box
+	// SeriesList provides the OHLC data the bricks are derived from, only the close prices are used. Typically
+	// constructed using NewSeriesListCandlestick. CandleMetadata and PatternConfig are not used by Renko bricks.
+	SeriesList CandlestickSeriesList
+	// XAxis contains options for the x-axis. When Labels match the length of the first series data, each brick is
+	// labeled with the label of the data point which completed it. TimeValues are not used since bricks are not
+	// spaced by time.
+	XAxis XAxisOption
+	// YAxis contains options for the y-axis. At most two y-axes are supported.
+	YAxis []YAxisOption
+	// Title contains options for rendering the chart title.
+	Title TitleOption
+	// Legend contains options for the data legend.
+	Legend LegendOption
+	// BoxSize is the price movement each brick represents. When zero, one twentieth of the close price range of the
+	// first series is used.
+	BoxSize float64
+	// BrickWidth sets the ratio (0.0–1.0) of the available space each brick spans (default 1.0, bricks touching
+	// corner to corner).
+	BrickWidth float64
+	// InvertColors when true swaps the theme up and down colors, for markets where rising prices are shown in red
+	// and falling prices in green.
+	InvertColors *bool
+	// ValueFormatter formats numeric values.
+	ValueFormatter ValueFormatter
+}
+
+// NewRenkoOptionWithData creates a RenkoChartOption from OHLC data slices.
+func NewRenkoOptionWithData(data ...[]OHLCData) RenkoChartOption {
+	seriesList := make(CandlestickSeriesList, len(data))
+	for i, ohlcData := range data {
+		seriesList[i] = CandlestickSeries{Data: ohlcData}
+	}
+	return NewRenkoOptionWithSeries(seriesList...)
+}
+
+// NewRenkoOptionWithSeries returns an initialized RenkoChartOption with the provided Series.
+func NewRenkoOptionWithSeries(series ...CandlestickSeries) RenkoChartOption {
+	seriesList := CandlestickSeriesList(slices.Clone(series))
+	return RenkoChartOption{
+		SeriesList:     seriesList,
+		Padding:        defaultPadding,
+		Theme:          GetDefaultTheme(),
+		YAxis:          make([]YAxisOption, getSeriesYAxisCount(seriesList)),
+		ValueFormatter: defaultValueFormatter,
+		BrickWidth:     1.0,
+	}
+}
+
+// Renko returns the bricks formed from the data close prices, each represented as an OHLC bar spanning boxSize.
+// A new brick is added when the close moves at least boxSize beyond the last brick, continuing the trend, or two
+// boxes for a reversal. Bars with an invalid close are skipped. Nil is returned when boxSize is not positive.
+func Renko(data []OHLCData, boxSize float64) []OHLCData {
+	bricks, _ := renkoBricks(data, boxSize)
+	return bricks
+}
+
+// renkoBricks returns the Renko bricks along with the index of the data point which completed each brick.
+func renkoBricks(data []OHLCData, boxSize float64) ([]OHLCData, []int) {
+	if boxSize <= 0 || !isValidExtent(boxSize) {
+		return nil, nil
+	}
+	var bricks []OHLCData
+	var sourceIndexes []int
+	var top, bottom float64 // edges of the last brick, equal to the starting price until a brick is formed
+	started := false
+	addBrick := func(open, close float64, index int) {
+		bricks = append(bricks, OHLCData{Open: open, High: max(open, close), Low: min(open, close), Close: close})
+		sourceIndexes = append(sourceIndexes, index)
+		top, bottom = max(open, close), min(open, close)
+	}
+	for i, ohlc := range data {
+		if !validateOHLCClose(ohlc) {
+			continue
+		} else if !started {
+			top, bottom = ohlc.Close, ohlc.Close
+			started = true
+			continue
+		}
+		for ohlc.Close >= top+boxSize {
+			addBrick(top, top+boxSize, i)
+		}
+		for ohlc.Close <= bottom-boxSize {
+			addBrick(bottom, bottom-boxSize, i)
+		}
+	}
+	return bricks, sourceIndexes
+}
+
+// defaultRenkoBoxSize returns one twentieth of the close price range of the data.
+func defaultRenkoBoxSize(data []OHLCData) float64 {
+	minClose, maxClose := math.MaxFloat64, -math.MaxFloat64
+	for _, ohlc := range data {
+		if validateOHLCClose(ohlc) {
+			minClose = min(minClose, ohlc.Close)
+			maxClose = max(maxClose, ohlc.Close)
+		}
+	}
+	if maxClose <= minClose {
+		return 0
+	}
+	return (maxClose - minClose) / 20
+}
+
+// candlestickOption returns the candlestick configuration which renders the Renko bricks.
+func (o RenkoChartOption) candlestickOption() CandlestickChartOption {
+	boxSize := o.BoxSize
+	if boxSize <= 0 && len(o.SeriesList) > 0 {
+		boxSize = defaultRenkoBoxSize(o.SeriesList[0].Data)
+	}
+	brickWidth := o.BrickWidth
+	if brickWidth <= 0 {
+		brickWidth = 1.0
+	}
+
+	xAxis := o.XAxis
+	xAxis.Labels = nil
+	xAxis.TimeValues = nil
+	seriesList := slices.Clone(o.SeriesList)
+	for i := range seriesList {
+		bricks, sourceIndexes := renkoBricks(seriesList[i].Data, boxSize)
+		if i == 0 && len(o.XAxis.Labels) == len(seriesList[i].Data) {
+			xAxis.Labels = make([]string, len(sourceIndexes))
+			for j, sourceIndex := range sourceIndexes {
+				xAxis.Labels[j] = o.XAxis.Labels[sourceIndex]
+			}
+		}
+		seriesList[i].Data = bricks
+		seriesList[i].CandleStyle = CandleStyleFilled
+		seriesList[i].Transform = CandlestickTransformNone
+		seriesList[i].PatternConfig = nil
+		seriesList[i].CandleMetadata = nil
+	}
+
+	return CandlestickChartOption{
+		Theme:          o.Theme,
+		Padding:        o.Padding,
+		SeriesList:     seriesList,
+		XAxis:          xAxis,
+		YAxis:          o.YAxis,
+		Title:          o.Title,
+		Legend:         o.Legend,
+		CandleWidth:    brickWidth,
+		ShowWicks:      Ptr(false),
+		CandleMargin:   Ptr(0.0),
+		InvertColors:   o.InvertColors,
+		ValueFormatter: o.ValueFormatter,
+	}
+}
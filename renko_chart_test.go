@@ -0,0 +1,104 @@
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeRenkoCloses(closes ...float64) []OHLCData {
+	data := make([]OHLCData, len(closes))
+	for i, c := range closes {
+		data[i] = OHLCData{Open: c, High: c, Low: c, Close: c}
+	}
+	return data
+}
+
+func renderRenkoSVG(t *testing.T, opt RenkoChartOption) string {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.RenkoChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	return string(buf)
+}
+
+func TestRenko(t *testing.T) {
+	t.Parallel()
+
+	t.Run("price_path", func(t *testing.T) {
+		// up three boxes, a one box pullback (ignored), a reversal, then a gap through two boxes
+		data := makeRenkoCloses(100, 104, 112, 131, 121, 109, 95, 99, 85)
+		bricks, sourceIndexes := renkoBricks(data, 10)
+
+		assert.Equal(t, []OHLCData{
+			{Open: 100, High: 110, Low: 100, Close: 110},
+			{Open: 110, High: 120, Low: 110, Close: 120},
+			{Open: 120, High: 130, Low: 120, Close: 130},
+			{Open: 120, High: 120, Low: 110, Close: 110},
+			{Open: 110, High: 110, Low: 100, Close: 100},
+			{Open: 100, High: 100, Low: 90, Close: 90},
+		}, bricks)
+		assert.Equal(t, []int{2, 3, 3, 5, 6, 8}, sourceIndexes)
+
+		var up, down int
+		for _, brick := range bricks {
+			if brick.Close > brick.Open {
+				up++
+			} else {
+				down++
+			}
+		}
+		assert.Equal(t, 3, up)
+		assert.Equal(t, 3, down)
+	})
+	t.Run("no_movement", func(t *testing.T) {
+		assert.Empty(t, Renko(makeRenkoCloses(100, 105, 96, 102), 10))
+	})
+	t.Run("invalid_closes_skipped", func(t *testing.T) {
+		data := makeRenkoCloses(100, 100, 115)
+		data[0].Close = GetNullValue()
+		assert.Equal(t, []OHLCData{{Open: 100, High: 110, Low: 100, Close: 110}}, Renko(data, 10))
+	})
+	t.Run("invalid_box_size", func(t *testing.T) {
+		assert.Nil(t, Renko(makeRenkoCloses(100, 150), 0))
+		assert.Nil(t, Renko(makeRenkoCloses(100, 150), -5))
+	})
+}
+
+func TestRenkoChart(t *testing.T) {
+	t.Parallel()
+
+	data := makeRenkoCloses(100, 104, 112, 131, 121, 109, 95, 99, 85)
+	labels := []string{"D1", "D2", "D3", "D4", "D5", "D6", "D7", "D8", "D9"}
+
+	t.Run("basic", func(t *testing.T) {
+		opt := NewRenkoOptionWithData(data)
+		opt.BoxSize = 10
+		opt.XAxis.Labels = labels
+		svg := renderRenkoSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		upColor, downColor := opt.Theme.GetSeriesUpDownColors(0)
+		assert.Equal(t, 3, strings.Count(svg, "fill:"+upColor.String()))
+		assert.Equal(t, 3, strings.Count(svg, "fill:"+downColor.String()))
+		// bricks are labeled by the data point which completed them
+		assert.Contains(t, svg, ">D3</text>")
+		assert.NotContains(t, svg, ">D1</text>")
+	})
+	t.Run("default_box_size", func(t *testing.T) {
+		opt := NewRenkoOptionWithData(data)
+		assert.InDelta(t, 2.3, defaultRenkoBoxSize(data), 0.0001)
+		assert.Len(t, opt.candlestickOption().SeriesList[0].Data, len(Renko(data, 2.3)))
+	})
+	t.Run("option_not_mutated", func(t *testing.T) {
+		opt := NewRenkoOptionWithData(data)
+		opt.XAxis.Labels = labels
+		_ = renderRenkoSVG(t, opt)
+		assert.Equal(t, data, opt.SeriesList[0].Data)
+		assert.Equal(t, labels, opt.XAxis.Labels)
+	})
+}
@@ -1,7 +1,10 @@
 package charts
 
 import (
+	"cmp"
 	"math"
+	"math/rand/v2"
+	"slices"
 )
 
 type scatterChart struct {
@@ -51,16 +54,155 @@ type ScatterChartOption struct {
 	YAxis []YAxisOption
 	// Title contains options for rendering the chart title.
 	Title TitleOption
+	// Footer contains options for a note rendered beneath the chart, such as a data source.
+	Footer FooterOption
 	// Legend contains options for the data legend.
 	Legend LegendOption
 	// Symbol specifies the shape and size for each data point, overridable per series.
 	// Shape defaults to SymbolDot; Size defaults to 2.0.
 	Symbol Symbol
+	// MinRadius is the radius in pixels of the smallest bubble for series with SizeValues. Default is 4.
+	MinRadius float64
+	// MaxRadius is the radius in pixels of the largest bubble for series with SizeValues. Default is 20.
+	MaxRadius float64
+	// ColorScale sets the colors used for series with ColorValues, interpolated from the minimum to the maximum
+	// value. Defaults to a scale from the theme down color to the up color.
+	ColorScale ColorScale
 	// ValueFormatter defines how float values are rendered to strings, notably for numeric axis labels.
 	ValueFormatter ValueFormatter
+	// NullPolicy sets how null values (see GetNullValue) are treated by connecting lines and trend lines. The default
+	// NullPolicyGap breaks lines at nulls. Symbols are never drawn for null values.
+	NullPolicy NullPolicy
 }
 
-const defaultSymbolSize = 2.0
+// NullPolicy selects how null values are treated when drawing connecting lines and fitting trend lines.
+type NullPolicy int
+
+const (
+	// NullPolicyGap skips null values, breaking connecting lines and excluding the values from trend fitting.
+	NullPolicyGap NullPolicy = iota
+	// NullPolicyZero treats null values as zero. The y-axis range is extended to include zero when needed.
+	NullPolicyZero
+	// NullPolicyInterpolate linearly interpolates null values between the surrounding valid values. Leading and
+	// trailing nulls have no surrounding values and remain gaps.
+	NullPolicyInterpolate
+)
+
+// applyNullPolicy returns a copy of the values with nulls replaced according to the policy.
+func applyNullPolicy(values []float64, policy NullPolicy) []float64 {
+	result := slices.Clone(values)
+	switch policy {
+	case NullPolicyZero:
+		for i, v := range result {
+			if !isValidExtent(v) {
+				result[i] = 0
+			}
+		}
+	case NullPolicyInterpolate:
+		prev := -1 // index of the last valid value
+		for i, v := range result {
+			if !isValidExtent(v) {
+				continue
+			}
+			if prev >= 0 && i-prev > 1 {
+				step := (v - result[prev]) / float64(i-prev)
+				for j := prev + 1; j < i; j++ {
+					result[j] = result[prev] + step*float64(j-prev)
+				}
+			}
+			prev = i
+		}
+	}
+	return result
+}
+
+// resolveColorScale returns the configured color scale, filling in theme defaults for unset colors.
+func (o *ScatterChartOption) resolveColorScale() ColorScale {
+	scale := o.ColorScale
+	upColor, downColor := o.Theme.GetSeriesUpDownColors(0)
+	if scale.Low.IsZero() {
+		scale.Low = downColor
+	}
+	if scale.High.IsZero() {
+		scale.High = upColor
+	}
+	return scale
+}
+
+const (
+	defaultSymbolSize      = 2.0
+	defaultBubbleMinRadius = 4.0
+	defaultBubbleMaxRadius = 20.0
+	bubbleLegendRadius     = 7.0
+	bubbleFillAlpha        = 160
+)
+
+// scatterValueRange is the span of a per-point scalar channel (sizes or colors) across all series.
+type scatterValueRange struct {
+	min, max float64
+}
+
+// newScatterValueRange returns the range of the valid values selected from each series.
+func newScatterValueRange(seriesList ScatterSeriesList, values func(ScatterSeries) [][]float64) scatterValueRange {
+	result := scatterValueRange{min: math.MaxFloat64, max: -math.MaxFloat64}
+	for _, series := range seriesList {
+		for _, sample := range values(series) {
+			for _, v := range sample {
+				if isValidExtent(v) {
+					result.min = min(result.min, v)
+					result.max = max(result.max, v)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// factor returns the position of the value within the range (0.0-1.0), or false if the value is null. When all
+// values are equal the factor is 1.0.
+func (r scatterValueRange) factor(v float64) (float64, bool) {
+	if !isValidExtent(v) {
+		return 0, false
+	} else if r.max <= r.min {
+		return 1, true
+	}
+	return (v - r.min) / (r.max - r.min), true
+}
+
+// pointValue returns the channel value at the sample and value index, or a null value if not provided.
+func pointValue(values [][]float64, sampleIndex, valueIndex int) float64 {
+	if sampleIndex < len(values) && valueIndex < len(values[sampleIndex]) {
+		return values[sampleIndex][valueIndex]
+	}
+	return GetNullValue()
+}
+
+// averageStep returns the average distance between the x positions, or the width when there is a single position.
+func averageStep(xValues []int, width int) float64 {
+	if len(xValues) < 2 {
+		return float64(width)
+	}
+	return float64(xValues[len(xValues)-1]-xValues[0]) / float64(len(xValues)-1)
+}
+
+// drawScatterSymbols draws the symbol shape at each of the points.
+func drawScatterSymbols(p *Painter, points []Point, shape SymbolShape, fillColor, color, backgroundColor Color,
+	size float64) {
+	switch shape {
+	case SymbolCircle:
+		p.Dots(points, backgroundColor, color, 1.0, size)
+	case SymbolSquare:
+		p.squares(points, fillColor, color, 1.0, ceilFloatToInt(size*2.0))
+	case SymbolDiamond:
+		p.diamonds(points, fillColor, color, 1.0, ceilFloatToInt(size*2.8))
+	case SymbolTriangle:
+		p.triangles(points, fillColor, color, 1.0, ceilFloatToInt(size*2.4))
+	case SymbolCross:
+		p.crosses(points, color, max(1.0, size/1.5), ceilFloatToInt(size*2.8))
+	default:
+		p.Dots(points, fillColor, color, 1.0, size)
+	}
+}
 
 func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 	p := s.p
@@ -71,15 +213,34 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 	}
 	seriesPainter := result.seriesPainter
 
-	xValues := boundaryGapAxisPositions(seriesPainter.Width(), flagIs(true, opt.XAxis.BoundaryGap),
-		max(getSeriesMaxDataCount(opt.SeriesList), len(opt.XAxis.Labels)))
+	var xValues []int
+	if result.categoryAxisRange.isTimeAxis() {
+		xValues = result.categoryAxisRange.timeDataPositions(seriesPainter.Width())
+	} else {
+		xValues = boundaryGapAxisPositions(seriesPainter.Width(), flagIs(true, opt.XAxis.BoundaryGap),
+			max(getSeriesMaxDataCount(opt.SeriesList), len(opt.XAxis.Labels)))
+	}
 
 	markLinePainter := newMarkLinePainter(seriesPainter)
 	trendLinePainter := newTrendLinePainter(seriesPainter)
 	rendererList := []renderer{markLinePainter, trendLinePainter}
 
 	seriesNames := opt.SeriesList.names()
+	minRadius, maxRadius := opt.MinRadius, opt.MaxRadius
+	if minRadius <= 0 {
+		minRadius = defaultBubbleMinRadius
+	}
+	if maxRadius <= 0 {
+		maxRadius = defaultBubbleMaxRadius
+	}
+	maxRadius = max(minRadius, maxRadius)
+	sizeRange := newScatterValueRange(opt.SeriesList, func(s ScatterSeries) [][]float64 { return s.SizeValues })
+	colorRange := newScatterValueRange(opt.SeriesList, func(s ScatterSeries) [][]float64 { return s.ColorValues })
+	colorScale := opt.resolveColorScale()
 	var points []Point
+	var radii []float64
+	var colors []Color
+	var pointSamples []int // sample index of each point, used to resolve per-point metadata
 	for index, series := range opt.SeriesList {
 		seriesSymbol := series.Symbol
 		if seriesSymbol.Shape == "" {
@@ -109,18 +270,65 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 		} else {
 			points = points[:0]
 		}
+		bubbles := len(series.SizeValues) > 0
+		colorMapped := len(series.ColorValues) > 0
+		linked := len(series.PointLinks) > 0
+		radii = radii[:0]
+		colors = colors[:0]
+		pointSamples = pointSamples[:0]
+		seriesXValues := xValues // x position of the first value of each sample
+		var jitter *rand.Rand
+		var jitterWidth float64
+		if series.Jitter > 0 {
+			seriesXValues = slices.Clone(xValues)
+			jitter = rand.New(rand.NewPCG(series.JitterSeed, uint64(index)))
+			jitterWidth = min(series.Jitter, 1) * averageStep(xValues, seriesPainter.Width())
+		}
+		var errorXValues [][]int // x position of each value, error bars are drawn at their jittered point
+		if len(series.ErrorValues) > 0 {
+			errorXValues = make([][]int, len(series.Values))
+		}
 		for i, sampleValues := range series.Values {
+			if errorXValues != nil {
+				errorXValues[i] = make([]int, len(sampleValues))
+			}
 			allNull := true
-			for _, item := range sampleValues {
+			for j, item := range sampleValues {
+				x := xValues[i]
+				if jitter != nil {
+					x += int(math.Round((jitter.Float64() - 0.5) * jitterWidth))
+					x = min(max(x, 0), seriesPainter.Width())
+					if j == 0 {
+						seriesXValues[i] = x
+					}
+				}
+				if errorXValues != nil {
+					errorXValues[i][j] = x
+				}
 				if !isValidExtent(item) {
 					continue
 				}
 				allNull = false
 				p := Point{
-					X: xValues[i],
+					X: x,
 					Y: yRange.getRestHeight(item),
 				}
 				points = append(points, p)
+				pointSamples = append(pointSamples, i)
+				if bubbles {
+					radius := symbolSize // null or missing sizes fall back to the default size
+					if f, ok := sizeRange.factor(pointValue(series.SizeValues, i, j)); ok {
+						radius = minRadius + (maxRadius-minRadius)*f
+					}
+					radii = append(radii, radius)
+				}
+				if colorMapped {
+					color := seriesColor // null or missing color values fall back to the series color
+					if f, ok := colorRange.factor(pointValue(series.ColorValues, i, j)); ok {
+						color = colorScale.ColorAt(f)
+					}
+					colors = append(colors, color)
+				}
 
 				if labelPainter != nil {
 					labelPainter.Add(labelValue{
@@ -131,22 +339,60 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 					})
 				}
 			}
-			if allNull {
+			if allNull && !bubbles && !colorMapped {
 				points = append(points, Point{X: xValues[i], Y: math.MaxInt32})
+				pointSamples = append(pointSamples, i)
 			}
 		}
 
-		// Draw points
-		switch seriesSymbol.Shape {
-		case SymbolCircle:
-			seriesPainter.Dots(points, opt.Theme.GetBackgroundColor(), seriesColor, 1.0, symbolSize)
-		case SymbolSquare:
-			seriesPainter.squares(points, seriesColor, seriesColor, 1.0, ceilFloatToInt(symbolSize*2.0))
-		case SymbolDiamond:
-			seriesPainter.diamonds(points, seriesColor, seriesColor, 1.0, ceilFloatToInt(symbolSize*2.8))
-		default:
-			seriesPainter.Dots(points, seriesColor, seriesColor, 1.0, symbolSize)
+		var groupAttrs map[string]string
+		if flagIs(true, opt.Legend.SeriesGroups) {
+			groupAttrs = seriesGroupAttributes(index, seriesNames[index])
 		}
+		seriesPainter.withElementGroup(groupAttrs, func() {
+			if flagIs(true, series.ConnectPoints) {
+				s.renderConnectLine(seriesPainter, series, seriesXValues, yRange, seriesColor, opt.NullPolicy)
+			}
+			if len(series.ErrorValues) > 0 {
+				renderErrorBars(seriesPainter, series, errorXValues, yRange, seriesColor, symbolSize)
+			}
+
+			// Draw points
+			if bubbles || colorMapped || linked {
+				order := make([]int, len(points))
+				for i := range order {
+					order[i] = i
+				}
+				if bubbles {
+					// draw the largest bubbles first so smaller bubbles remain visible on top
+					slices.SortStableFunc(order, func(a, b int) int {
+						return cmp.Compare(radii[b], radii[a])
+					})
+				}
+				for _, i := range order {
+					color, size := seriesColor, symbolSize
+					if colorMapped {
+						color = colors[i]
+					}
+					fillColor := color
+					if bubbles {
+						size = radii[i]
+						fillColor = color.WithAlpha(bubbleFillAlpha)
+					}
+					var link string
+					if sample := pointSamples[i]; sample < len(series.PointLinks) {
+						link = series.PointLinks[sample]
+					}
+					seriesPainter.withElementMetadata(nil, link, func() {
+						drawScatterSymbols(seriesPainter, points[i:i+1], seriesSymbol.Shape,
+							fillColor, color, opt.Theme.GetBackgroundColor(), size)
+					})
+				}
+			} else {
+				drawScatterSymbols(seriesPainter, points, seriesSymbol.Shape,
+					seriesColor, seriesColor, opt.Theme.GetBackgroundColor(), symbolSize)
+			}
+		})
 
 		if len(series.MarkLine.Lines) > 0 {
 			markLinePainter.add(markLineRenderOption{
@@ -165,7 +411,7 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 			trendLinePainter.add(trendLineRenderOption{
 				defaultStrokeColor: opt.Theme.GetSeriesTrendColor(seriesThemeIndex),
 				xValues:            xValues,
-				seriesValues:       series.avgValues(),
+				seriesValues:       applyNullPolicy(series.avgValues(), opt.NullPolicy),
 				axisRange:          yRange,
 				trends:             series.TrendLine,
 				dashed:             false, // Default for scatter charts
@@ -179,6 +425,57 @@ func (s *scatterChart) renderChart(result *defaultRenderResult) (Box, error) {
 	return p.box, nil
 }
 
+// renderErrorBars draws a vertical bar with end caps spanning the error range of each value. The xValues provide the
+// x position of each value, indexed the same as the series values.
+func renderErrorBars(seriesPainter *Painter, series ScatterSeries, xValues [][]int, yRange axisRange,
+	color Color, symbolSize float64) {
+	capHalfWidth := int(math.Ceil(symbolSize)) + 2
+	for i, sample := range series.Values {
+		low, high, ok := series.errorRange(i)
+		if !ok || i >= len(xValues) {
+			continue
+		}
+		for j, v := range sample {
+			if !isValidExtent(v) || j >= len(xValues[i]) {
+				continue
+			}
+			x := xValues[i][j]
+			lowY, highY := yRange.getRestHeight(v-low), yRange.getRestHeight(v+high)
+			seriesPainter.LineStroke([]Point{{X: x, Y: lowY}, {X: x, Y: highY}}, color, 1)
+			seriesPainter.LineStroke([]Point{{X: x - capHalfWidth, Y: lowY}, {X: x + capHalfWidth, Y: lowY}}, color, 1)
+			seriesPainter.LineStroke([]Point{{X: x - capHalfWidth, Y: highY}, {X: x + capHalfWidth, Y: highY}}, color, 1)
+		}
+	}
+}
+
+// renderConnectLine strokes a line through the first value of each sample, with null values handled by the policy.
+func (s *scatterChart) renderConnectLine(seriesPainter *Painter, series ScatterSeries, xValues []int,
+	yRange axisRange, seriesColor Color, nullPolicy NullPolicy) {
+	values := applyNullPolicy(series.connectValues(), nullPolicy)
+	points := make([]Point, len(values))
+	for i, v := range values {
+		if !isValidExtent(v) {
+			points[i] = Point{X: xValues[i], Y: math.MaxInt32} // break the line
+		} else {
+			points[i] = Point{X: xValues[i], Y: yRange.getRestHeight(v)}
+		}
+	}
+	style := series.ConnectStyle
+	color := style.LineColor
+	if color.IsZero() {
+		color = seriesColor
+	}
+	strokeWidth := style.LineStrokeWidth
+	if strokeWidth <= 0 {
+		strokeWidth = defaultStrokeWidth
+	}
+	if flagIs(true, style.DashedLine) {
+		seriesPainter.DashedLineStroke(points, color, strokeWidth, scaledDashArray(seriesPainter))
+	} else {
+		seriesPainter.LineStroke(points, color, strokeWidth)
+	}
+}
+
 func (s *scatterChart) Render() (Box, error) {
 	p := s.p
 	opt := s.opt
@@ -197,19 +494,101 @@ func (s *scatterChart) Render() (Box, error) {
 		}
 	}
 
+	padding := opt.Padding
+	var scaleLegend *colorScaleLegend
+	if flagIs(true, opt.ColorScale.ShowLegend) {
+		scaleLegend = s.newColorScaleLegend()
+		if scaleLegend != nil { // reserve space to the right of the plot
+			padding.Right += scaleLegend.width
+			padding.IsSet = true
+		}
+	}
+
+	if opt.NullPolicy == NullPolicyZero { // nulls drawn at zero must be within the axis range
+		opt.SeriesList = slices.Clone(opt.SeriesList)
+		for i := range opt.SeriesList {
+			opt.SeriesList[i].nullAsZero = true
+		}
+	}
+
 	// TODO - scatter uses CategoryAxisOption as a faux-category axis for what is semantically value data
 	renderResult, err := defaultRender(p, defaultRenderOption{
 		theme:          opt.Theme,
-		padding:        opt.Padding,
+		padding:        padding,
 		seriesList:     opt.SeriesList,
 		categoryAxis:   &s.opt.XAxis,
 		valueAxis:      opt.YAxis,
 		title:          opt.Title,
+		footer:         opt.Footer,
 		legend:         &s.opt.Legend,
 		valueFormatter: opt.ValueFormatter,
 	})
 	if err != nil {
 		return BoxZero, err
 	}
-	return s.renderChart(renderResult)
+	box, err := s.renderChart(renderResult)
+	if err != nil {
+		return BoxZero, err
+	}
+	if scaleLegend != nil {
+		scaleLegend.render(p, renderResult.seriesPainter)
+	}
+	return box, nil
+}
+
+const (
+	colorScaleLegendGap        = 10
+	colorScaleLegendStripWidth = 12
+	colorScaleLegendTextGap    = 4
+	colorScaleLegendSteps      = 32
+)
+
+// colorScaleLegend describes the gradient strip rendered beside the plot for a ColorScale.
+type colorScaleLegend struct {
+	scale             ColorScale
+	lowText, highText string
+	fontStyle         FontStyle
+	width             int
+}
+
+// newColorScaleLegend returns the legend for the chart color scale, or nil if no series has color values.
+func (s *scatterChart) newColorScaleLegend() *colorScaleLegend {
+	opt := s.opt
+	colorRange := newScatterValueRange(opt.SeriesList, func(s ScatterSeries) [][]float64 { return s.ColorValues })
+	if colorRange.min > colorRange.max {
+		return nil
+	}
+	valueFormatter := getPreferredValueFormatter(opt.ValueFormatter)
+	legend := &colorScaleLegend{
+		scale:     opt.resolveColorScale(),
+		lowText:   valueFormatter(colorRange.min),
+		highText:  valueFormatter(colorRange.max),
+		fontStyle: fillFontStyleDefaults(FontStyle{}, defaultFontSize, opt.Theme.GetYAxisTextColor(), s.p.font),
+	}
+	textWidth := max(s.p.MeasureText(legend.lowText, 0, legend.fontStyle).Width(),
+		s.p.MeasureText(legend.highText, 0, legend.fontStyle).Width())
+	legend.width = colorScaleLegendGap + colorScaleLegendStripWidth + colorScaleLegendTextGap + textWidth
+	return legend
+}
+
+// render draws the gradient strip to the right of the series painter, with the maximum value at the top.
+func (l *colorScaleLegend) render(p *Painter, seriesPainter *Painter) {
+	left := seriesPainter.box.Right - p.box.Left + colorScaleLegendGap
+	right := left + colorScaleLegendStripWidth
+	top := seriesPainter.box.Top - p.box.Top
+	bottom := seriesPainter.box.Bottom - p.box.Top
+	height := bottom - top
+	if height <= 0 {
+		return
+	}
+	for i := 0; i < colorScaleLegendSteps; i++ {
+		y1 := top + i*height/colorScaleLegendSteps
+		y2 := top + (i+1)*height/colorScaleLegendSteps
+		color := l.scale.ColorAt(1 - (float64(i)+0.5)/colorScaleLegendSteps)
+		p.FilledRect(left, y1, right, y2, color, color, 0)
+	}
+	textX := right + colorScaleLegendTextGap
+	textHeight := p.MeasureText(l.highText, 0, l.fontStyle).Height()
+	p.Text(l.highText, textX, top+textHeight, 0, l.fontStyle)
+	p.Text(l.lowText, textX, bottom, 0, l.fontStyle)
 }
@@ -2,7 +2,10 @@ package charts
 
 import (
 	"math/rand"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -162,6 +165,16 @@ func makeDenseScatterChartOption() ScatterChartOption {
 	}
 }
 
+func renderScatterSVG(t *testing.T, opt ScatterChartOption) string {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.ScatterChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	return string(buf)
+}
+
 func TestNewScatterChartOptionWithData(t *testing.T) {
 	t.Parallel()
 
@@ -636,6 +649,186 @@ func TestScatterChart(t *testing.T) {
 	}
 }
 
+func TestScatterChartBubble(t *testing.T) {
+	t.Parallel()
+
+	makeBubbleOption := func() ScatterChartOption {
+		opt := makeBasicScatterChartOption()
+		opt.SeriesList = NewSeriesListBubble([][]float64{
+			{120, 132, 101, 134, 90, 230, 210},
+			{820, 932, 901, 934, 1290, 1330, 1320},
+		}, [][]float64{
+			{10, 40, 25, GetNullValue(), 60, 5, 80},
+			{30, 15, 100, 45},
+		})
+		return opt
+	}
+
+	t.Run("render", func(t *testing.T) {
+		assertTestdataSVG(t, []byte(renderScatterSVG(t, makeBubbleOption())))
+	})
+	t.Run("radius_range", func(t *testing.T) {
+		opt := makeBubbleOption()
+		opt.MinRadius = 2
+		opt.MaxRadius = 10
+		svg := renderScatterSVG(t, opt)
+
+		assert.Contains(t, svg, `cx="229" cy="186" r="10"`) // largest size maps to MaxRadius
+		assert.Contains(t, svg, `cx="499" cy="320" r="2"`)  // smallest size maps to MinRadius
+		assert.NotContains(t, svg, `r="20"`)
+	})
+}
+
+func TestScatterChartColorScale(t *testing.T) {
+	t.Parallel()
+
+	makeColorOption := func() ScatterChartOption {
+		opt := makeBasicScatterChartOption()
+		opt.SeriesList = NewSeriesListScatter([][]float64{
+			{120, 132, 101, 134, 90, 230, 210},
+			{820, 932, 901, 934, 1290, 1330, 1320},
+		}, ScatterSeriesOption{
+			ColorValues: [][]float64{
+				{0, 25, 50, 75, 100, GetNullValue()},
+			},
+		})
+		opt.Symbol.Size = 6
+		opt.ColorScale = NewColorScale(Color{R: 10, G: 20, B: 200, A: 255}, Color{R: 200, G: 40, B: 10, A: 255})
+		return opt
+	}
+
+	t.Run("interpolation", func(t *testing.T) {
+		svg := renderScatterSVG(t, makeColorOption())
+
+		assert.Contains(t, svg, `r="6" style="stroke-width:1;stroke:rgb(10,20,200);fill:rgb(10,20,200)"`)   // min
+		assert.Contains(t, svg, `r="6" style="stroke-width:1;stroke:rgb(105,30,105);fill:rgb(105,30,105)"`) // mid
+		assert.Contains(t, svg, `r="6" style="stroke-width:1;stroke:rgb(200,40,10);fill:rgb(200,40,10)"`)   // max
+		// null falls back to the series color
+		assert.Contains(t, svg, `r="6" style="stroke-width:1;stroke:rgb(84,112,198);fill:rgb(84,112,198)"`)
+	})
+	t.Run("legend", func(t *testing.T) {
+		opt := makeColorOption()
+		opt.ColorScale.ShowLegend = Ptr(true)
+		svg := renderScatterSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Contains(t, svg, ">100</text>")
+		assert.Contains(t, svg, ">0</text>")
+	})
+}
+
+func TestScatterChartConnectPoints(t *testing.T) {
+	t.Parallel()
+
+	makeConnectOption := func() ScatterChartOption {
+		opt := makeBasicScatterChartOption()
+		opt.SeriesList = NewSeriesListScatterMultiValue([][][]float64{
+			{{120, 150}, {132}, {GetNullValue()}, {134}, {90, 60}, {230}, {210}},
+			{{820}, {932}, {901}, {934}, {1290}, {1330}, {1320}},
+		}, ScatterSeriesOption{
+			ConnectPoints: Ptr(true),
+		})
+		return opt
+	}
+
+	t.Run("gap", func(t *testing.T) {
+		svg := renderScatterSVG(t, makeConnectOption())
+		assertTestdataSVG(t, []byte(svg))
+
+		// the null sample splits the first series into two segments, the second series is a single segment
+		assert.Equal(t, 2, strings.Count(svg, "style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\""))
+		assert.Equal(t, 1, strings.Count(svg, "style=\"stroke-width:2;stroke:rgb(145,204,117);fill:none\""))
+	})
+	t.Run("style", func(t *testing.T) {
+		opt := makeConnectOption()
+		for i := range opt.SeriesList {
+			opt.SeriesList[i].ConnectStyle = ScatterConnectStyle{
+				LineStrokeWidth: 1,
+				LineColor:       Color{R: 10, G: 20, B: 30, A: 255},
+				DashedLine:      Ptr(true),
+			}
+		}
+		svg := renderScatterSVG(t, opt)
+
+		assert.Equal(t, 3, strings.Count(svg, "stroke-width:1;stroke:rgb(10,20,30);fill:none"))
+		assert.Equal(t, 3, strings.Count(svg, "stroke-dasharray="))
+	})
+}
+
+func TestApplyNullPolicy(t *testing.T) {
+	t.Parallel()
+
+	null := GetNullValue()
+	values := []float64{null, 2, null, null, 8, null}
+
+	for _, tt := range []struct {
+		name     string
+		policy   NullPolicy
+		expected []float64
+	}{
+		{"gap", NullPolicyGap, []float64{null, 2, null, null, 8, null}},
+		{"zero", NullPolicyZero, []float64{0, 2, 0, 0, 8, 0}},
+		{"interpolate", NullPolicyInterpolate, []float64{null, 2, 4, 6, 8, null}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, applyNullPolicy(values, tt.policy))
+		})
+	}
+	assert.Equal(t, null, values[2]) // input not mutated
+}
+
+func TestScatterChartNullPolicy(t *testing.T) {
+	t.Parallel()
+
+	makeOption := func(policy NullPolicy) ScatterChartOption {
+		opt := makeBasicScatterChartOption()
+		opt.SeriesList = NewSeriesListScatter([][]float64{
+			{120, 132, GetNullValue(), 134, 90, 230, 210},
+		}, ScatterSeriesOption{
+			ConnectPoints: Ptr(true),
+		})
+		opt.SeriesList[0].TrendLine = NewTrendLine(SeriesTrendTypeLinear)
+		opt.NullPolicy = policy
+		return opt
+	}
+	const connectStroke = "style=\"stroke-width:2;stroke:rgb(84,112,198);fill:none\""
+
+	gap := renderScatterSVG(t, makeOption(NullPolicyGap))
+	zero := renderScatterSVG(t, makeOption(NullPolicyZero))
+	interpolate := renderScatterSVG(t, makeOption(NullPolicyInterpolate))
+
+	t.Run("gap", func(t *testing.T) {
+		assertTestdataSVG(t, []byte(gap))
+		assert.Equal(t, 2, strings.Count(gap, connectStroke))
+	})
+	t.Run("zero", func(t *testing.T) {
+		assertTestdataSVG(t, []byte(zero))
+		assert.Equal(t, 1, strings.Count(zero, connectStroke))
+		assert.Contains(t, zero, ">0</text>") // axis extended to include the zero value
+	})
+	t.Run("interpolate", func(t *testing.T) {
+		assertTestdataSVG(t, []byte(interpolate))
+		assert.Equal(t, 1, strings.Count(interpolate, connectStroke))
+		assert.NotContains(t, interpolate, ">0</text>")
+	})
+	t.Run("trend_fit", func(t *testing.T) {
+		opt := makeOption(NullPolicyGap)
+		values := opt.SeriesList[0].avgValues()
+		gapTrend, err := computeTrend(applyNullPolicy(values, NullPolicyGap), SeriesTrendLine{Type: SeriesTrendTypeLinear})
+		require.NoError(t, err)
+		zeroTrend, err := computeTrend(applyNullPolicy(values, NullPolicyZero), SeriesTrendLine{Type: SeriesTrendTypeLinear})
+		require.NoError(t, err)
+		interpolateTrend, err := computeTrend(applyNullPolicy(values, NullPolicyInterpolate),
+			SeriesTrendLine{Type: SeriesTrendTypeLinear})
+		require.NoError(t, err)
+
+		assert.False(t, isValidExtent(gapTrend[2])) // the null is excluded from the fit
+		assert.True(t, isValidExtent(zeroTrend[2]))
+		assert.True(t, isValidExtent(interpolateTrend[2]))
+		assert.Less(t, zeroTrend[0], interpolateTrend[0]) // zero pulls the fit down at the start
+	})
+}
+
 func validateScatterChartRender(t *testing.T, svgP, pngP *Painter, opt ScatterChartOption, expectedCRC uint32) {
 	t.Helper()
 
@@ -694,3 +887,292 @@ func TestScatterChartError(t *testing.T) {
 		})
 	}
 }
+
+func TestScatterChartLogAxis(t *testing.T) {
+	t.Parallel()
+
+	makeLogOption := func() ScatterChartOption {
+		opt := makeBasicScatterChartOption()
+		opt.SeriesList = NewSeriesListScatter([][]float64{
+			{12, 35, 18, 60, 25, 90, 45},
+			{820, 932, 901, 934, 690, 980, 720},
+		})
+		opt.YAxis[0].Type = AxisTypeLog
+		return opt
+	}
+
+	t.Run("render", func(t *testing.T) {
+		svg := renderScatterSVG(t, makeLogOption())
+		assertTestdataSVG(t, []byte(svg))
+
+		for _, label := range []string{">10<", ">100<", ">1k<"} {
+			assert.Contains(t, svg, label)
+		}
+	})
+	t.Run("minor_split_lines", func(t *testing.T) {
+		opt := makeLogOption()
+		opt.YAxis[0].MinorSplitLineShow = Ptr(true)
+		svg := renderScatterSVG(t, opt)
+		assertTestdataSVG(t, []byte(svg))
+
+		assert.Equal(t, 16, strings.Count(svg, "stroke:rgba(224,230,242,0.4)"))
+	})
+	t.Run("non_positive_error", func(t *testing.T) {
+		opt := makeLogOption()
+		opt.SeriesList = NewSeriesListScatter([][]float64{{0, -1, 0}})
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.Error(t, p.ScatterChart(opt))
+	})
+}
+
+func TestScatterChartSecondaryYAxis(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicScatterChartOption()
+	opt.SeriesList = append(NewSeriesListScatter([][]float64{{820, 932, 901, 934, 1290, 1330, 1320}}),
+		NewSeriesListScatter([][]float64{{35, 48, 42, 55, 71, 82, 76}}, ScatterSeriesOption{YAxisIndex: 1})...)
+	opt.YAxis = make([]YAxisOption, 2)
+	opt.YAxis[1].Max = Ptr(100.0)
+
+	svg := renderScatterSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+
+	assert.Contains(t, svg, ">1.36k<") // left axis
+	assert.Contains(t, svg, ">100<")   // right axis
+	assert.Equal(t, 1, opt.SeriesList[1].YAxisIndex)
+}
+
+func TestScatterChartLegendPlacement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		legend func(*LegendOption)
+	}{
+		{
+			name: "bottom_horizontal",
+			legend: func(l *LegendOption) {
+				l.Offset = OffsetStr{Top: PositionBottom}
+			},
+		},
+		{
+			name: "right_vertical",
+			legend: func(l *LegendOption) {
+				l.Offset = OffsetStr{Left: PositionRight, Top: "40"}
+				l.Vertical = Ptr(true)
+				l.OverlayChart = Ptr(false)
+			},
+		},
+		{
+			name: "left_vertical",
+			legend: func(l *LegendOption) {
+				l.Offset = OffsetStr{Top: "40"}
+				l.Vertical = Ptr(true)
+				l.OverlayChart = Ptr(false)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := makeFullScatterChartOption()
+			tt.legend(&opt.Legend)
+			assertTestdataSVG(t, []byte(renderScatterSVG(t, opt)))
+		})
+	}
+}
+
+func TestScatterChartSeriesGroups(t *testing.T) {
+	t.Parallel()
+
+	render := func(groups *bool) string {
+		opt := makeFullScatterChartOption()
+		opt.Legend.SeriesGroups = groups
+		return renderScatterSVG(t, opt)
+	}
+
+	assert.NotContains(t, render(nil), "<g")
+	svg := render(Ptr(true))
+	assertTestdataSVG(t, []byte(svg))
+	for _, name := range makeFullScatterChartOption().Legend.SeriesNames {
+		group := `<g data-series="` + name + `">`
+		assert.Equal(t, 2, strings.Count(svg, group), name) // legend entry and series points
+		// the series group directly contains the point symbols
+		seriesStart := strings.LastIndex(svg, group) + len(group)
+		assert.True(t, strings.HasPrefix(svg[seriesStart:], "<circle"), name)
+		seriesEnd := strings.Index(svg[seriesStart:], "</g>")
+		assert.Equal(t, 7, strings.Count(svg[seriesStart:seriesStart+seriesEnd], "<circle"), name)
+	}
+}
+
+func TestScatterChartErrorBars(t *testing.T) {
+	t.Parallel()
+
+	values := [][]float64{
+		{12, 15, 11, 18, 20},
+		{5, 7, GetNullValue(), 9, 8},
+	}
+	opt := NewScatterChartOptionWithSeries(NewSeriesListScatter(values, ScatterSeriesOption{
+		ErrorValues: [][]float64{
+			{1, 2.5, GetNullValue(), 1.5, 4},
+			{0.5, GetNullValue(), 1},
+		},
+	}))
+	opt.Padding = NewBoxEqual(10)
+	opt.XAxis.Labels = []string{"A", "B", "C", "D", "E"}
+
+	assertTestdataSVG(t, []byte(renderScatterSVG(t, opt)))
+
+	// error bar ends are included in the axis range
+	assert.Equal(t, []float64{11, 13, 12.5, 17.5, 16.5, 19.5, 16, 24}, opt.SeriesList[0].extentValues())
+	assert.Equal(t, []float64{4.5, 5.5}, opt.SeriesList[1].extentValues())
+	minValue, maxValue, _ := getSeriesMinMaxSumMax(opt.SeriesList, 0, false)
+	assert.InDelta(t, 4.5, minValue, 0)
+	assert.InDelta(t, 24, maxValue, 0)
+}
+
+func TestScatterChartErrorBarsJitter(t *testing.T) {
+	t.Parallel()
+
+	opt := NewScatterChartOptionWithSeries(NewSeriesListScatterMultiValue([][][]float64{{
+		{10, 20, 30},
+		{15, 25, 35},
+	}}, ScatterSeriesOption{
+		ErrorValues: [][]float64{{2, 3}},
+		Jitter:      0.5,
+		JitterSeed:  1,
+	}))
+	opt.XAxis.Labels = []string{"A", "B"}
+	opt.XAxis.BoundaryGap = Ptr(true)
+	opt.Symbol.Size = 3
+
+	svg := renderScatterSVG(t, opt)
+
+	type bar struct{ x, top, bottom int }
+	var bars []bar
+	for _, m := range regexp.MustCompile(`M (\d+) (\d+)\nL (\d+) (\d+)"`).FindAllStringSubmatch(svg, -1) {
+		x1, _ := strconv.Atoi(m[1])
+		y1, _ := strconv.Atoi(m[2])
+		x2, _ := strconv.Atoi(m[3])
+		y2, _ := strconv.Atoi(m[4])
+		if x1 == x2 && y1 != y2 {
+			bars = append(bars, bar{x: x1, top: min(y1, y2), bottom: max(y1, y2)})
+		}
+	}
+	circles := regexp.MustCompile(`<circle cx="(\d+)" cy="(\d+)"`).FindAllStringSubmatch(svg, -1)
+	require.Len(t, circles, 6)
+	xPositions := make(map[int]bool)
+	for _, c := range circles {
+		cx, _ := strconv.Atoi(c[1])
+		cy, _ := strconv.Atoi(c[2])
+		xPositions[cx] = true
+		// each point has its own error bar drawn through it at the jittered position
+		assert.True(t, slices.ContainsFunc(bars, func(b bar) bool {
+			return b.x == cx && b.top < cy && b.bottom > cy
+		}), "no error bar through point %d,%d", cx, cy)
+	}
+	assert.Greater(t, len(xPositions), 2) // jitter spreads the values of each sample
+}
+
+func TestScatterChartPointLinks(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicScatterChartOption()
+	opt.SeriesList = NewSeriesListScatter([][]float64{{120, 132, 101}},
+		ScatterSeriesOption{PointLinks: [][]string{{"", "https://example.com/?id=1&v=2", "https://example.com/3"}}})
+
+	svg := renderScatterSVG(t, opt)
+
+	assert.Equal(t, 2, strings.Count(svg, "<a href="))
+	assert.Equal(t, 2, strings.Count(svg, "</a>"))
+	assert.Contains(t, svg, `<a href="https://example.com/?id=1&amp;v=2"><circle cx="136" cy="110"`)
+	assert.Contains(t, svg, `<a href="https://example.com/3"><circle cx="227" cy="358"`)
+	assert.Contains(t, svg, `</text><circle cx="46" cy="206"`) // first point has no link
+}
+
+func TestScatterChartSymbolShapes(t *testing.T) {
+	t.Parallel()
+
+	shapes := []SymbolShape{SymbolDot, SymbolCircle, SymbolSquare, SymbolDiamond, SymbolTriangle, SymbolCross}
+	values := make([][]float64, len(shapes))
+	names := make([]string, len(shapes))
+	for i := range shapes {
+		values[i] = []float64{float64(i + 1), float64(i + 3), float64(i + 2)}
+		names[i] = string(shapes[i])
+	}
+	opt := NewScatterChartOptionWithData(values)
+	opt.XAxis.Labels = []string{"A", "B", "C"}
+	opt.Legend.SeriesNames = names
+	opt.Legend.SeriesGroups = Ptr(true)
+	for i, shape := range shapes {
+		opt.SeriesList[i].Symbol = Symbol{Shape: shape, Size: 4}
+	}
+
+	svg := renderScatterSVG(t, opt)
+	assertTestdataSVG(t, []byte(svg))
+
+	for _, shape := range shapes {
+		group := `<g data-series="` + string(shape) + `">`
+		seriesStart := strings.LastIndex(svg, group) + len(group)
+		seriesEnd := strings.Index(svg[seriesStart:], "</g>")
+		series := svg[seriesStart : seriesStart+seriesEnd]
+		switch shape {
+		case SymbolDot, SymbolCircle:
+			assert.Equal(t, 3, strings.Count(series, "<circle"), shape)
+		case SymbolCross:
+			assert.Contains(t, series, "fill:none", shape)
+			assert.Equal(t, 6, strings.Count(series, "M "), shape)
+		default:
+			assert.NotContains(t, series, "<circle", shape)
+			assert.Equal(t, 3, strings.Count(series, "M "), shape)
+		}
+	}
+}
+
+func TestScatterChartJitter(t *testing.T) {
+	t.Parallel()
+
+	render := func(jitter float64, seed uint64) string {
+		values := make([][][]float64, 1)
+		for range 3 {
+			values[0] = append(values[0], slices.Repeat([]float64{10}, 12))
+		}
+		opt := NewScatterChartOptionWithSeries(NewSeriesListScatterMultiValue(values, ScatterSeriesOption{
+			Jitter:     jitter,
+			JitterSeed: seed,
+		}))
+		opt.XAxis.Labels = []string{"A", "B", "C"}
+		opt.XAxis.BoundaryGap = Ptr(true)
+		opt.Symbol.Size = 3
+		return renderScatterSVG(t, opt)
+	}
+	circleX := func(svg string) []int {
+		var result []int
+		for _, m := range regexp.MustCompile(`<circle cx="(\d+)"`).FindAllStringSubmatch(svg, -1) {
+			x, err := strconv.Atoi(m[1])
+			require.NoError(t, err)
+			result = append(result, x)
+		}
+		return result
+	}
+
+	svg := render(0.5, 1)
+	assertTestdataSVG(t, []byte(svg))
+	assert.Equal(t, svg, render(0.5, 1))
+	assert.NotEqual(t, svg, render(0.5, 2))
+
+	centers := circleX(render(0, 0))
+	jittered := circleX(svg)
+	require.Len(t, jittered, len(centers))
+	step := centers[12] - centers[0]
+	for sample := range 3 {
+		offsets := make(map[int]bool)
+		for i := sample * 12; i < (sample+1)*12; i++ {
+			offset := jittered[i] - centers[i]
+			assert.LessOrEqual(t, 4*offset, step, i) // within a quarter step of the category
+			assert.GreaterOrEqual(t, 4*offset, -step, i)
+			offsets[offset] = true
+		}
+		assert.Greater(t, len(offsets), 6, sample)
+	}
+}
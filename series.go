@@ -308,6 +308,16 @@ func (l LineSeriesList) ToGenericSeriesList() GenericSeriesList {
 	return result
 }
 
+// ScatterConnectStyle configures the line drawn between scatter points when ConnectPoints is enabled.
+type ScatterConnectStyle struct {
+	// LineStrokeWidth is the width of the connecting line. Default is 2.0.
+	LineStrokeWidth float64
+	// LineColor overrides the series color for the connecting line.
+	LineColor Color
+	// DashedLine when set to *true draws the connecting line dashed.
+	DashedLine *bool
+}
+
 // ScatterSeries references a population of data for scatter charts.
 type ScatterSeries struct {
 	// Values provides the series data values.
@@ -325,9 +335,36 @@ type ScatterSeries struct {
 	TrendLine []SeriesTrendLine
 	// Symbol specifies a custom shape and size for the series.
 	Symbol Symbol
+	// SizeValues optionally provides a size for each value in Values, rendering the points as bubbles with a radius
+	// scaled between the chart MinRadius and MaxRadius. Null or missing sizes use the default symbol size.
+	SizeValues [][]float64
+	// ColorValues optionally provides a scalar for each value in Values, filling each point with the color at the
+	// matching position of the chart ColorScale. Null or missing values use the series color.
+	ColorValues [][]float64
+	// ConnectPoints when set to *true draws a line through the points in index order beneath the symbols. Samples
+	// with multiple values are connected through their first value, and null values break the line.
+	ConnectPoints *bool
+	// ConnectStyle configures the line drawn when ConnectPoints is enabled.
+	ConnectStyle ScatterConnectStyle
+	// PointLinks provides a link URL for each sample, indexed the same as Values. SVG output wraps the sample points
+	// in an <a> element, empty and javascript: links and other output formats are ignored.
+	PointLinks []string
+	// ErrorValues provides the lower and upper error amounts for each sample, indexed the same as Values. A vertical
+	// error bar with caps is drawn from the value minus the lower amount to the value plus the upper amount. Each value
+	// of a multi-value sample gets its own bar, drawn at the point's position including any Jitter offset. Samples
+	// with a null or missing error are drawn without a bar.
+	ErrorValues [][2]float64
+	// Jitter randomly offsets each point horizontally within this fraction (0 to 1) of the x-axis step, spreading
+	// out coincident values so they remain distinguishable. Offsets are deterministic for a given JitterSeed, and are
+	// limited to the plot area, so enabling the x-axis BoundaryGap is recommended.
+	Jitter float64
+	// JitterSeed seeds the offsets applied by Jitter, change the seed to produce a different arrangement.
+	JitterSeed uint64
 
 	// absThemeIndex represents the series index when combined with other chart types.
 	absThemeIndex *int
+	// nullAsZero is set when the chart NullPolicy draws connecting and trend line nulls at zero.
+	nullAsZero bool
 }
 
 func (s *ScatterSeries) getYAxisIndex() int {
@@ -345,6 +382,57 @@ func (s *ScatterSeries) getValues() []float64 {
 	return result
 }
 
+// extentValues returns the error bar ends, extending the axis range to include the bars. Zero is included when null
+// values are drawn at zero by a connecting or trend line.
+func (s *ScatterSeries) extentValues() []float64 {
+	var result []float64
+	if s.nullAsZero {
+		isNull := func(v float64) bool { return !isValidExtent(v) }
+		if (flagIs(true, s.ConnectPoints) && slices.ContainsFunc(s.connectValues(), isNull)) ||
+			(len(s.TrendLine) > 0 && slices.ContainsFunc(s.avgValues(), isNull)) {
+			result = append(result, 0)
+		}
+	}
+	for i, sample := range s.Values {
+		low, high, ok := s.errorRange(i)
+		if !ok {
+			continue
+		}
+		for _, v := range sample {
+			if isValidExtent(v) {
+				result = append(result, v-low, v+high)
+			}
+		}
+	}
+	return result
+}
+
+// errorRange returns the lower and upper error amounts for the sample, or false if the sample has no error bar.
+func (s *ScatterSeries) errorRange(index int) (float64, float64, bool) {
+	if index >= len(s.ErrorValues) {
+		return 0, 0, false
+	}
+	low, high := s.ErrorValues[index][0], s.ErrorValues[index][1]
+	if !isValidExtent(low) || !isValidExtent(high) {
+		return 0, 0, false
+	}
+	return low, high, true
+}
+
+// connectValues returns the first value of each sample, the values a connecting line is drawn through. Empty samples
+// are null.
+func (s *ScatterSeries) connectValues() []float64 {
+	values := make([]float64, len(s.Values))
+	for i, sample := range s.Values {
+		if len(sample) == 0 {
+			values[i] = GetNullValue()
+		} else {
+			values[i] = sample[0]
+		}
+	}
+	return values
+}
+
 func (s *ScatterSeries) avgValues() []float64 {
 	values := make([]float64, len(s.Values))
 	for i, v := range s.Values {
@@ -406,6 +494,9 @@ func (s ScatterSeriesList) getSeriesLen(index int) int {
 }
 
 func (s ScatterSeriesList) getSeriesSymbol(index int) SymbolShape {
+	if s[index].Symbol.Shape == "" && len(s[index].SizeValues) > 0 {
+		return symbolBubble
+	}
 	return s[index].Symbol.Shape
 }
 
@@ -992,6 +1083,12 @@ type series interface {
 	getValues() []float64
 }
 
+// extentSeries is implemented by series which draw beyond their values, such as error bars. The extents are included
+// when calculating the axis range.
+type extentSeries interface {
+	extentValues() []float64
+}
+
 func expandSingleValueScatterSeries(vals []float64) [][]float64 {
 	result := make([][]float64, len(vals))
 	for i, v := range vals {
@@ -1326,6 +1423,12 @@ func getSeriesMinMaxSumMax(sl seriesList, yaxisIndex int, calcSum bool) (float64
 				sums[valueIndex] += item
 			}
 		}
+		if extents, ok := series.(extentSeries); ok {
+			for _, item := range extents.extentValues() {
+				minValue = min(minValue, item)
+				maxValue = max(maxValue, item)
+			}
+		}
 	}
 	maxSum := maxValue
 	if calcSum {
@@ -1363,6 +1466,8 @@ type LineSeriesOption struct {
 	MarkPoint SeriesMarkPoint
 	MarkLine  SeriesMarkLine
 	TrendLine []SeriesTrendLine
+	// YAxisIndex binds the series to a y-axis, 1 renders against a secondary axis on the right.
+	YAxisIndex int
 }
 
 // NewSeriesListLine builds a SeriesList for a line chart. The first dimension of the values indicates the population
@@ -1376,11 +1481,12 @@ func NewSeriesListLine(values [][]float64, opts ...LineSeriesOption) LineSeriesL
 	seriesList := make([]LineSeries, len(values))
 	for index, v := range values {
 		s := LineSeries{
-			Values:    v,
-			Label:     opt.Label,
-			MarkPoint: opt.MarkPoint,
-			MarkLine:  opt.MarkLine,
-			TrendLine: opt.TrendLine,
+			Values:     v,
+			Label:      opt.Label,
+			MarkPoint:  opt.MarkPoint,
+			MarkLine:   opt.MarkLine,
+			TrendLine:  opt.TrendLine,
+			YAxisIndex: opt.YAxisIndex,
 		}
 		if index < len(opt.Names) {
 			s.Name = opt.Names[index]
@@ -1396,6 +1502,25 @@ type ScatterSeriesOption struct {
 	Names     []string
 	MarkLine  SeriesMarkLine
 	TrendLine []SeriesTrendLine
+	// SizeValues provides a bubble size for each sample, indexed the same as the series values.
+	SizeValues [][]float64
+	// ColorValues provides a color scale value for each sample, indexed the same as the series values.
+	ColorValues [][]float64
+	// ConnectPoints when set to *true draws a line through each series' points in index order.
+	ConnectPoints *bool
+	// ConnectStyle configures the line drawn when ConnectPoints is enabled.
+	ConnectStyle ScatterConnectStyle
+	// YAxisIndex binds the series to a y-axis, 1 renders against a secondary axis on the right.
+	YAxisIndex int
+	// PointLinks provides a link URL for each sample, indexed the same as the series values.
+	PointLinks [][]string
+	// ErrorValues provides a symmetric error amount for each sample, indexed the same as the series values. Each
+	// point is drawn with an error bar spanning the value plus and minus the error, null values skip the bar.
+	ErrorValues [][]float64
+	// Jitter randomly offsets points horizontally within this fraction of the x-axis step, see ScatterSeries.Jitter.
+	Jitter float64
+	// JitterSeed seeds the offsets applied by Jitter.
+	JitterSeed uint64
 }
 
 // NewSeriesListScatter builds a SeriesList for a scatter chart. The first dimension of the values indicates the population
@@ -1409,19 +1534,48 @@ func NewSeriesListScatter(values [][]float64, opts ...ScatterSeriesOption) Scatt
 	seriesList := make([]ScatterSeries, len(values))
 	for index, v := range values {
 		s := ScatterSeries{
-			Values:    expandSingleValueScatterSeries(v),
-			Label:     opt.Label,
-			MarkLine:  opt.MarkLine,
-			TrendLine: opt.TrendLine,
+			Values:        expandSingleValueScatterSeries(v),
+			Label:         opt.Label,
+			MarkLine:      opt.MarkLine,
+			TrendLine:     opt.TrendLine,
+			ConnectPoints: opt.ConnectPoints,
+			ConnectStyle:  opt.ConnectStyle,
+			YAxisIndex:    opt.YAxisIndex,
+			Jitter:        opt.Jitter,
+			JitterSeed:    opt.JitterSeed,
 		}
 		if index < len(opt.Names) {
 			s.Name = opt.Names[index]
 		}
+		if index < len(opt.SizeValues) {
+			s.SizeValues = expandSingleValueScatterSeries(opt.SizeValues[index])
+		}
+		if index < len(opt.ColorValues) {
+			s.ColorValues = expandSingleValueScatterSeries(opt.ColorValues[index])
+		}
+		if index < len(opt.PointLinks) {
+			s.PointLinks = opt.PointLinks[index]
+		}
+		if index < len(opt.ErrorValues) {
+			s.ErrorValues = symmetricErrorValues(opt.ErrorValues[index])
+		}
 		seriesList[index] = s
 	}
 	return seriesList
 }
 
+// NewSeriesListBubble builds a SeriesList for a bubble chart, rendered as a scatter chart. The first dimension of the
+// values and sizes indicates the population of the data, while the second dimension provides the samples for the
+// population. Each size sets the radius of the matching sample point, scaled between the chart MinRadius and MaxRadius.
+func NewSeriesListBubble(values [][]float64, sizes [][]float64, opts ...ScatterSeriesOption) ScatterSeriesList {
+	var opt ScatterSeriesOption
+	if len(opts) != 0 {
+		opt = opts[0]
+	}
+	opt.SizeValues = sizes
+	return NewSeriesListScatter(values, opt)
+}
+
 // NewSeriesListScatterMultiValue builds a SeriesList for a scatter charts. The first dimension of the values indicates
 // the population of the data, while the second dimension provides the samples for the population. Multiple values for
 // a single sample can be provided using the last dimension.
@@ -1434,19 +1588,62 @@ func NewSeriesListScatterMultiValue(values [][][]float64, opts ...ScatterSeriesO
 	seriesList := make([]ScatterSeries, len(values))
 	for index, v := range values {
 		s := ScatterSeries{
-			Values:    v,
-			Label:     opt.Label,
-			MarkLine:  opt.MarkLine,
-			TrendLine: opt.TrendLine,
+			Values:        v,
+			Label:         opt.Label,
+			MarkLine:      opt.MarkLine,
+			TrendLine:     opt.TrendLine,
+			ConnectPoints: opt.ConnectPoints,
+			ConnectStyle:  opt.ConnectStyle,
+			YAxisIndex:    opt.YAxisIndex,
+			Jitter:        opt.Jitter,
+			JitterSeed:    opt.JitterSeed,
 		}
 		if index < len(opt.Names) {
 			s.Name = opt.Names[index]
 		}
+		if index < len(opt.SizeValues) {
+			s.SizeValues = expandScatterSampleValues(v, opt.SizeValues[index])
+		}
+		if index < len(opt.ColorValues) {
+			s.ColorValues = expandScatterSampleValues(v, opt.ColorValues[index])
+		}
+		if index < len(opt.PointLinks) {
+			s.PointLinks = opt.PointLinks[index]
+		}
+		if index < len(opt.ErrorValues) {
+			s.ErrorValues = symmetricErrorValues(opt.ErrorValues[index])
+		}
 		seriesList[index] = s
 	}
 	return seriesList
 }
 
+// symmetricErrorValues converts an error amount for each sample to matching lower and upper error amounts.
+func symmetricErrorValues(errors []float64) [][2]float64 {
+	result := make([][2]float64, len(errors))
+	for i, e := range errors {
+		result[i] = [2]float64{e, e}
+	}
+	return result
+}
+
+// expandScatterSampleValues expands one value per sample to match each value of the multi-value samples. Samples
+// without a provided value are set to null.
+func expandScatterSampleValues(samples [][]float64, sampleValues []float64) [][]float64 {
+	result := make([][]float64, len(samples))
+	for i, sample := range samples {
+		v := GetNullValue()
+		if i < len(sampleValues) {
+			v = sampleValues[i]
+		}
+		result[i] = make([]float64, len(sample))
+		for j := range sample {
+			result[i][j] = v
+		}
+	}
+	return result
+}
+
 // BarSeriesOption provides series customization for NewSeriesListBar.
 type BarSeriesOption struct {
 	Label     SeriesLabel
@@ -1598,6 +1795,8 @@ type OHLCData struct {
 	Low float64
 	// Close is the closing price for the time period.
 	Close float64
+	// Volume is the traded volume for the time period, optional.
+	Volume float64
 }
 
 const (
@@ -1609,6 +1808,17 @@ const (
 	CandleStyleOutline = "outline"
 )
 
+// CandlestickTransform selects a transformation applied to candlestick data before rendering.
+type CandlestickTransform int
+
+const (
+	// CandlestickTransformNone renders the OHLC data as provided.
+	CandlestickTransformNone CandlestickTransform = iota
+	// CandlestickTransformHeikinAshi renders Heikin-Ashi candles computed from the OHLC data, smoothing the price
+	// action to make trends easier to identify.
+	CandlestickTransformHeikinAshi
+)
+
 // CandlestickSeries references OHLC data for candlestick charts.
 type CandlestickSeries struct {
 	// Data provides OHLC data for each time period.
@@ -1649,9 +1859,23 @@ type CandlestickSeries struct {
 	ShowWicks *bool
 	// CandleStyle specifies the visual style: CandleStyleFilled, CandleStyleTraditional, or CandleStyleOutline.
 	CandleStyle string
+	// Transform specifies a transformation of Data applied before rendering, for example
+	// CandlestickTransformHeikinAshi. Data is not modified.
+	Transform CandlestickTransform
 	// PatternConfig configures automatic pattern detection and labeling.
 	PatternConfig *CandlestickPatternConfig
-
+	// CandleMetadata provides attributes for each candle, indexed the same as Data. SVG output writes each entry as
+	// a data-* attribute on the candle body and wick elements, other output formats ignore the metadata.
+	CandleMetadata []map[string]string
+
+	// rawData holds the untransformed data when Transform has been applied to Data.
+	rawData []OHLCData
+	// priorData holds the data preceding the chart VisibleRange, used to warm up overlays.
+	priorData []OHLCData
+	// percentBase is the price Data was rebased against by PercentAxis, zero when Data holds prices.
+	percentBase float64
+	// patterns holds the patterns detected by PatternConfig, keyed by Data index.
+	patterns map[int][]PatternDetectionResult
 	// absThemeIndex represents the series index when combined with other chart types.
 	absThemeIndex *int
 }
@@ -1686,6 +1910,11 @@ func validateOHLCData(ohlc OHLCData) bool {
 	return validateOHLCOpen(ohlc) && validateOHLCClose(ohlc)
 }
 
+// isFlatOHLC returns true when open, high, low, and close are all equal.
+func isFlatOHLC(ohlc OHLCData) bool {
+	return ohlc.High == ohlc.Low && ohlc.Open == ohlc.High && ohlc.Close == ohlc.High
+}
+
 // validateOHLCHighLow validates that High >= Low and neither is null.
 func validateOHLCHighLow(ohlc OHLCData) bool {
 	if !isValidExtent(ohlc.High) || !isValidExtent(ohlc.Low) {
@@ -1839,6 +2068,8 @@ type CandlestickSeriesOption struct {
 	CandleStyle string
 	// PatternConfig configures candlestick pattern detection.
 	PatternConfig *CandlestickPatternConfig
+	// YAxisIndex binds the series to a y-axis, 1 renders against a secondary axis on the right.
+	YAxisIndex int
 }
 
 // NewSeriesListCandlestick builds a SeriesList for candlestick charts from OHLC data.
@@ -1867,6 +2098,7 @@ func NewSeriesListCandlestick(data [][]OHLCData, opts ...CandlestickSeriesOption
 			CloseTrendLine: opt.CloseTrendLine,
 			CandleStyle:    opt.CandleStyle,
 			PatternConfig:  opt.PatternConfig,
+			YAxisIndex:     opt.YAxisIndex,
 		}
 		if index < len(opt.Names) {
 			s.Name = opt.Names[index]
@@ -1928,44 +2160,180 @@ func (k *CandlestickSeries) ExtractLowPrices() []float64 {
 	return result
 }
 
-// AggregateCandlestick aggregates OHLC data by the specified factor.
+// AggregateCandlestick aggregates OHLC data by the specified factor, see AggregateOHLC. The series configuration is
+// carried over to the returned series. CandleMetadata is merged for each group of candles, with values from later
+// candles replacing earlier values for the same key.
 func AggregateCandlestick(data CandlestickSeries, factor int) CandlestickSeries {
 	if factor <= 1 {
 		return data
 	}
 
-	aggregated := make([]OHLCData, 0, len(data.Data)/factor)
-	for i := 0; i < len(data.Data); i += factor {
-		end := i + factor
-		if end > len(data.Data) {
-			end = len(data.Data)
+	result := data
+	result.Data = AggregateOHLC(data.Data, factor)
+	result.CandleMetadata = aggregateCandleMetadata(data.CandleMetadata, factor)
+	return result
+}
+
+// aggregateCandleMetadata merges every factor metadata entries into one, matching the grouping of AggregateOHLC.
+func aggregateCandleMetadata(metadata []map[string]string, factor int) []map[string]string {
+	if metadata == nil {
+		return nil
+	}
+
+	aggregated := make([]map[string]string, 0, (len(metadata)+factor-1)/factor)
+	for i := 0; i < len(metadata); i += factor {
+		var merged map[string]string
+		for _, attrs := range metadata[i:min(i+factor, len(metadata))] {
+			for k, v := range attrs {
+				if merged == nil {
+					merged = make(map[string]string, len(attrs))
+				}
+				merged[k] = v
+			}
 		}
+		aggregated = append(aggregated, merged)
+	}
+	return aggregated
+}
 
-		// Aggregate OHLC for this period
-		open := data.Data[i].Open       // First open
-		close := data.Data[end-1].Close // Last close
-		high := data.Data[i].High       // Find max high
-		low := data.Data[i].Low         // Find min low
+// AggregateOHLC merges every factor candles into one, converting the data to a coarser timeframe (for example
+// a factor of 60 converts one minute bars to hourly bars). Each merged candle uses the open of the first candle, the
+// close of the last candle, the highest high, the lowest low, and the summed volume. A final group with fewer than
+// factor candles is merged from the remaining candles. Invalid candles are skipped, a group without valid candles
+// produces a null candle. The data is returned unchanged when factor is less than two.
+func AggregateOHLC(data []OHLCData, factor int) []OHLCData {
+	if factor <= 1 {
+		return data
+	}
 
-		for j := i; j < end; j++ {
-			if data.Data[j].High > high {
-				high = data.Data[j].High
+	aggregated := make([]OHLCData, 0, (len(data)+factor-1)/factor)
+	for i := 0; i < len(data); i += factor {
+		end := min(i+factor, len(data))
+		merged := OHLCData{Open: GetNullValue(), High: GetNullValue(), Low: GetNullValue(), Close: GetNullValue()}
+		var found bool
+		for _, ohlc := range data[i:end] {
+			if !validateOHLCData(ohlc) {
+				continue
 			}
-			if data.Data[j].Low < low {
-				low = data.Data[j].Low
+			if !found {
+				merged.Open, merged.High, merged.Low = ohlc.Open, ohlc.High, ohlc.Low
+				found = true
+			} else {
+				merged.High = max(merged.High, ohlc.High)
+				merged.Low = min(merged.Low, ohlc.Low)
+			}
+			merged.Close = ohlc.Close
+			if isValidExtent(ohlc.Volume) {
+				merged.Volume += ohlc.Volume
 			}
 		}
+		aggregated = append(aggregated, merged)
+	}
+	return aggregated
+}
 
-		aggregated = append(aggregated, OHLCData{
-			Open:  open,
-			High:  high,
-			Low:   low,
-			Close: close,
-		})
+// HeikinAshi returns a new slice of Heikin-Ashi candles computed from the provided OHLC data. The close is the
+// average of the open, high, low, and close; the open is the midpoint of the prior Heikin-Ashi body; and the high
+// and low extend to include the computed open and close. Invalid bars are kept as provided and restart the sequence.
+func HeikinAshi(data []OHLCData) []OHLCData {
+	result := make([]OHLCData, len(data))
+	var prev *OHLCData
+	for i, ohlc := range data {
+		if !validateOHLCData(ohlc) {
+			result[i] = ohlc
+			prev = nil
+			continue
+		}
+		haClose := (ohlc.Open + ohlc.High + ohlc.Low + ohlc.Close) / 4
+		haOpen := (ohlc.Open + ohlc.Close) / 2 // first bar seeds from its own body
+		if prev != nil {
+			haOpen = (prev.Open + prev.Close) / 2
+		}
+		result[i] = OHLCData{
+			Open:   haOpen,
+			High:   max(ohlc.High, haOpen, haClose),
+			Low:    min(ohlc.Low, haOpen, haClose),
+			Close:  haClose,
+			Volume: ohlc.Volume,
+		}
+		prev = &result[i]
 	}
+	return result
+}
 
-	result := data
-	result.Data = aggregated
+// ComputeATR returns the Average True Range over the period for each bar. The true range is the largest of the bar
+// high-low range and the distances from the prior close to the high and low. The first value, at the period-th valid
+// bar, is the average true range of the first period bars, and following values use Wilder smoothing:
+// (priorATR*(period-1) + trueRange) / period. Invalid bars are skipped and returned as null, as are bars before the
+// first value.
+func ComputeATR(data []OHLCData, period int) []float64 {
+	result := newNullValues(len(data))
+	if period <= 0 {
+		return result
+	}
+	var atr, prevClose float64
+	var count int
+	for i, ohlc := range data {
+		if !validateOHLCData(ohlc) {
+			continue
+		}
+		trueRange := ohlc.High - ohlc.Low
+		if count > 0 {
+			trueRange = max(trueRange, math.Abs(ohlc.High-prevClose), math.Abs(ohlc.Low-prevClose))
+		}
+		prevClose = ohlc.Close
+		count++
+		if count <= period {
+			atr += trueRange
+			if count < period {
+				continue
+			}
+			atr /= float64(period)
+		} else {
+			atr = (atr*float64(period-1) + trueRange) / float64(period)
+		}
+		result[i] = atr
+	}
+	return result
+}
+
+// ComputeVolumeProfile returns the volume traded at each of buckets equal price ranges between low and high, ordered
+// from the lowest price. Each bar's volume is spread across the buckets its high-low range overlaps in proportion to
+// the overlap, a bar without range assigns its volume to the bucket containing its close. Invalid bars, bars without
+// a positive volume, and prices outside the low to high range are excluded.
+func ComputeVolumeProfile(data []OHLCData, low, high float64, buckets int) []float64 {
+	if buckets <= 0 {
+		return nil
+	}
+	result := make([]float64, buckets)
+	if !(high > low) {
+		return result
+	}
+	step := (high - low) / float64(buckets)
+	bucketIndex := func(price float64) int {
+		return min(int((price-low)/step), buckets-1)
+	}
+	for _, ohlc := range data {
+		if !validateOHLCData(ohlc) || !isValidExtent(ohlc.Volume) || ohlc.Volume <= 0 {
+			continue
+		}
+		barLow, barHigh := max(ohlc.Low, low), min(ohlc.High, high)
+		if ohlc.High == ohlc.Low {
+			if ohlc.Close >= low && ohlc.Close <= high {
+				result[bucketIndex(ohlc.Close)] += ohlc.Volume
+			}
+			continue
+		} else if barHigh <= barLow {
+			continue // entirely outside the range
+		}
+		perPrice := ohlc.Volume / (ohlc.High - ohlc.Low)
+		for i := bucketIndex(barLow); i <= bucketIndex(barHigh); i++ {
+			bucketLow := low + float64(i)*step
+			if overlap := min(barHigh, bucketLow+step) - max(barLow, bucketLow); overlap > 0 {
+				result[i] += overlap * perPrice
+			}
+		}
+	}
 	return result
 }
 
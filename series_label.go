@@ -1,6 +1,7 @@
 package charts
 
 import (
+	"math"
 	"slices"
 	"strings"
 )
@@ -23,6 +24,16 @@ type SeriesLabel struct {
 	Distance int // TODO - do we want to replace with just Offset?
 	// Offset specifies an offset from the position.
 	Offset OffsetInt
+	// Rotation is the rotation angle in radians for labels of vertical bar charts, for example -math.Pi/2 to render
+	// labels vertically above narrow bars. Use DegreesToRadians(float64) to convert from degrees.
+	Rotation float64
+	// LeaderLines when set to *true moves labels which overlap a prior label of the series, drawing a thin line
+	// connecting each moved label back to its data point.
+	LeaderLines *bool
+	// AutoContrast when set to *true picks a dark or light font color based on the luminance of the fill the label is
+	// drawn over (for example a funnel segment, a pie slice or doughnut center, or the label's
+	// LabelStyle.BackgroundColor). An explicit FontColor still takes precedence.
+	AutoContrast *bool
 }
 
 // LabelStyle contains optional styling overrides for individual label rendering.
@@ -40,6 +51,47 @@ type LabelStyle struct {
 	BorderWidth float64
 }
 
+// mergeLabelStyle returns the primary style with any unset fields provided by the default style.
+func mergeLabelStyle(primary, defaultStyle LabelStyle) LabelStyle {
+	primary.FontStyle = mergeFontStyles(primary.FontStyle, defaultStyle.FontStyle)
+	if primary.BackgroundColor.IsZero() {
+		primary.BackgroundColor = defaultStyle.BackgroundColor
+	}
+	if primary.CornerRadius <= 0 {
+		primary.CornerRadius = defaultStyle.CornerRadius
+	}
+	if primary.BorderColor.IsZero() {
+		primary.BorderColor = defaultStyle.BorderColor
+	}
+	if primary.BorderWidth <= 0 {
+		primary.BorderWidth = defaultStyle.BorderWidth
+	}
+	return primary
+}
+
+// contrastFontColor returns a font color which is readable when drawn on top of the provided fill color.
+func contrastFontColor(fill Color) Color {
+	if isLightColor(fill) {
+		return defaultLightFontColor
+	}
+	return defaultDarkFontColor
+}
+
+// labelFontColor returns the default font color for a series label. When AutoContrast is enabled the color is
+// selected to contrast with the label background, or otherwise the provided fill the label is drawn over.
+func labelFontColor(label SeriesLabel, style *LabelStyle, fill, defaultColor Color) Color {
+	if !flagIs(true, label.AutoContrast) {
+		return defaultColor
+	}
+	if style != nil && !style.BackgroundColor.IsTransparent() {
+		fill = style.BackgroundColor
+	}
+	if fill.IsTransparent() {
+		return defaultColor
+	}
+	return contrastFontColor(fill)
+}
+
 // SeriesLabelFormatter is a function that generates custom labels with optional per-point styling.
 // This provides full control over label content and appearance for each data point.
 //
@@ -244,6 +296,12 @@ type labelRenderValue struct {
 	cornerRadius    int
 	borderColor     Color
 	borderWidth     float64
+	anchorX         int // data point position, used for leader lines
+	anchorY         int
+	width           int
+	height          int
+	rotatedBox      Box // drawn bounds of rotated text relative to x and y, unset without rotation
+	leaderLine      bool
 }
 
 type labelValue struct {
@@ -255,6 +313,7 @@ type labelValue struct {
 	radians   float64
 	fontStyle FontStyle
 	vertical  bool
+	below     bool // with vertical, places the label beneath the point instead of above
 	offset    OffsetInt
 }
 
@@ -265,6 +324,8 @@ type seriesLabelPainter struct {
 	theme        ColorPalette
 	rightPadding int
 	values       []labelRenderValue
+	// stackOverlapping shifts labels vertically to avoid overprinting a previously placed label.
+	stackOverlapping bool
 }
 
 func newSeriesLabelPainter(p *Painter, seriesNames []string, label SeriesLabel,
@@ -309,31 +370,13 @@ func (o *seriesLabelPainter) Add(value labelValue) {
 	labelFontStyle := mergeFontStyles(label.FontStyle, value.fontStyle, FontStyle{
 		FontColor: o.theme.GetLabelTextColor(),
 		FontSize:  defaultLabelFontSize,
-		Font:      getPreferredFont(label.FontStyle.Font, value.fontStyle.Font),
+		Font:      getPreferredFont(label.FontStyle.Font, value.fontStyle.Font, o.p.font),
 	})
 	if labelStyleOverride != nil { // Prefer per-point style overrides if present
 		labelFontStyle = mergeFontStyles(labelStyleOverride.FontStyle, labelFontStyle)
 	}
 
-	// Measure text accounting for potential multi-line content
-	lines := splitLabelText(text)
-	var textBox Box
-	if len(lines) <= 1 {
-		textBox = o.p.MeasureText(text, value.radians, labelFontStyle)
-	} else {
-		// For multi-line text, calculate total dimensions
-		var maxWidth, totalHeight int
-		for _, line := range lines {
-			lineBox := o.p.MeasureText(line, value.radians, labelFontStyle)
-			w := lineBox.Width()
-			h := lineBox.Height()
-			if w > maxWidth {
-				maxWidth = w
-			}
-			totalHeight += h
-		}
-		textBox = Box{Left: 0, Top: 0, Right: maxWidth, Bottom: totalHeight, IsSet: true}
-	}
+	textBox := o.measureLabelText(text, value.radians, labelFontStyle)
 	renderValue := labelRenderValue{
 		text:      text,
 		fontStyle: labelFontStyle,
@@ -341,6 +384,10 @@ func (o *seriesLabelPainter) Add(value labelValue) {
 		x:         value.x,
 		y:         value.y,
 		radians:   value.radians,
+		anchorX:   value.x,
+		anchorY:   value.y,
+		width:     textBox.Width(),
+		height:    textBox.Height(),
 	}
 
 	// Set background color, corner radius, and border styling if specified
@@ -352,7 +399,11 @@ func (o *seriesLabelPainter) Add(value labelValue) {
 	}
 	if value.vertical {
 		renderValue.x -= textBox.Width() >> 1
-		renderValue.y -= distance
+		if value.below {
+			renderValue.y += distance + textBox.Height()
+		} else {
+			renderValue.y -= distance
+		}
 	} else {
 		// Start with default positioning
 		renderValue.x += distance
@@ -372,12 +423,37 @@ func (o *seriesLabelPainter) Add(value labelValue) {
 	}
 	if value.radians != 0 {
 		renderValue.x = value.x + (textBox.Width() >> 1) - 1
+		// text rotates around its baseline start, so the drawn bounds differ from the measured box
+		flat := o.measureLabelText(text, 0, labelFontStyle)
+		minX, maxX, minY, maxY := rotatedTextExtents(flat.Width(), flat.Height(), value.radians)
+		renderValue.rotatedBox = Box{
+			Left:   int(math.Round(minX)),
+			Top:    int(math.Round(minY)),
+			Right:  int(math.Round(maxX)),
+			Bottom: int(math.Round(maxY)),
+			IsSet:  true,
+		}
 	}
 	renderValue.x += value.offset.Left
 	renderValue.y += value.offset.Top
 	o.values = append(o.values, renderValue)
 }
 
+// measureLabelText measures the label text, summing the line heights of multi-line text.
+func (o *seriesLabelPainter) measureLabelText(text string, radians float64, fontStyle FontStyle) Box {
+	lines := splitLabelText(text)
+	if len(lines) <= 1 {
+		return o.p.MeasureText(text, radians, fontStyle)
+	}
+	var maxWidth, totalHeight int
+	for _, line := range lines {
+		lineBox := o.p.MeasureText(line, radians, fontStyle)
+		maxWidth = max(maxWidth, lineBox.Width())
+		totalHeight += lineBox.Height()
+	}
+	return Box{Left: 0, Top: 0, Right: maxWidth, Bottom: totalHeight, IsSet: true}
+}
+
 // drawLabelWithBackground draws a text label with optional background styling.
 // This helper function can be used by various chart types to render labels with custom styling.
 // Supports multi-line text separated by '\n' characters.
@@ -461,8 +537,104 @@ func drawLabelWithBackground(p *Painter, text string, x, y int, radians float64,
 	}
 }
 
+// labelLeaderLine connects a label to its data point, running from the start to a branch point and then horizontally
+// to the end point beside the label text.
+type labelLeaderLine struct {
+	startX, startY   int
+	branchX, branchY int
+	endX, endY       int
+}
+
+// leaderLineTextMargin is the space between the end of a leader line and the label text.
+const leaderLineTextMargin = 3
+
+// seriesLeaderLineLength is the length of the horizontal leader line segment for series labels.
+const seriesLeaderLineLength = 5
+
+// leaderLineToLabel returns a leader line from the point to the nearest side of the label box, with a horizontal
+// segment of lineLength level with the label center, matching the outer labels of pie charts.
+func leaderLineToLabel(x, y int, label Box, lineLength int) labelLeaderLine {
+	l := labelLeaderLine{startX: x, startY: y, endY: (label.Top + label.Bottom) >> 1}
+	if x <= (label.Left+label.Right)>>1 {
+		l.endX = label.Left - leaderLineTextMargin
+		l.branchX = l.endX - lineLength
+	} else {
+		l.endX = label.Right + leaderLineTextMargin
+		l.branchX = l.endX + lineLength
+	}
+	l.branchY = l.endY
+	return l
+}
+
+// render strokes the leader line segments.
+func (l labelLeaderLine) render(p *Painter, color Color) {
+	p.moveTo(l.startX, l.startY)
+	p.lineTo(l.branchX, l.branchY)
+	p.moveTo(l.branchX, l.branchY)
+	p.lineTo(l.endX, l.endY)
+	p.stroke(color, 1)
+}
+
+// maxLabelShiftAttempts limits how many alternative positions are tried when moving an overlapping label.
+const maxLabelShiftAttempts = 8
+
+// offsetOverlappingLabels moves labels which collide with a previously placed label, alternating above and below
+// the original position. When leaderLines is set, moved labels are flagged so a line is drawn back to the data point.
+func (o *seriesLabelPainter) offsetOverlappingLabels(leaderLines bool) {
+	const gap = 2
+	placed := make([]Box, 0, len(o.values))
+	for i := range o.values {
+		v := &o.values[i]
+		if v.text == "" {
+			continue
+		}
+		box := v.bounds()
+		collides := func(b Box) bool {
+			for _, other := range placed {
+				if b.Overlaps(other) {
+					return true
+				}
+			}
+			return false
+		}
+		var shift int
+		for attempt := 1; attempt <= maxLabelShiftAttempts && collides(box.Shift(0, shift)); attempt++ {
+			shift = ((attempt + 1) / 2) * (box.Height() + gap)
+			if attempt%2 == 1 {
+				shift = -shift // odd attempts move up, even attempts move down
+			}
+		}
+		if shift != 0 {
+			v.y += shift
+			v.leaderLine = leaderLines
+		}
+		placed = append(placed, box.Shift(0, shift))
+	}
+}
+
+// bounds returns the box the label occupies when drawn, including any background and border.
+func (v *labelRenderValue) bounds() Box {
+	box := Box{Left: v.x, Top: v.y - v.height, Right: v.x + v.width, Bottom: v.y, IsSet: true}
+	if v.rotatedBox.IsSet {
+		box = v.rotatedBox.Shift(v.x, v.y)
+	}
+	if !v.backgroundColor.IsTransparent() || (!v.borderColor.IsTransparent() && v.borderWidth > 0) {
+		// match the background padding applied by drawLabelWithBackground
+		pad := 4 + ceilFloatToInt(max(v.borderWidth, 0)/2)
+		box = Box{Left: box.Left - pad, Top: box.Top - pad, Right: box.Right + pad, Bottom: box.Bottom + pad, IsSet: true}
+	}
+	return box
+}
+
 func (o *seriesLabelPainter) Render() (Box, error) {
+	if leaderLines := flagIs(true, o.label.LeaderLines); leaderLines || o.stackOverlapping {
+		o.offsetOverlappingLabels(leaderLines)
+	}
 	for _, item := range o.values {
+		if item.leaderLine {
+			leaderLineToLabel(item.anchorX, item.anchorY, item.bounds(), seriesLeaderLineLength).
+				render(o.p, item.fontStyle.FontColor)
+		}
 		if item.text != "" {
 			drawLabelWithBackground(o.p, item.text, item.x, item.y, item.radians,
 				item.fontStyle, item.backgroundColor, item.cornerRadius, item.borderColor, item.borderWidth)
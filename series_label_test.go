@@ -1,7 +1,9 @@
 package charts
 
 import (
+	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1599,3 +1601,73 @@ func TestDrawLabelWithBackgroundMultiline(t *testing.T) {
 		assertTestdataSVG(t, data)
 	})
 }
+
+func TestSeriesLabelLeaderLines(t *testing.T) {
+	t.Parallel()
+
+	render := func(leaderLines *bool) (*seriesLabelPainter, string) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 400, Height: 400})
+		label := SeriesLabel{Show: Ptr(true), LeaderLines: leaderLines}
+		lp := newSeriesLabelPainter(p, []string{"a"}, label, GetDefaultTheme(), 0)
+		lp.Add(labelValue{value: 10, x: 100, y: 200})
+		lp.Add(labelValue{value: 11, x: 102, y: 201}) // near-coincident point
+		_, err := lp.Render()
+		require.NoError(t, err)
+		svg, err := p.Bytes()
+		require.NoError(t, err)
+		return lp, string(svg)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		lp, svg := render(nil)
+		require.Len(t, lp.values, 2)
+		assert.False(t, lp.values[1].leaderLine)
+		assert.Equal(t, 0, strings.Count(svg, "<path"))
+	})
+	t.Run("enabled", func(t *testing.T) {
+		lp, svg := render(Ptr(true))
+		require.Len(t, lp.values, 2)
+		assert.False(t, lp.values[0].leaderLine)
+		second := lp.values[1]
+		assert.True(t, second.leaderLine)
+		assert.Equal(t, 201, second.anchorY)
+		first := lp.values[0]
+		firstBox := Box{Left: first.x, Top: first.y - first.height, Right: first.x + first.width, Bottom: first.y}
+		secondBox := Box{Left: second.x, Top: second.y - second.height, Right: second.x + second.width, Bottom: second.y}
+		assert.False(t, firstBox.Overlaps(secondBox))
+		assert.Equal(t, 1, strings.Count(svg, "<path"))
+		assertTestdataSVG(t, []byte(svg))
+	})
+	t.Run("rotated", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 400, Height: 400})
+		label := SeriesLabel{Show: Ptr(true), LeaderLines: Ptr(true)}
+		lp := newSeriesLabelPainter(p, []string{"a"}, label, GetDefaultTheme(), 0)
+		lp.Add(labelValue{value: 1000, x: 100, y: 200, radians: math.Pi / 2})
+		lp.Add(labelValue{value: 1001, x: 102, y: 201, radians: math.Pi / 2})
+		_, err := lp.Render()
+		require.NoError(t, err)
+
+		first, second := lp.values[0], lp.values[1]
+		flat := p.MeasureText(first.text, 0, first.fontStyle)
+		firstBox := first.bounds()
+		// rotated a quarter turn clockwise around the baseline start, the text extends down and right of the anchor
+		assert.Equal(t, Box{Left: first.x, Top: first.y, Right: first.x + flat.Height(), Bottom: first.y + flat.Width(), IsSet: true}, firstBox)
+		assert.True(t, second.leaderLine)
+		assert.False(t, firstBox.Overlaps(second.bounds()))
+	})
+}
+
+func TestLeaderLineToLabel(t *testing.T) {
+	t.Parallel()
+
+	label := Box{Left: 100, Top: 40, Right: 140, Bottom: 60, IsSet: true}
+
+	t.Run("right_of_point", func(t *testing.T) {
+		assert.Equal(t, labelLeaderLine{startX: 90, startY: 80, branchX: 92, branchY: 50, endX: 97, endY: 50},
+			leaderLineToLabel(90, 80, label, 5))
+	})
+	t.Run("left_of_point", func(t *testing.T) {
+		assert.Equal(t, labelLeaderLine{startX: 150, startY: 80, branchX: 148, branchY: 50, endX: 143, endY: 50},
+			leaderLineToLabel(150, 80, label, 5))
+	})
+}
@@ -2,6 +2,7 @@ package charts
 
 import (
 	"math"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -584,6 +585,44 @@ func TestScatterSeriesAvgValues(t *testing.T) {
 	assert.Equal(t, expected, seriesList.ToGenericSeriesList()[0].Values)
 }
 
+func TestScatterSeriesSizeValues(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bubble", func(t *testing.T) {
+		seriesList := NewSeriesListBubble([][]float64{{1, 2, 3}, {4}}, [][]float64{{10, 20, 30}},
+			ScatterSeriesOption{Names: []string{"a", "b"}})
+
+		require.Len(t, seriesList, 2)
+		assert.Equal(t, [][]float64{{10}, {20}, {30}}, seriesList[0].SizeValues)
+		assert.Nil(t, seriesList[1].SizeValues)
+		assert.Equal(t, "a", seriesList[0].Name)
+		assert.Equal(t, symbolBubble, seriesList.getSeriesSymbol(0))
+		assert.Equal(t, SymbolShape(""), seriesList.getSeriesSymbol(1))
+	})
+	t.Run("multi_value", func(t *testing.T) {
+		seriesList := NewSeriesListScatterMultiValue([][][]float64{{{1, 2}, {3}, {4, 5}}},
+			ScatterSeriesOption{SizeValues: [][]float64{{10, 20}}})
+
+		require.Len(t, seriesList, 1)
+		assert.Equal(t, [][]float64{{10, 10}, {20}, {GetNullValue(), GetNullValue()}}, seriesList[0].SizeValues)
+	})
+}
+
+func TestSeriesOptionYAxisIndex(t *testing.T) {
+	t.Parallel()
+
+	line := NewSeriesListLine([][]float64{{1, 2}}, LineSeriesOption{YAxisIndex: 1})
+	assert.Equal(t, 1, line[0].YAxisIndex)
+	scatter := NewSeriesListScatter([][]float64{{1, 2}}, ScatterSeriesOption{YAxisIndex: 1})
+	assert.Equal(t, 1, scatter[0].YAxisIndex)
+	scatterMulti := NewSeriesListScatterMultiValue([][][]float64{{{1, 2}}}, ScatterSeriesOption{YAxisIndex: 1})
+	assert.Equal(t, 1, scatterMulti[0].YAxisIndex)
+	candlestick := NewSeriesListCandlestick([][]OHLCData{makeBasicCandlestickData()},
+		CandlestickSeriesOption{YAxisIndex: 1})
+	assert.Equal(t, 1, candlestick[0].YAxisIndex)
+	assert.Equal(t, 2, getSeriesYAxisCount(candlestick))
+}
+
 func TestSeriesSummary(t *testing.T) {
 	t.Parallel()
 
@@ -826,8 +865,8 @@ func TestAggregateCandlestick(t *testing.T) {
 
 	series := CandlestickSeries{
 		Data: []OHLCData{
-			{Open: 100, High: 110, Low: 95, Close: 105},
-			{Open: 105, High: 115, Low: 100, Close: 112},
+			{Open: 100, High: 110, Low: 95, Close: 105, Volume: 1000},
+			{Open: 105, High: 115, Low: 100, Close: 112, Volume: 1500},
 			{Open: 112, High: 118, Low: 108, Close: 115},
 			{Open: 115, High: 120, Low: 110, Close: 118},
 		},
@@ -843,7 +882,7 @@ func TestAggregateCandlestick(t *testing.T) {
 		aggregated := AggregateCandlestick(series, 2)
 
 		require.Len(t, aggregated.Data, 2)
-		assert.Equal(t, OHLCData{Open: 100, High: 115, Low: 95, Close: 112}, aggregated.Data[0])
+		assert.Equal(t, OHLCData{Open: 100, High: 115, Low: 95, Close: 112, Volume: 2500}, aggregated.Data[0])
 		assert.Equal(t, series.Name, aggregated.Name)
 		assert.Equal(t, series.ShowWicks, aggregated.ShowWicks)
 		assert.Equal(t, series.CandleStyle, aggregated.CandleStyle)
@@ -851,11 +890,168 @@ func TestAggregateCandlestick(t *testing.T) {
 		assert.Equal(t, series.CloseTrendLine, aggregated.CloseTrendLine)
 		assert.Equal(t, series.PatternConfig, aggregated.PatternConfig)
 	})
+	t.Run("factor_merges_metadata", func(t *testing.T) {
+		withMetadata := series
+		withMetadata.CandleMetadata = []map[string]string{
+			{"id": "0", "session": "pre"},
+			{"id": "1"},
+			nil,
+			{"id": "3", "event": "earnings"},
+		}
+		aggregated := AggregateCandlestick(withMetadata, 2)
+
+		require.Len(t, aggregated.CandleMetadata, 2)
+		assert.Equal(t, map[string]string{"id": "1", "session": "pre"}, aggregated.CandleMetadata[0])
+		assert.Equal(t, map[string]string{"id": "3", "event": "earnings"}, aggregated.CandleMetadata[1])
+		assert.Nil(t, AggregateCandlestick(series, 2).CandleMetadata)
+	})
 	t.Run("factor_below_two_unchanged", func(t *testing.T) {
 		assert.Equal(t, series, AggregateCandlestick(series, 1))
 	})
 }
 
+func TestAggregateOHLC(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 104, Low: 99, Close: 103, Volume: 100},
+		{Open: 103, High: 108, Low: 102, Close: 107, Volume: 250},
+		{Open: 107, High: 107.5, Low: 96, Close: 98, Volume: 400},
+		{Open: 98, High: 101, Low: 97, Close: 100, Volume: 150},
+		{Open: 100, High: 102, Low: 94, Close: 95, Volume: 300},
+	}
+
+	t.Run("full_group", func(t *testing.T) {
+		aggregated := AggregateOHLC(data, 3)
+
+		require.Len(t, aggregated, 2)
+		assert.Equal(t, OHLCData{Open: 100, High: 108, Low: 96, Close: 98, Volume: 750}, aggregated[0])
+	})
+	t.Run("remainder_group", func(t *testing.T) {
+		aggregated := AggregateOHLC(data, 3)
+
+		require.Len(t, aggregated, 2)
+		assert.Equal(t, OHLCData{Open: 98, High: 102, Low: 94, Close: 95, Volume: 450}, aggregated[1])
+	})
+	t.Run("factor_exceeds_length", func(t *testing.T) {
+		aggregated := AggregateOHLC(data, 10)
+
+		assert.Equal(t, []OHLCData{{Open: 100, High: 108, Low: 94, Close: 95, Volume: 1200}}, aggregated)
+	})
+	t.Run("factor_below_two_unchanged", func(t *testing.T) {
+		assert.Equal(t, data, AggregateOHLC(data, 1))
+		assert.Equal(t, data, AggregateOHLC(data, 0))
+	})
+	t.Run("invalid_candles_skipped", func(t *testing.T) {
+		null := OHLCData{Open: GetNullValue(), High: GetNullValue(), Low: GetNullValue(), Close: GetNullValue()}
+		aggregated := AggregateOHLC([]OHLCData{null, data[0], data[1], null, null}, 3)
+
+		require.Len(t, aggregated, 2)
+		assert.Equal(t, OHLCData{Open: 100, High: 108, Low: 99, Close: 107, Volume: 350}, aggregated[0])
+		assert.Equal(t, null, aggregated[1])
+	})
+	t.Run("empty", func(t *testing.T) {
+		assert.Empty(t, AggregateOHLC(nil, 5))
+	})
+}
+
+func TestHeikinAshi(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 100, High: 110, Low: 95, Close: 105, Volume: 1000},
+		{Open: 105, High: 115, Low: 100, Close: 112},
+		{Open: 112, High: 118, Low: 108, Close: 115},
+		{Open: GetNullValue(), High: GetNullValue(), Low: GetNullValue(), Close: GetNullValue()},
+		{Open: 115, High: 120, Low: 105, Close: 108},
+	}
+	original := slices.Clone(data)
+
+	result := HeikinAshi(data)
+
+	require.Len(t, result, len(data))
+	assert.Equal(t, OHLCData{Open: 102.5, High: 110, Low: 95, Close: 102.5, Volume: 1000}, result[0])
+	assert.Equal(t, OHLCData{Open: 102.5, High: 115, Low: 100, Close: 108}, result[1])
+	assert.Equal(t, OHLCData{Open: 105.25, High: 118, Low: 105.25, Close: 113.25}, result[2])
+	// invalid bars are kept, and the sequence restarts after them
+	assert.Equal(t, data[3], result[3])
+	assert.Equal(t, OHLCData{Open: 111.5, High: 120, Low: 105, Close: 112}, result[4])
+	assert.Equal(t, original, data)
+}
+
+func TestComputeATR(t *testing.T) {
+	t.Parallel()
+
+	data := []OHLCData{
+		{Open: 8.5, High: 10, Low: 8, Close: 9},    // TR 2
+		{Open: 9, High: 11, Low: 9, Close: 10.5},   // TR 2
+		{Open: 10.5, High: 12, Low: 10, Close: 11}, // TR 2, ATR (2+2+2)/3
+		{Open: 11, High: 14, Low: 11, Close: 13},   // TR 3 (high-low and high-prevClose)
+		{Open: 9.5, High: 10, Low: 9, Close: 9.5},  // TR 4 (gap down, prevClose-low)
+	}
+	null := GetNullValue()
+
+	t.Run("hand_computed", func(t *testing.T) {
+		result := ComputeATR(data, 3)
+		require.Len(t, result, len(data))
+		assert.Equal(t, null, result[0])
+		assert.Equal(t, null, result[1])
+		assert.InDelta(t, 2.0, result[2], 1e-9)
+		assert.InDelta(t, 7.0/3, result[3], 1e-9)  // (2*2 + 3) / 3
+		assert.InDelta(t, 26.0/9, result[4], 1e-9) // (7/3*2 + 4) / 3
+	})
+	t.Run("invalid_bar_skipped", func(t *testing.T) {
+		withNull := slices.Insert(slices.Clone(data), 3,
+			OHLCData{Open: null, High: null, Low: null, Close: null})
+		result := ComputeATR(withNull, 3)
+		assert.Equal(t, null, result[3])
+		// the prior valid close is used for the following true range
+		assert.InDelta(t, 7.0/3, result[4], 1e-9)
+		assert.InDelta(t, 26.0/9, result[5], 1e-9)
+	})
+	t.Run("period_one", func(t *testing.T) {
+		assert.Equal(t, []float64{2, 2, 2, 3, 4}, ComputeATR(data, 1))
+	})
+	t.Run("invalid_period", func(t *testing.T) {
+		assert.Equal(t, []float64{null, null, null, null, null}, ComputeATR(data, 0))
+		assert.Equal(t, []float64{null, null, null, null, null}, ComputeATR(data, 10))
+	})
+}
+
+func TestComputeVolumeProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("proportional_overlap", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 12, High: 20, Low: 10, Close: 18, Volume: 100}, // entirely within the first bucket
+			{Open: 16, High: 25, Low: 15, Close: 24, Volume: 100}, // split evenly across the first two buckets
+			{Open: 25, High: 25, Low: 25, Close: 25, Volume: 40},  // no range, assigned to the bucket of the close
+		}
+		assert.InDeltaSlice(t, []float64{150, 90}, ComputeVolumeProfile(data, 10, 30, 2), 1e-9)
+	})
+	t.Run("clipped_to_range", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 5, High: 20, Low: 0, Close: 15, Volume: 200}, // only the 10-20 portion is counted
+			{Open: 40, High: 50, Low: 35, Close: 45, Volume: 100},
+		}
+		assert.InDeltaSlice(t, []float64{100, 0}, ComputeVolumeProfile(data, 10, 30, 2), 1e-9)
+	})
+	t.Run("skips_invalid", func(t *testing.T) {
+		data := []OHLCData{
+			{Open: 12, High: 20, Low: 10, Close: 18},                         // no volume
+			{Open: 12, High: 8, Low: 10, Close: 18, Volume: 100},             // invalid bar
+			{Open: 12, High: 20, Low: 10, Close: 18, Volume: GetNullValue()}, // null volume
+			{Open: 22, High: 28, Low: 22, Close: 28, Volume: 60},
+		}
+		assert.InDeltaSlice(t, []float64{0, 60}, ComputeVolumeProfile(data, 10, 30, 2), 1e-9)
+	})
+	t.Run("invalid_range", func(t *testing.T) {
+		data := []OHLCData{{Open: 12, High: 20, Low: 10, Close: 18, Volume: 100}}
+		assert.Nil(t, ComputeVolumeProfile(data, 10, 30, 0))
+		assert.InDeltaSlice(t, []float64{0, 0}, ComputeVolumeProfile(data, 30, 10, 2), 0)
+	})
+}
+
 func TestCandlestickGenericBidirectionalConversion(t *testing.T) {
 	t.Parallel()
 
@@ -9,7 +9,10 @@ const (
 	SymbolDot         SymbolShape = "dot"
 	SymbolSquare      SymbolShape = "square"
 	SymbolDiamond     SymbolShape = "diamond"
+	SymbolTriangle    SymbolShape = "triangle"
+	SymbolCross       SymbolShape = "cross"
 	symbolCandlestick SymbolShape = "candlestick" // internal only, set automatically
+	symbolBubble      SymbolShape = "bubble"      // internal only, set automatically
 )
 
 // Symbol configures the shape and size drawn at data points and legend icons.
@@ -182,7 +182,7 @@ func (t *tableChart) render() (*renderInfo, error) {
 		}
 	}
 	if fontStyle.Font == nil {
-		fontStyle.Font = GetDefaultFont()
+		fontStyle.Font = getPreferredFont(p.font)
 	}
 	if opt.HeaderFontColor.IsZero() {
 		if opt.Theme.IsDark() {
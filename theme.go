@@ -42,24 +42,47 @@ const (
 	ThemeSummer = "summer"
 	// ThemeFall is a dark theme with shades of yellow, orange and brown.
 	ThemeFall = "fall"
+	// ThemeTradingDark is a financial chart theme with a muted dark background, subtle grid lines, and
+	// high-contrast teal and red candles.
+	ThemeTradingDark = "trading-dark"
+	// ThemeTradingLight is a financial chart theme with a soft white background, subtle grid lines, and
+	// high-contrast green and red candles.
+	ThemeTradingLight = "trading-light"
 )
 
-// ColorPalette provides the theming for the chart.
+// ColorPalette provides the theming for the chart. The getters can also be used when drawing custom content
+// (for example on a child Painter) so that it matches the colors of the rendered chart.
 type ColorPalette interface {
+	// IsDark returns true if the palette is intended for a dark background.
 	IsDark() bool
+	// GetXAxisStrokeColor returns the color of the x-axis line and ticks.
 	GetXAxisStrokeColor() Color
+	// GetYAxisStrokeColor returns the color of the y-axis line and ticks.
 	GetYAxisStrokeColor() Color
+	// GetAxisSplitLineColor returns the color of the grid lines drawn across the plot.
 	GetAxisSplitLineColor() Color
+	// GetSeriesColor returns the color for the series index. Once the index exceeds the palette the colors
+	// cycle, with the lightness and saturation adjusted so that repeated colors remain distinguishable.
 	GetSeriesColor(int) Color
+	// GetSeriesTrendColor returns the trend line color for the series index, cycling like GetSeriesColor.
 	GetSeriesTrendColor(int) Color
+	// GetBackgroundColor returns the chart background color.
 	GetBackgroundColor() Color
+	// GetTitleTextColor returns the color of the title text.
 	GetTitleTextColor() Color
+	// GetMarkTextColor returns the color of mark point and mark line labels.
 	GetMarkTextColor() Color
+	// GetLabelTextColor returns the color of series value labels.
 	GetLabelTextColor() Color
+	// GetLegendTextColor returns the color of the legend text.
 	GetLegendTextColor() Color
+	// GetXAxisTextColor returns the color of the x-axis labels.
 	GetXAxisTextColor() Color
+	// GetYAxisTextColor returns the color of the y-axis labels.
 	GetYAxisTextColor() Color
+	// GetTitleBorderColor returns the color of the title border.
 	GetTitleBorderColor() Color
+	// GetLegendBorderColor returns the color of the legend border.
 	GetLegendBorderColor() Color
 	// WithXAxisColor returns a new ColorPalette with the specified x-axis color.
 	// Use WithXAxisTextColor to adjust the text color.
@@ -100,6 +123,15 @@ type ColorPalette interface {
 	GetCandleWickColor() Color
 	// GetSeriesUpDownColors returns distinct "up" and "down" colors for each series index.
 	GetSeriesUpDownColors(index int) (Color, Color)
+	// WithCandleWickColor returns a new ColorPalette with the specified candlestick wick color.
+	// A transparent color results in the wicks matching the candle body color.
+	WithCandleWickColor(Color) ColorPalette
+	// WithSeriesUpDownColors returns a new ColorPalette with the specified up and down (bull and bear) color pairs.
+	WithSeriesUpDownColors([][2]Color) ColorPalette
+	// Invert returns a new ColorPalette with the background, text, axis, and border lightness inverted, deriving a
+	// dark variant from a light theme or a light variant from a dark theme. Series hues are preserved, with their
+	// lightness adjusted only as needed to remain visible against the new background.
+	Invert() ColorPalette
 }
 
 type themeColorPalette struct {
@@ -750,6 +782,75 @@ func init() {
 		},
 	)
 
+	InstallTheme(
+		ThemeTradingDark,
+		ThemeOption{
+			IsDarkMode:         true,
+			AxisStrokeColor:    Color{R: 42, G: 46, B: 57, A: 255},
+			AxisSplitLineColor: Color{R: 30, G: 34, B: 45, A: 255},
+			BackgroundColor:    Color{R: 19, G: 23, B: 34, A: 255},
+			TextColor:          Color{R: 178, G: 181, B: 190, A: 255},
+			TextColorTitle:     Color{R: 209, G: 212, B: 220, A: 255},
+			SeriesColors: []Color{
+				{ // Sky blue
+					R: 41, G: 98, B: 255, A: 255,
+				},
+				{ // Amber
+					R: 255, G: 152, B: 0, A: 255,
+				},
+				{ // Violet
+					R: 156, G: 39, B: 176, A: 255,
+				},
+				{ // Cyan
+					R: 0, G: 188, B: 212, A: 255,
+				},
+				{ // Pink
+					R: 233, G: 30, B: 99, A: 255,
+				},
+			},
+			CandleWickColor: Color{R: 120, G: 123, B: 134, A: 255},
+			SeriesUpDownColors: [][2]Color{
+				{{R: 38, G: 166, B: 154, A: 255}, {R: 239, G: 83, B: 80, A: 255}}, // Teal / Red
+				{{R: 8, G: 153, B: 129, A: 255}, {R: 242, G: 54, B: 69, A: 255}},  // Dark teal / Crimson
+				{{R: 0, G: 188, B: 212, A: 255}, {R: 255, G: 152, B: 0, A: 255}},  // Cyan / Amber
+			},
+		},
+	)
+	InstallTheme(
+		ThemeTradingLight,
+		ThemeOption{
+			IsDarkMode:         false,
+			AxisStrokeColor:    Color{R: 209, G: 212, B: 220, A: 255},
+			AxisSplitLineColor: Color{R: 240, G: 243, B: 250, A: 255},
+			BackgroundColor:    Color{R: 250, G: 250, B: 252, A: 255},
+			TextColor:          Color{R: 19, G: 23, B: 34, A: 255},
+			TextColorLegend:    Color{R: 80, G: 83, B: 94, A: 255},
+			SeriesColors: []Color{
+				{ // Royal blue
+					R: 41, G: 98, B: 255, A: 255,
+				},
+				{ // Orange
+					R: 245, G: 124, B: 0, A: 255,
+				},
+				{ // Purple
+					R: 123, G: 31, B: 162, A: 255,
+				},
+				{ // Teal
+					R: 0, G: 137, B: 123, A: 255,
+				},
+				{ // Magenta
+					R: 194, G: 24, B: 91, A: 255,
+				},
+			},
+			CandleWickColor: Color{R: 93, G: 96, B: 107, A: 255},
+			SeriesUpDownColors: [][2]Color{
+				{{R: 8, G: 153, B: 129, A: 255}, {R: 242, G: 54, B: 69, A: 255}},  // Green / Red
+				{{R: 38, G: 166, B: 154, A: 255}, {R: 239, G: 83, B: 80, A: 255}}, // Teal / Light red
+				{{R: 41, G: 98, B: 255, A: 255}, {R: 245, G: 124, B: 0, A: 255}},  // Blue / Orange
+			},
+		},
+	)
+
 	if err := SetDefaultTheme(ThemeLight); err != nil {
 		panic(fmt.Errorf("could not setup default theme %s", ThemeLight))
 	}
@@ -1090,3 +1191,60 @@ func (t *themeColorPalette) WithLegendBorderColor(color Color) ColorPalette {
 	copy.legendBorderColor = color
 	return &copy
 }
+
+func (t *themeColorPalette) WithCandleWickColor(color Color) ColorPalette {
+	copy := *t
+	copy.name += "-wick_mod"
+	copy.candleWickColor = color
+	return &copy
+}
+
+func (t *themeColorPalette) WithSeriesUpDownColors(colors [][2]Color) ColorPalette {
+	copy := *t
+	if len(colors) == 0 { // ignore invalid input rather than panic later
+		copy.name += "-ignored_invalid_updown_mod"
+		return &copy
+	}
+	copy.name += "-updown_mod"
+	copy.seriesUpDownColors = colors
+	return &copy
+}
+
+func (t *themeColorPalette) Invert() ColorPalette {
+	copy := *t
+	copy.name += "-inverted"
+	copy.backgroundColor = invertLightness(t.backgroundColor)
+	copy.isDarkMode = !t.isDarkMode
+	if !t.backgroundColor.IsTransparent() {
+		copy.isDarkMode = !isLightColor(copy.backgroundColor)
+	}
+	copy.xaxisStrokeColor = invertLightness(t.xaxisStrokeColor)
+	copy.yaxisStrokeColor = invertLightness(t.yaxisStrokeColor)
+	copy.axisSplitLineColor = invertLightness(t.axisSplitLineColor)
+	copy.titleTextColor = invertLightness(t.titleTextColor)
+	copy.markTextColor = invertLightness(t.markTextColor)
+	copy.labelTextColor = invertLightness(t.labelTextColor)
+	copy.legendTextColor = invertLightness(t.legendTextColor)
+	copy.xaxisTextColor = invertLightness(t.xaxisTextColor)
+	copy.yaxisTextColor = invertLightness(t.yaxisTextColor)
+	copy.titleBorderColor = invertLightness(t.titleBorderColor)
+	copy.legendBorderColor = invertLightness(t.legendBorderColor)
+	if !t.candleWickColor.IsZero() {
+		copy.candleWickColor = invertLightness(t.candleWickColor)
+	}
+	copy.seriesColors = make([]Color, len(t.seriesColors))
+	for i, c := range t.seriesColors {
+		copy.seriesColors[i] = contrastLightness(c, copy.isDarkMode)
+	}
+	copy.seriesTrendColors = make([]Color, len(t.seriesTrendColors))
+	for i, c := range t.seriesTrendColors {
+		copy.seriesTrendColors[i] = contrastLightness(c, copy.isDarkMode)
+	}
+	copy.seriesUpDownColors = make([][2]Color, len(t.seriesUpDownColors))
+	for i, pair := range t.seriesUpDownColors {
+		copy.seriesUpDownColors[i] = [2]Color{
+			contrastLightness(pair[0], copy.isDarkMode), contrastLightness(pair[1], copy.isDarkMode),
+		}
+	}
+	return &copy
+}
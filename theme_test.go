@@ -11,7 +11,7 @@ import (
 
 var allThemes = []string{ThemeLight, ThemeDark, ThemeVividLight, ThemeVividDark, ThemeAnt, ThemeGrafana,
 	ThemeNatureLight, ThemeNatureDark, ThemeRetro, ThemeOcean, ThemeSlate, ThemeGray,
-	ThemeWinter, ThemeSpring, ThemeSummer, ThemeFall}
+	ThemeWinter, ThemeSpring, ThemeSummer, ThemeFall, ThemeTradingDark, ThemeTradingLight}
 
 func TestInstallGetTheme(t *testing.T) {
 	t.Parallel()
@@ -194,6 +194,38 @@ func TestThemeFall(t *testing.T) {
 	assertTestdataSVG(t, svg)
 }
 
+func TestThemeTradingDark(t *testing.T) {
+	t.Parallel()
+
+	svg := renderTestCandlestickChartWithThemeName(t, ThemeTradingDark)
+	assertTestdataSVG(t, svg)
+}
+
+func TestThemeTradingLight(t *testing.T) {
+	t.Parallel()
+
+	svg := renderTestCandlestickChartWithThemeName(t, ThemeTradingLight)
+	assertTestdataSVG(t, svg)
+}
+
+func renderTestCandlestickChartWithThemeName(t *testing.T, themeName string) []byte {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: ChartOutputSVG,
+		Width:        600,
+		Height:       400,
+	})
+	opt := makeBasicCandlestickChartOption()
+	opt.Theme = GetTheme(themeName)
+
+	err := p.CandlestickChart(opt)
+	require.NoError(t, err)
+	data, err := p.Bytes()
+	require.NoError(t, err)
+	return data
+}
+
 func TestLightThemeSeriesRepeat(t *testing.T) {
 	t.Parallel()
 
@@ -234,6 +266,23 @@ func TestDarkThemeSeriesRepeat(t *testing.T) {
 	assertTestdataSVG(t, svg)
 }
 
+func TestGetSeriesColorCycle(t *testing.T) {
+	t.Parallel()
+
+	colors := []Color{ColorRed, ColorGreen, ColorBlue}
+	cp := GetTheme(ThemeLight).WithSeriesColors(colors)
+	for i, c := range colors {
+		assert.Equal(t, c, cp.GetSeriesColor(i))
+	}
+	// after the palette is exhausted the colors repeat in order with a shade adjustment
+	for i := len(colors); i < len(colors)*3; i++ {
+		expected := adjustSeriesColor(colors[i%len(colors)], i/len(colors), false)
+		assert.Equal(t, expected, cp.GetSeriesColor(i))
+		assert.NotEqual(t, colors[i%len(colors)], cp.GetSeriesColor(i))
+	}
+	assert.Equal(t, cp.GetSeriesColor(4), cp.GetSeriesColor(4)) // stable across calls
+}
+
 func TestWithAxisColor(t *testing.T) {
 	t.Parallel()
 
@@ -416,6 +465,82 @@ func TestWithLegendBorderColor(t *testing.T) {
 	assert.Equal(t, ColorWhite, whiteCP.GetLegendBorderColor())
 }
 
+func TestWithCandleColors(t *testing.T) {
+	t.Parallel()
+
+	base := GetTheme(ThemeLight)
+	up, down := ColorBlue, ColorOrange
+	derived := base.WithSeriesUpDownColors([][2]Color{{up, down}}).WithCandleWickColor(ColorBlack)
+
+	derivedUp, derivedDown := derived.GetSeriesUpDownColors(0)
+	assert.Equal(t, up, derivedUp)
+	assert.Equal(t, down, derivedDown)
+	assert.Equal(t, ColorBlack, derived.GetCandleWickColor())
+	baseUp, baseDown := base.GetSeriesUpDownColors(0)
+	assert.NotEqual(t, up, baseUp)
+	assert.NotEqual(t, down, baseDown)
+	ignoredUp, ignoredDown := base.WithSeriesUpDownColors(nil).GetSeriesUpDownColors(0)
+	assert.Equal(t, baseUp, ignoredUp)
+	assert.Equal(t, baseDown, ignoredDown)
+
+	opt := NewCandlestickOptionWithData([]OHLCData{
+		{Open: 100, High: 110, Low: 95, Close: 105},
+		{Open: 105, High: 108, Low: 98, Close: 100},
+	})
+	opt.Theme = derived
+	p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+	require.NoError(t, p.CandlestickChart(opt))
+	buf, err := p.Bytes()
+	require.NoError(t, err)
+	svg := string(buf)
+	assert.Contains(t, svg, "fill:"+up.String())
+	assert.Contains(t, svg, "fill:"+down.String())
+	assert.Contains(t, svg, "stroke:"+ColorBlack.String())
+	assert.NotContains(t, svg, baseUp.String())
+	// colors not overridden remain from the base theme
+	assert.Contains(t, svg, "fill:"+base.GetBackgroundColor().String())
+}
+
+func TestThemeInvert(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{ThemeLight, ThemeVividLight, ThemeAnt, ThemeTradingLight} {
+		t.Run(name, func(t *testing.T) {
+			light := GetTheme(name)
+			dark := light.Invert()
+
+			_, _, lightBgL := light.GetBackgroundColor().HSL()
+			_, _, darkBgL := dark.GetBackgroundColor().HSL()
+			assert.Greater(t, lightBgL, 0.5)
+			assert.Less(t, darkBgL, 0.5)
+			assert.False(t, light.IsDark())
+			assert.True(t, dark.IsDark())
+			assert.True(t, isLightColor(dark.GetTitleTextColor()))
+
+			for i := 0; i < 4; i++ {
+				lightHue, lightSat, _ := light.GetSeriesColor(i).HSL()
+				darkHue, _, darkL := dark.GetSeriesColor(i).HSL()
+				if lightSat > 0.1 { // hue is not meaningful for near gray colors
+					assert.InDelta(t, lightHue, darkHue, 3, "series %d", i)
+				}
+				assert.GreaterOrEqual(t, darkL, 0.44, "series %d", i)
+			}
+			lightUp, _ := light.GetSeriesUpDownColors(0)
+			darkUp, _ := dark.GetSeriesUpDownColors(0)
+			lightUpHue, _, _ := lightUp.HSL()
+			darkUpHue, _, _ := darkUp.HSL()
+			assert.InDelta(t, lightUpHue, darkUpHue, 3)
+		})
+	}
+
+	t.Run("round_trip", func(t *testing.T) {
+		dark := GetTheme(ThemeDark)
+		light := dark.Invert()
+		assert.False(t, light.IsDark())
+		assert.True(t, light.Invert().IsDark())
+	})
+}
+
 func getThemeSeriesColors(name string) []Color {
 	if value, ok := palettes.Load(name); ok {
 		if cp, ok := value.(*themeColorPalette); ok {
@@ -0,0 +1,304 @@
+package charts
+
+import (
+	"slices"
+	"time"
+)
+
+// timeTickStep describes a candidate interval between time axis ticks.
+type timeTickStep struct {
+	duration time.Duration // used for intervals shorter than a day
+	days     int
+	months   int
+}
+
+var timeTickSteps = [...]timeTickStep{
+	{duration: time.Minute},
+	{duration: 2 * time.Minute},
+	{duration: 5 * time.Minute},
+	{duration: 10 * time.Minute},
+	{duration: 15 * time.Minute},
+	{duration: 30 * time.Minute},
+	{duration: time.Hour},
+	{duration: 2 * time.Hour},
+	{duration: 3 * time.Hour},
+	{duration: 6 * time.Hour},
+	{duration: 12 * time.Hour},
+	{days: 1},
+	{days: 2},
+	{days: 7},
+	{months: 1},
+	{months: 3},
+	{months: 6},
+	{months: 12},
+	{months: 24},
+	{months: 60},
+	{months: 120},
+}
+
+// layout returns the default time layout for labels at this step over the given span.
+func (s timeTickStep) layout(start, end time.Time) string {
+	switch {
+	case s.duration > 0:
+		if sy, sm, sd := start.Date(); sy == end.Year() && sm == end.Month() && sd == end.Day() {
+			return "15:04"
+		}
+		return "Jan 2 15:04"
+	case s.days > 0:
+		return "Jan 2"
+	case s.months < 12:
+		return "Jan 2006"
+	default:
+		return "2006"
+	}
+}
+
+// ticks returns the step aligned times within the start and end range (inclusive).
+func (s timeTickStep) ticks(start, end time.Time) []time.Time {
+	loc := start.Location()
+	midnight := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	var t time.Time
+	next := func(t time.Time) time.Time {
+		switch {
+		case s.duration > 0:
+			return t.Add(s.duration)
+		case s.days > 0:
+			return t.AddDate(0, 0, s.days)
+		default:
+			return t.AddDate(0, s.months, 0)
+		}
+	}
+	switch {
+	case s.duration > 0:
+		t = midnight.Add(start.Sub(midnight).Truncate(s.duration))
+	case s.days > 0:
+		t = midnight
+	default:
+		month := (int(start.Month())-1)/s.months*s.months + 1
+		year := start.Year()
+		if s.months > 12 { // align multi-year steps to the year
+			years := s.months / 12
+			year = year / years * years
+			month = 1
+		}
+		t = time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+	}
+	var result []time.Time
+	for ; !t.After(end); t = next(t) {
+		if !t.Before(start) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// timeRange returns the earliest and latest of the provided times.
+func timeRange(times []time.Time) (time.Time, time.Time) {
+	start, end := times[0], times[0]
+	for _, t := range times[1:] {
+		if t.Before(start) {
+			start = t
+		} else if t.After(end) {
+			end = t
+		}
+	}
+	return start, end
+}
+
+// calculateTimeAxisRange produces a category axis range whose labels are round time ticks, selecting the smallest
+// tick interval where the labels fit the axis size. Data and tick positions are mapped proportionally to time, or
+// evenly by sample when compressGaps is set. When alwaysShowEnds is set the first and last times are also labeled.
+func calculateTimeAxisRange(p *Painter, axisSize int, times []time.Time, layoutCfg string,
+	boundaryGap, compressGaps, alwaysShowEnds bool, labelRotation float64, fontStyle FontStyle) axisRange {
+	start, end := timeRange(times)
+	var ticks []time.Time
+	layout := layoutCfg
+	if !end.After(start) {
+		ticks = []time.Time{start}
+		if layout == "" {
+			layout = time.DateOnly
+		}
+	} else {
+		for i, step := range timeTickSteps {
+			stepLayout := layoutCfg
+			if stepLayout == "" {
+				stepLayout = step.layout(start, end)
+			}
+			stepTicks := step.ticks(start, end)
+			if len(stepTicks) == 0 && i > 0 {
+				break // step exceeds the span, keep the previous fitting ticks
+			}
+			labelW, _ := p.measureTextMaxWidthHeight([]string{end.Format(stepLayout)}, labelRotation, fontStyle)
+			ticks = stepTicks
+			layout = stepLayout
+			if len(stepTicks)*(labelW+labelW/2) <= axisSize {
+				break
+			}
+		}
+		if compressGaps {
+			ticks = snapTimeTicks(times, ticks)
+		}
+		if len(ticks) == 0 {
+			ticks = []time.Time{start}
+		}
+		if alwaysShowEnds {
+			labelW, _ := p.measureTextMaxWidthHeight([]string{end.Format(layout)}, labelRotation, fontStyle)
+			r := axisRange{timeValues: times, timeBoundaryGap: boundaryGap, timeCompressGaps: compressGaps}
+			ticks = r.withEndTicks(ticks, start, end, axisSize, labelW+labelW/2)
+		}
+	}
+
+	labels := make([]string, len(ticks))
+	for i, t := range ticks {
+		labels[i] = t.Format(layout)
+	}
+	textW, textH := p.measureTextMaxWidthHeight(labels, labelRotation, fontStyle)
+	return axisRange{
+		isCategory:       true,
+		labels:           labels,
+		divideCount:      len(times),
+		tickCount:        len(ticks),
+		labelCount:       len(ticks),
+		size:             axisSize,
+		textMaxWidth:     textW,
+		textMaxHeight:    textH,
+		labelRotation:    labelRotation,
+		labelFontStyle:   fontStyle,
+		timeValues:       times,
+		timeTicks:        ticks,
+		timeBoundaryGap:  boundaryGap,
+		timeCompressGaps: compressGaps,
+	}
+}
+
+// withEndTicks returns the ticks bounded by the start and end times, dropping ticks positioned closer than minGap
+// pixels to either end so their labels don't collide with the end labels.
+func (r axisRange) withEndTicks(ticks []time.Time, start, end time.Time, size, minGap int) []time.Time {
+	startPos, endPos := r.timePositionIn(start, start, end, size), r.timePositionIn(end, start, end, size)
+	result := []time.Time{start}
+	for _, tick := range ticks {
+		if pos := r.timePositionIn(tick, start, end, size); pos-startPos >= minGap && endPos-pos >= minGap {
+			result = append(result, tick)
+		}
+	}
+	return append(result, end)
+}
+
+// snapTimeTicks moves each tick to the first sample at or after it, so that ticks falling in a gap between samples
+// (like a weekend) label the next real sample time. Ticks after the last sample are dropped. Times must be ascending.
+func snapTimeTicks(times, ticks []time.Time) []time.Time {
+	var result []time.Time
+	for _, tick := range ticks {
+		i, _ := slices.BinarySearchFunc(times, tick, time.Time.Compare)
+		if i >= len(times) {
+			break
+		} else if len(result) == 0 || !result[len(result)-1].Equal(times[i]) {
+			result = append(result, times[i])
+		}
+	}
+	return result
+}
+
+// extendTimeValues returns the times with count additional values appended after the last, spaced by the median
+// interval between samples. Times must be ascending.
+func extendTimeValues(times []time.Time, count int) []time.Time {
+	if count <= 0 || len(times) < 2 {
+		return times
+	}
+	gaps := make([]time.Duration, len(times)-1)
+	for i := 1; i < len(times); i++ {
+		gaps[i-1] = times[i].Sub(times[i-1])
+	}
+	slices.Sort(gaps)
+	step := gaps[len(gaps)/2]
+	if step <= 0 {
+		return times
+	}
+	result := make([]time.Time, len(times), len(times)+count)
+	copy(result, times)
+	last := times[len(times)-1]
+	for i := 1; i <= count; i++ {
+		result = append(result, last.Add(time.Duration(i)*step))
+	}
+	return result
+}
+
+// validateTimeValues returns an error if the time values can't position every data sample.
+func validateTimeValues(times []time.Time, dataCount int) error {
+	if len(times) < dataCount {
+		return ErrMissingTimeValues
+	}
+	return nil
+}
+
+// isTimeAxis returns true if the range positions data by time values.
+func (r axisRange) isTimeAxis() bool {
+	return len(r.timeValues) > 0
+}
+
+// timePosition returns the pixel offset of the time along an axis of the given size. When the boundary gap is
+// enabled, half a sample slot is inset on each side so wide symbols (like candles) are not clipped.
+func (r axisRange) timePosition(t time.Time, size int) int {
+	start, end := timeRange(r.timeValues)
+	return r.timePositionIn(t, start, end, size)
+}
+
+// timePositionIn returns the pixel offset of the time like timePosition, using the provided earliest and latest time
+// values so callers positioning many times only compute the range once.
+func (r axisRange) timePositionIn(t, start, end time.Time, size int) int {
+	var inset int
+	if r.timeBoundaryGap {
+		inset = size / (2 * len(r.timeValues))
+	}
+	if r.timeCompressGaps {
+		if len(r.timeValues) < 2 {
+			return size / 2
+		}
+		return inset + int(r.timeSampleIndex(t)/float64(len(r.timeValues)-1)*float64(size-2*inset))
+	}
+	span := end.Sub(start)
+	if span <= 0 {
+		return size / 2
+	}
+	return inset + int(float64(t.Sub(start))/float64(span)*float64(size-2*inset))
+}
+
+// timeSampleIndex returns the fractional sample index of the time, interpolating between the surrounding samples and
+// clamped to the sample range. Time values must be ascending.
+func (r axisRange) timeSampleIndex(t time.Time) float64 {
+	i, found := slices.BinarySearchFunc(r.timeValues, t, time.Time.Compare)
+	if found || i == 0 {
+		return float64(i)
+	} else if i >= len(r.timeValues) {
+		return float64(len(r.timeValues) - 1)
+	}
+	prev, next := r.timeValues[i-1], r.timeValues[i]
+	return float64(i-1) + float64(t.Sub(prev))/float64(next.Sub(prev))
+}
+
+// timeDataPositions returns the pixel offset of each time value along an axis of the given size.
+func (r axisRange) timeDataPositions(size int) []int {
+	positions := make([]int, len(r.timeValues))
+	if len(positions) == 0 {
+		return positions
+	}
+	start, end := timeRange(r.timeValues)
+	for i, t := range r.timeValues {
+		positions[i] = r.timePositionIn(t, start, end, size)
+	}
+	return positions
+}
+
+// timeSectionWidth returns the width available to each sample, based on the closest spacing between samples.
+func timeSectionWidth(positions []int, size int) int {
+	width := size
+	if len(positions) > 1 {
+		width = size / len(positions)
+	}
+	for i := 1; i < len(positions); i++ {
+		if gap := positions[i] - positions[i-1]; gap > 0 && gap < width {
+			width = gap
+		}
+	}
+	return max(width, 1)
+}
@@ -0,0 +1,143 @@
+package charts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateTimeAxisRange(t *testing.T) {
+	t.Parallel()
+
+	fontStyle := fillFontStyleDefaults(FontStyle{}, defaultFontSize, ColorBlack)
+	calc := func(times []time.Time, layout string) axisRange {
+		return calculateTimeAxisRange(NewPainter(PainterOptions{}), 600, times, layout, false, false, false, 0, fontStyle)
+	}
+
+	t.Run("multi_day", func(t *testing.T) {
+		start := time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC)
+		var times []time.Time
+		for i := 0; i < 10; i++ {
+			times = append(times, start.AddDate(0, 0, i))
+		}
+		r := calc(times, "")
+		assert.True(t, r.isTimeAxis())
+		assert.Equal(t, []string{"Mar 6", "Mar 8", "Mar 10", "Mar 12"}, r.labels)
+		for _, tick := range r.timeTicks {
+			assert.Equal(t, 0, tick.Hour())
+		}
+	})
+	t.Run("always_show_ends", func(t *testing.T) {
+		start := time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC)
+		var times []time.Time
+		for i := 0; i < 10; i++ {
+			times = append(times, start.AddDate(0, 0, i))
+		}
+		r := calculateTimeAxisRange(NewPainter(PainterOptions{}), 600, times, "", false, false, true, 0, fontStyle)
+		require.GreaterOrEqual(t, len(r.labels), 3)
+		assert.Equal(t, "Mar 4", r.labels[0])
+		assert.Equal(t, "Mar 13", r.labels[len(r.labels)-1])
+		assert.Equal(t, times[0], r.timeTicks[0])
+		assert.Equal(t, times[len(times)-1], r.timeTicks[len(r.timeTicks)-1])
+		assert.Len(t, r.labels, len(r.timeTicks))
+	})
+	t.Run("sub_hour", func(t *testing.T) {
+		start := time.Date(2024, time.March, 4, 9, 2, 0, 0, time.UTC)
+		var times []time.Time
+		for i := 0; i < 40; i++ {
+			times = append(times, start.Add(time.Duration(i)*time.Minute))
+		}
+		r := calc(times, "")
+		require.NotEmpty(t, r.labels)
+		assert.Equal(t, "09:05", r.labels[0])
+		assert.Equal(t, "09:40", r.labels[len(r.labels)-1])
+		assert.Len(t, r.labels, 8)
+	})
+	t.Run("custom_layout", func(t *testing.T) {
+		start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		r := calc([]time.Time{start, start.AddDate(0, 6, 0)}, "Jan 02")
+		assert.Equal(t, "Jan 01", r.labels[0])
+	})
+	t.Run("compress_gaps_labels", func(t *testing.T) {
+		start := time.Date(2024, time.March, 7, 9, 30, 0, 0, time.UTC) // Thursday
+		var times []time.Time
+		for i := 0; i < 12; i++ {
+			if day := start.AddDate(0, 0, i); day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+				times = append(times, day)
+			}
+		}
+		r := calculateTimeAxisRange(NewPainter(PainterOptions{}), 600, times, "", false, true, false, 0, fontStyle)
+		require.NotEmpty(t, r.timeTicks)
+		for _, tick := range r.timeTicks {
+			assert.Contains(t, times, tick) // labels are real sample dates, never a weekend
+		}
+		assert.Len(t, r.labels, len(r.timeTicks))
+	})
+	t.Run("single_time", func(t *testing.T) {
+		start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		r := calc([]time.Time{start}, "")
+		assert.Equal(t, []string{"2024-01-01"}, r.labels)
+		assert.Equal(t, 300, r.timePosition(start, 600))
+	})
+}
+
+func TestTimeDataPositions(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC) // Thursday
+	times := []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 4), start.AddDate(0, 0, 5)}
+
+	t.Run("proportional", func(t *testing.T) {
+		r := axisRange{timeValues: times}
+		assert.Equal(t, []int{0, 100, 400, 500}, r.timeDataPositions(500))
+		assert.Equal(t, 100, timeSectionWidth(r.timeDataPositions(500), 500))
+	})
+	t.Run("boundary_gap", func(t *testing.T) {
+		r := axisRange{timeValues: times, timeBoundaryGap: true}
+		assert.Equal(t, []int{62, 137, 362, 438}, r.timeDataPositions(500))
+	})
+	t.Run("compress_gaps", func(t *testing.T) {
+		r := axisRange{timeValues: times, timeCompressGaps: true}
+		positions := r.timeDataPositions(600)
+		assert.Equal(t, []int{0, 200, 400, 600}, positions)
+		// Friday to Monday is spaced the same as consecutive weekdays
+		assert.Equal(t, positions[1]-positions[0], positions[2]-positions[1])
+		assert.Equal(t, 300, r.timePosition(start.AddDate(0, 0, 2).Add(12*time.Hour), 600)) // Saturday noon
+		assert.Equal(t, 600, r.timePosition(start.AddDate(0, 0, 30), 600))
+
+		r.timeBoundaryGap = true
+		assert.Equal(t, []int{75, 225, 375, 525}, r.timeDataPositions(600))
+	})
+	t.Run("compress_gaps_single", func(t *testing.T) {
+		r := axisRange{timeValues: times[:1], timeCompressGaps: true}
+		assert.Equal(t, []int{300}, r.timeDataPositions(600))
+	})
+	t.Run("missing_values", func(t *testing.T) {
+		require.Error(t, validateTimeValues(times, 5))
+		require.NoError(t, validateTimeValues(times, 4))
+	})
+}
+
+func TestExtendTimeValues(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.March, 7, 0, 0, 0, 0, time.UTC) // Thursday
+	day := func(d int) time.Time { return start.AddDate(0, 0, d) }
+
+	t.Run("median_spacing", func(t *testing.T) {
+		// the weekend gap does not widen the daily spacing
+		times := []time.Time{day(0), day(1), day(4), day(5)}
+		assert.Equal(t, []time.Time{day(0), day(1), day(4), day(5), day(6), day(7)}, extendTimeValues(times, 2))
+		assert.Len(t, times, 4)
+	})
+	t.Run("no_count", func(t *testing.T) {
+		times := []time.Time{day(0), day(1)}
+		assert.Equal(t, times, extendTimeValues(times, 0))
+	})
+	t.Run("single_time", func(t *testing.T) {
+		times := []time.Time{day(0)}
+		assert.Equal(t, times, extendTimeValues(times, 3))
+	})
+}
@@ -25,6 +25,19 @@ type TitleOption struct {
 	BorderWidth float64
 }
 
+// FooterOption configures a note drawn along the bottom of the chart, such as a data source or attribution.
+type FooterOption struct {
+	// Show specifies if the footer should be rendered. Set to *false (via Ptr(false)) to hide the footer.
+	Show *bool
+	// Text is the footer text. Supports '\n' for line breaks.
+	Text string
+	// FontStyle specifies the font, size, and color for the footer text. Defaults to a small font using the theme
+	// label text color.
+	FontStyle FontStyle
+	// Align specifies the horizontal alignment, AlignLeft (default), AlignCenter, or AlignRight.
+	Align string
+}
+
 type titleMeasureOption struct {
 	width  int
 	height int
@@ -156,3 +169,41 @@ func (t *titlePainter) Render() (Box, error) {
 	}
 	return result, nil
 }
+
+// renderFooter draws the footer text aligned to the bottom of the painter, returning the occupied box.
+func renderFooter(p *Painter, opt FooterOption, theme ColorPalette) Box {
+	lines := splitTitleText(opt.Text)
+	if flagIs(false, opt.Show) || len(lines) == 0 {
+		return BoxZero
+	}
+	fontStyle := fillFontStyleDefaults(opt.FontStyle, defaultLabelFontSize, theme.GetLabelTextColor(), p.font)
+	heights := make([]int, len(lines))
+	widths := make([]int, len(lines))
+	var textMaxWidth, textTotalHeight int
+	for i, line := range lines {
+		textBox := p.MeasureText(line, 0, fontStyle)
+		widths[i], heights[i] = textBox.Width(), textBox.Height()
+		textMaxWidth = max(textMaxWidth, widths[i])
+		textTotalHeight += heights[i]
+	}
+
+	alignX := func(width int) int {
+		switch opt.Align {
+		case AlignCenter:
+			return (p.Width() - width) >> 1
+		case AlignRight:
+			return p.Width() - width
+		default:
+			return 0
+		}
+	}
+
+	top := p.Height() - textTotalHeight
+	y := top
+	for i, line := range lines {
+		y += heights[i]
+		p.Text(line, alignX(widths[i]), y, 0, fontStyle)
+	}
+	left := alignX(textMaxWidth)
+	return Box{Top: top, Bottom: p.Height(), Left: left, Right: left + textMaxWidth, IsSet: true}
+}
@@ -1,9 +1,11 @@
 package charts
 
 import (
+	"regexp"
 	"strconv"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -185,3 +187,62 @@ func TestTitleRenderer(t *testing.T) {
 		})
 	}
 }
+
+func TestFooter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("scatter", func(t *testing.T) {
+		opt := makeBasicScatterChartOption()
+		opt.Title = TitleOption{Text: "Measurements", Subtext: "Weekly samples"}
+		opt.Footer = FooterOption{Text: "Source: example data", Align: AlignCenter}
+
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.ScatterChart(opt))
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		assertTestdataSVG(t, buf)
+		svg := string(buf)
+
+		titleY := textY(t, svg, "Measurements")
+		subtextY := textY(t, svg, "Weekly samples")
+		footerY := textY(t, svg, "Source: example data")
+		assert.Less(t, titleY, subtextY)
+		assert.Greater(t, footerY, 370) // within the bottom padding of the chart
+		for _, label := range opt.XAxis.Labels {
+			assert.Less(t, textY(t, svg, label), footerY-10) // axis is raised above the footer
+		}
+	})
+	t.Run("candlestick", func(t *testing.T) {
+		opt := makeMinimalCandlestickChartOption()
+		opt.Legend = LegendOption{SeriesNames: []string{"Price"}, Offset: OffsetStr{Top: PositionBottom}}
+		opt.Footer = FooterOption{Text: "Prices delayed\nSource: example exchange", Align: AlignRight}
+
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.CandlestickChart(opt))
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		assertTestdataSVG(t, buf)
+		svg := string(buf)
+
+		delayedY := textY(t, svg, "Prices delayed")
+		sourceY := textY(t, svg, "Source: example exchange")
+		assert.Less(t, delayedY, sourceY)
+		assert.Less(t, textY(t, svg, "Price"), delayedY) // bottom legend is placed above the footer
+	})
+	t.Run("hidden", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		assert.True(t, renderFooter(p, FooterOption{Text: "note", Show: Ptr(false)}, GetDefaultTheme()).IsZero())
+		assert.True(t, renderFooter(p, FooterOption{Text: " \n "}, GetDefaultTheme()).IsZero())
+	})
+}
+
+// textY returns the y coordinate of the SVG text element with the given content.
+func textY(t *testing.T, svg, text string) int {
+	t.Helper()
+
+	m := regexp.MustCompile(`<text x="-?\d+" y="(-?\d+)"[^>]*>` + regexp.QuoteMeta(text) + `</text>`).FindStringSubmatch(svg)
+	require.NotNil(t, m, text)
+	y, err := strconv.Atoi(m[1])
+	require.NoError(t, err)
+	return y
+}
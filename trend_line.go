@@ -17,10 +17,20 @@ const (
 	SeriesTrendTypeLinear SeriesTrendType = "linear"
 	// SeriesTrendTypeCubic represents a cubic polynomial (degree 3) regression trend line that fits a curved line through the data points.
 	SeriesTrendTypeCubic SeriesTrendType = "cubic"
+	// SeriesTrendTypePolynomial represents a polynomial regression trend line of the SeriesTrendLine Degree (default 2).
+	SeriesTrendTypePolynomial SeriesTrendType = "polynomial"
+	// SeriesTrendTypeExponential represents an exponential regression trend line of the form a*e^(b*x), fit by
+	// least squares over the logarithm of the values. All values must be positive.
+	SeriesTrendTypeExponential SeriesTrendType = "exponential"
 	// SeriesTrendTypeSMA represents a Simple Moving Average trend line that smooths data using a sliding window average.
 	SeriesTrendTypeSMA SeriesTrendType = "sma"
 	// SeriesTrendTypeEMA represents an Exponential Moving Average trend line that gives more weight to recent data points.
 	SeriesTrendTypeEMA SeriesTrendType = "ema"
+	// SeriesTrendTypeWMA represents a linearly Weighted Moving Average trend line, weighting recent data points more
+	// heavily for reduced lag compared to an SMA.
+	SeriesTrendTypeWMA SeriesTrendType = "wma"
+	// SeriesTrendTypeHMA represents a Hull Moving Average trend line, a smoothed WMA based average with minimal lag.
+	SeriesTrendTypeHMA SeriesTrendType = "hma"
 	// SeriesTrendTypeBollingerUpper represents the upper Bollinger Band, the trailing Period moving
 	// average plus 2 standard deviations.
 	// Designed for financial time-series analysis to identify volatility boundaries around price movements.
@@ -47,10 +57,25 @@ type SeriesTrendLine struct {
 	// Type specifies the trend line type, one of the SeriesTrendType* constants.
 	Type SeriesTrendType
 	// Period specifies the number of data points to consider for trend calculations.
-	// Used by moving averages (SMA, EMA), Bollinger Bands, RSI, and other indicators.
+	// Used by moving averages (SMA, EMA, WMA, HMA), Bollinger Bands, RSI, and other indicators.
 	// For example, Period=20 calculates a 20-period moving average. If unset, or larger than the
 	// number of data points, a default derived from the data size is used.
 	Period int
+	// Degree sets the polynomial degree for SeriesTrendTypePolynomial, defaults to 2 (quadratic). The degree is
+	// reduced when there are not enough data points to fit it.
+	Degree int
+	// ExtendBy extrapolates a linear regression trend line by this many x-axis steps before the first and after the
+	// last data point, for example to visualize a forecast. The extension is limited to the plot area.
+	ExtendBy float64
+	// ClipToData when set to *true restricts the trend line to the x-extent of the data, ignoring any ExtendBy.
+	ClipToData *bool
+	// ShowEquation when set to *true labels the end of the trend line with the fit quality (R²). Linear trend lines
+	// are additionally labeled with the regression equation.
+	ShowEquation *bool
+	// AreaGradient when set fills the region between the trend line and the bottom of the plot with a vertical
+	// gradient, from the High color at the line to the Low color at the baseline. An unset High color uses the line
+	// color, and an unset Low color fades High to transparent. The area follows straight segments between points.
+	AreaGradient *ColorScale
 }
 
 // NewTrendLine returns a trend line for the provided type. Set on a specific Series instance.
@@ -62,6 +87,79 @@ func NewTrendLine(trendType SeriesTrendType) []SeriesTrendLine {
 	}
 }
 
+// TrendFit reports how well a trend line describes the series values.
+type TrendFit struct {
+	// Slope is the slope of the least squares line through the values, per x-axis step. For a
+	// SeriesTrendTypeLinear trend this is the slope of the trend line itself.
+	Slope float64
+	// Intercept is the value of the least squares line at the first index.
+	Intercept float64
+	// RSquared is the coefficient of determination of the trend against the values. A value of 1 indicates the
+	// trend passes through every point, lower values indicate a greater residual spread. Only indices where both the
+	// value and the trend are defined are considered.
+	RSquared float64
+}
+
+// FitTrendLine computes the trend for the values and returns the statistics describing the fit.
+func FitTrendLine(values []float64, trend SeriesTrendLine) (TrendFit, error) {
+	fitted, err := computeTrend(values, trend)
+	if err != nil {
+		return TrendFit{}, err
+	}
+	return trendFitStats(values, fitted)
+}
+
+// trendFitStats computes the fit statistics of the fitted trend against the original values.
+func trendFitStats(values, fitted []float64) (TrendFit, error) {
+	coeffs, err := FitPolynomial(values, 1)
+	if err != nil {
+		return TrendFit{}, err
+	}
+	fit := TrendFit{Intercept: coeffs[0], Slope: coeffs[1]}
+
+	var sum float64
+	var count int
+	for i, v := range values {
+		if isValidExtent(v) && i < len(fitted) && isValidExtent(fitted[i]) {
+			sum += v
+			count++
+		}
+	}
+	if count == 0 {
+		return fit, errors.New("trend is not defined for any value")
+	}
+	mean := sum / float64(count)
+	var ssRes, ssTot float64
+	for i, v := range values {
+		if isValidExtent(v) && i < len(fitted) && isValidExtent(fitted[i]) {
+			ssRes += (v - fitted[i]) * (v - fitted[i])
+			ssTot += (v - mean) * (v - mean)
+		}
+	}
+	if ssTot == 0 {
+		if ssRes == 0 {
+			fit.RSquared = 1 // constant values matched exactly by the trend
+		}
+	} else {
+		fit.RSquared = 1 - ssRes/ssTot
+	}
+	return fit, nil
+}
+
+// trendEquationText returns the label text shown for a trend line with ShowEquation set.
+func trendEquationText(trendType SeriesTrendType, fit TrendFit) string {
+	rSquared := "R² = " + FormatValueHumanize(fit.RSquared, 3, true)
+	if trendType != SeriesTrendTypeLinear {
+		return rSquared
+	}
+	sign := " + "
+	if fit.Intercept < 0 {
+		sign = " - "
+	}
+	return "y = " + FormatValueHumanize(fit.Slope, 2, false) + "x" + sign +
+		FormatValueHumanize(math.Abs(fit.Intercept), 2, false) + ", " + rSquared
+}
+
 // trendLinePainter is responsible for rendering trend lines on the chart.
 type trendLinePainter struct {
 	p       *Painter
@@ -104,26 +202,7 @@ func (t *trendLinePainter) Render() (Box, error) {
 		}
 
 		for _, trend := range opt.trends {
-			var fitted []float64
-			var err error
-			switch trend.Type {
-			case SeriesTrendTypeLinear:
-				fitted, err = linearTrend(opt.seriesValues)
-			case SeriesTrendTypeCubic:
-				fitted, err = cubicTrend(opt.seriesValues)
-			case SeriesTrendTypeSMA:
-				fitted, err = movingAverageTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeEMA:
-				fitted, err = exponentialMovingAverageTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeBollingerUpper:
-				fitted, err = bollingerUpperTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeBollingerLower:
-				fitted, err = bollingerLowerTrend(opt.seriesValues, trend.Period)
-			case SeriesTrendTypeRSI:
-				fitted, err = rsiTrend(opt.seriesValues, trend.Period)
-			default:
-				err = errors.New("unknown trend type: " + string(trend.Type))
-			}
+			fitted, err := computeTrend(opt.seriesValues, trend)
 			if err != nil {
 				return BoxZero, err
 			} else if len(fitted) != len(opt.xValues) {
@@ -149,6 +228,14 @@ func (t *trendLinePainter) Render() (Box, error) {
 				}
 			}
 
+			if trend.Type == SeriesTrendTypeLinear && trend.ExtendBy > 0 && !flagIs(true, trend.ClipToData) {
+				points = extendLinearTrendPoints(points, fitted, opt.xValues, opt.axisRange, painter.Width(), trend.ExtendBy)
+			}
+
+			if trend.AreaGradient != nil {
+				fillTrendAreaGradient(painter, points, *trend.AreaGradient, color)
+			}
+
 			// Determine if this trend line should be dashed
 			isDashed := opt.dashed // start with chart default
 			if trend.DashedLine != nil {
@@ -156,11 +243,7 @@ func (t *trendLinePainter) Render() (Box, error) {
 			}
 
 			if isDashed {
-				// Calculate dash size based on painter dimensions for better visibility
-				avgDimension := float64(t.p.box.Width()+t.p.box.Height()) / 2
-				dashLength := max(avgDimension*0.02, 4.0) // Minimum 4px, scale with size
-				gapLength := dashLength * 0.8
-				dashArray := []float64{dashLength, gapLength}
+				dashArray := scaledDashArray(t.p)
 				if trend.StrokeSmoothingTension > 0 {
 					painter.SmoothDashedLineStroke(points, trend.StrokeSmoothingTension, color, strokeWidth, dashArray)
 				} else {
@@ -173,11 +256,135 @@ func (t *trendLinePainter) Render() (Box, error) {
 					painter.LineStroke(points, color, strokeWidth)
 				}
 			}
+
+			if flagIs(true, trend.ShowEquation) {
+				t.renderEquation(trend.Type, opt.seriesValues, fitted, points, color)
+			}
 		}
 	}
 	return BoxZero, nil
 }
 
+// fillTrendAreaGradient fills the area beneath each continuous segment of the trend line with the gradient.
+func fillTrendAreaGradient(p *Painter, points []Point, scale ColorScale, lineColor Color) {
+	if scale.High.IsZero() {
+		scale.High = lineColor
+	}
+	if scale.Low.IsZero() {
+		scale.Low = scale.High.WithAlpha(0)
+	}
+	bottom := p.Height()
+	top := bottom
+	for _, pt := range points {
+		if pt.Y != math.MaxInt32 {
+			top = min(top, pt.Y)
+		}
+	}
+	top = max(top, 0)
+	eachPointSegment(points, func(segment []Point) {
+		if len(segment) < 2 {
+			return
+		}
+		area := append(slices.Clone(segment),
+			Point{X: segment[len(segment)-1].X, Y: bottom}, Point{X: segment[0].X, Y: bottom})
+		p.fillAreaVerticalGradient(area, top, bottom, scale)
+	})
+}
+
+// computeTrend returns the trend values for the series values, with null values where the trend is undefined.
+func computeTrend(values []float64, trend SeriesTrendLine) ([]float64, error) {
+	switch trend.Type {
+	case SeriesTrendTypeLinear:
+		return linearTrend(values)
+	case SeriesTrendTypeCubic:
+		return cubicTrend(values)
+	case SeriesTrendTypePolynomial:
+		return polynomialTrend(values, trend.Degree)
+	case SeriesTrendTypeExponential:
+		return exponentialTrend(values)
+	case SeriesTrendTypeSMA:
+		return movingAverageTrend(values, trend.Period)
+	case SeriesTrendTypeEMA:
+		return exponentialMovingAverageTrend(values, trend.Period)
+	case SeriesTrendTypeWMA:
+		return weightedMovingAverageTrend(values, trend.Period)
+	case SeriesTrendTypeHMA:
+		return hullMovingAverageTrend(values, trend.Period)
+	case SeriesTrendTypeBollingerUpper:
+		return bollingerUpperTrend(values, trend.Period)
+	case SeriesTrendTypeBollingerLower:
+		return bollingerLowerTrend(values, trend.Period)
+	case SeriesTrendTypeRSI:
+		return rsiTrend(values, trend.Period)
+	default:
+		return nil, errors.New("unknown trend type: " + string(trend.Type))
+	}
+}
+
+// renderEquation labels the end of the trend line with the fit statistics.
+func (t *trendLinePainter) renderEquation(trendType SeriesTrendType, values, fitted []float64,
+	points []Point, color Color) {
+	last := len(points) - 1
+	for last >= 0 && points[last].Y == math.MaxInt32 {
+		last--
+	}
+	if last < 0 {
+		return
+	}
+	fit, err := trendFitStats(values, fitted)
+	if err != nil {
+		return // not enough values to describe the fit
+	}
+	text := trendEquationText(trendType, fit)
+	fontStyle := fillFontStyleDefaults(FontStyle{}, defaultLabelFontSize, color, t.p.font)
+	textBox := t.p.MeasureText(text, 0, fontStyle)
+	x := min(points[last].X, t.p.Width()) - textBox.Width()
+	y := points[last].Y - 4
+	if y-textBox.Height() < 0 {
+		y = points[last].Y + textBox.Height() + 4
+	}
+	t.p.Text(text, max(0, x), y, 0, fontStyle)
+}
+
+// extendLinearTrendPoints extrapolates the fitted linear trend by extendBy x-axis steps on both ends of the data,
+// limiting the extension to the painter width.
+func extendLinearTrendPoints(points []Point, fitted []float64, xValues []int, axisRange axisRange,
+	width int, extendBy float64) []Point {
+	first := slices.IndexFunc(fitted, isValidExtent)
+	last := len(fitted) - 1
+	for last >= 0 && !isValidExtent(fitted[last]) {
+		last--
+	}
+	if first < 0 || first == last || len(xValues) < 2 {
+		return points // a slope can't be derived from a single point
+	}
+	slope := (fitted[last] - fitted[first]) / float64(last-first)
+	step := float64(xValues[len(xValues)-1]-xValues[0]) / float64(len(xValues)-1)
+	if step <= 0 {
+		return points
+	}
+	// bound the extension so the resulting x coordinates remain within the painter
+	minIndex := max(float64(first)-extendBy, -float64(xValues[0])/step)
+	maxIndex := min(float64(last)+extendBy, float64(width-xValues[0])/step)
+	trendPoint := func(index float64) Point {
+		value := fitted[first] + slope*(index-float64(first))
+		return Point{
+			X: xValues[0] + int(math.Round(step*index)),
+			Y: axisRange.getRestHeight(value),
+		}
+	}
+
+	result := make([]Point, 0, len(points)+2)
+	if minIndex < float64(first) {
+		result = append(result, trendPoint(minIndex))
+	}
+	result = append(result, points[first:last+1]...) // leading and trailing null breaks are replaced by the extension
+	if maxIndex > float64(last) {
+		result = append(result, trendPoint(maxIndex))
+	}
+	return result
+}
+
 // extractNonNullData extracts non-null values and their indices from the input.
 func extractNonNullData(y []float64) ([]float64, []int) {
 	cleanData := make([]float64, 0, len(y))
@@ -310,6 +517,127 @@ func cubicTrend(y []float64) ([]float64, error) {
 	return result, nil
 }
 
+// defaultPolynomialDegree is the degree used for polynomial trends when unset.
+const defaultPolynomialDegree = 2
+
+// polynomialTrend computes a polynomial trend of the given degree over the data, preserving null positions.
+func polynomialTrend(y []float64, degree int) ([]float64, error) {
+	cleanData, cleanIndices := extractNonNullData(y)
+	result := newNullValues(len(y))
+	if len(cleanData) == 0 {
+		return result, nil // All nulls
+	} else if len(cleanData) == 1 {
+		result[cleanIndices[0]] = cleanData[0] // Single point - just preserve it
+		return result, nil
+	}
+
+	if degree <= 0 {
+		degree = defaultPolynomialDegree
+	}
+	coeffs, err := polynomialCoefficients(cleanData, cleanIndices, min(degree, len(cleanData)-1))
+	if err != nil {
+		return computeLinearTrend(result, y, cleanData, cleanIndices) // Fall back to linear
+	}
+	for i, v := range y {
+		if isValidExtent(v) {
+			result[i] = evaluatePolynomial(coeffs, float64(i))
+		}
+	}
+	return result, nil
+}
+
+// polynomialCoefficients returns the least squares polynomial coefficients, in ascending order of power, for the
+// values positioned at the x indices.
+func polynomialCoefficients(values []float64, xIndices []int, degree int) ([]float64, error) {
+	size := degree + 1
+	// sums of powers of x, and the right-hand side of the normal equations
+	powerSums := make([]float64, 2*degree+1)
+	rhs := make([]float64, size)
+	for i, v := range values {
+		x := float64(xIndices[i])
+		xp := 1.0
+		for k := range powerSums {
+			powerSums[k] += xp
+			if k < size {
+				rhs[k] += v * xp
+			}
+			xp *= x
+		}
+	}
+
+	mat := make([][]float64, size)
+	for j := range mat {
+		mat[j] = make([]float64, size+1)
+		copy(mat[j], powerSums[j:j+size])
+		mat[j][size] = rhs[j]
+	}
+	return solveLinearSystem(mat)
+}
+
+// evaluatePolynomial returns the polynomial value at x for coefficients in ascending order of power.
+func evaluatePolynomial(coeffs []float64, x float64) float64 {
+	var v float64
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		v = v*x + coeffs[i]
+	}
+	return v
+}
+
+// exponentialTrend computes an exponential trend over the data, preserving null positions.
+func exponentialTrend(y []float64) ([]float64, error) {
+	result := newNullValues(len(y))
+	if slices.IndexFunc(y, isValidExtent) < 0 {
+		return result, nil // All nulls
+	}
+	a, b, err := FitExponential(y)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range y {
+		if isValidExtent(v) {
+			result[i] = a * math.Exp(b*float64(i))
+		}
+	}
+	return result, nil
+}
+
+// FitPolynomial returns the least squares polynomial fit of the values, with the value index as x. The coefficients
+// are returned in ascending order of power, so a degree 1 fit returns the intercept followed by the slope. Null
+// values are skipped, and there must be more non-null values than the degree.
+func FitPolynomial(values []float64, degree int) ([]float64, error) {
+	cleanData, cleanIndices := extractNonNullData(values)
+	if degree < 0 {
+		return nil, errors.New("polynomial degree must not be negative")
+	} else if len(cleanData) <= degree {
+		return nil, errors.New("not enough values for polynomial degree")
+	}
+	return polynomialCoefficients(cleanData, cleanIndices, degree)
+}
+
+// FitExponential returns the a and b coefficients of the exponential fit a*e^(b*x), with the value index as x. The
+// fit is computed by least squares over the logarithm of the values, which must be positive. Null values are skipped.
+func FitExponential(values []float64) (float64, float64, error) {
+	cleanData, cleanIndices := extractNonNullData(values)
+	if len(cleanData) == 0 {
+		return 0, 0, errors.New("no values for exponential fit")
+	}
+	logData := make([]float64, len(cleanData))
+	for i, v := range cleanData {
+		if v <= 0 {
+			return 0, 0, errors.New("exponential fit requires positive values")
+		}
+		logData[i] = math.Log(v)
+	}
+	if len(cleanData) == 1 {
+		return cleanData[0], 0, nil
+	}
+	coeffs, err := polynomialCoefficients(logData, cleanIndices, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	return math.Exp(coeffs[0]), coeffs[1], nil
+}
+
 // exponentialMovingAverageTrend computes an exponential moving average over the data, preserving null positions.
 // If window is <= 0, a default based on the data size is used.
 func exponentialMovingAverageTrend(y []float64, window int) ([]float64, error) {
@@ -352,8 +680,8 @@ func exponentialMovingAverageTrend(y []float64, window int) ([]float64, error) {
 	return result, nil
 }
 
-// solveLinearSystem solves a 4x4 linear system represented as an augmented matrix.
-// The input matrix has 4 rows and 5 columns (last column is the constants vector).
+// solveLinearSystem solves an NxN linear system represented as an augmented matrix.
+// The input matrix has N rows and N+1 columns (last column is the constants vector).
 func solveLinearSystem(mat [][]float64) ([]float64, error) {
 	n := len(mat)
 	// Forward elimination
@@ -367,7 +695,7 @@ func solveLinearSystem(mat [][]float64) ([]float64, error) {
 		}
 		mat[i], mat[maxRow] = mat[maxRow], mat[i]
 		if math.Abs(mat[i][i]) < matrix.DefaultEpsilon {
-			return nil, errors.New("singular matrix in cubic regression")
+			return nil, errors.New("singular matrix in regression")
 		}
 		// Eliminate below
 		for j := i + 1; j < n; j++ {
@@ -433,6 +761,145 @@ func movingAverageTrend(y []float64, window int) ([]float64, error) {
 	return result, nil
 }
 
+// SMA computes the simple moving average over a trailing period. Null values are skipped, positions before the window
+// is filled and null inputs are returned as null.
+func SMA(values []float64, period int) []float64 {
+	cleanData, cleanIndices := extractNonNullData(values)
+	result := newNullValues(len(values))
+	if period <= 0 {
+		return result
+	}
+	var sum float64
+	for i, v := range cleanData {
+		sum += v
+		if i >= period {
+			sum -= cleanData[i-period]
+		}
+		if i >= period-1 {
+			result[cleanIndices[i]] = sum / float64(period)
+		}
+	}
+	return result
+}
+
+// EMA computes the exponential moving average over a trailing period, seeded with the SMA of the first period values
+// and weighting each following value by 2/(period+1). Null values are skipped, positions before the window is filled
+// and null inputs are returned as null.
+func EMA(values []float64, period int) []float64 {
+	cleanData, cleanIndices := extractNonNullData(values)
+	result := newNullValues(len(values))
+	if period <= 0 || period > len(cleanData) {
+		return result
+	}
+	multiplier := 2.0 / (float64(period) + 1.0)
+	var ema float64
+	for _, v := range cleanData[:period] {
+		ema += v
+	}
+	ema /= float64(period)
+	result[cleanIndices[period-1]] = ema
+	for i := period; i < len(cleanData); i++ {
+		ema = (cleanData[i] * multiplier) + (ema * (1 - multiplier))
+		result[cleanIndices[i]] = ema
+	}
+	return result
+}
+
+// WMA computes the linearly weighted moving average over a trailing period, weighting the most recent value by
+// period and the oldest by 1. Null values are skipped, positions before the window is filled and null inputs are
+// returned as null.
+func WMA(values []float64, period int) []float64 {
+	cleanData, cleanIndices := extractNonNullData(values)
+	result := newNullValues(len(values))
+	for i, v := range weightedMovingAverage(cleanData, period) {
+		result[cleanIndices[i]] = v
+	}
+	return result
+}
+
+// HMA computes the Hull moving average, WMA(2*WMA(period/2) - WMA(period)) over a sqrt(period) window. Null
+// values are skipped, positions before the windows are filled and null inputs are returned as null. The first
+// value is available after period + round(sqrt(period)) - 1 non-null values.
+func HMA(values []float64, period int) []float64 {
+	cleanData, cleanIndices := extractNonNullData(values)
+	result := newNullValues(len(values))
+	if period <= 0 || period > len(cleanData) {
+		return result
+	}
+
+	full := weightedMovingAverage(cleanData, period)
+	half := weightedMovingAverage(cleanData, max(period/2, 1))
+	start := period - 1
+	diff := make([]float64, 0, len(cleanData)-start)
+	for i := start; i < len(cleanData); i++ {
+		diff = append(diff, 2*half[i]-full[i])
+	}
+	for i, v := range weightedMovingAverage(diff, max(int(math.Round(math.Sqrt(float64(period)))), 1)) {
+		result[cleanIndices[start+i]] = v
+	}
+	return result
+}
+
+// ComputeMACD computes the Moving Average Convergence Divergence of the closes. The MACD line is the fast EMA minus
+// the slow EMA, the signal line is an EMA of the MACD line over the signal period, and the histogram is the MACD line
+// minus the signal line. Null values are skipped, the MACD line is null until the slow EMA is filled and the signal
+// line and histogram are null until slow+signal-1 non-null closes are seen.
+func ComputeMACD(closes []float64, fast, slow, signal int) (macd, signalLine, histogram []float64) {
+	macd = newNullValues(len(closes))
+	histogram = newNullValues(len(closes))
+	if fast <= 0 || slow <= 0 || signal <= 0 {
+		return macd, newNullValues(len(closes)), histogram
+	}
+	fastEMA, slowEMA := EMA(closes, fast), EMA(closes, slow)
+	for i := range closes {
+		if isValidExtent(fastEMA[i]) && isValidExtent(slowEMA[i]) {
+			macd[i] = fastEMA[i] - slowEMA[i]
+		}
+	}
+	signalLine = EMA(macd, signal)
+	for i, v := range signalLine {
+		if isValidExtent(v) {
+			histogram[i] = macd[i] - v
+		}
+	}
+	return macd, signalLine, histogram
+}
+
+// weightedMovingAverage computes a trailing WMA over data without nulls, values are null until the window fills.
+func weightedMovingAverage(data []float64, period int) []float64 {
+	result := newNullValues(len(data))
+	if period <= 0 {
+		return result
+	}
+	divisor := float64(period*(period+1)) / 2
+	for i := period - 1; i < len(data); i++ {
+		var sum float64
+		for j, v := range data[i-period+1 : i+1] {
+			sum += v * float64(j+1)
+		}
+		result[i] = sum / divisor
+	}
+	return result
+}
+
+// weightedMovingAverageTrend computes a weighted moving average over the data, preserving null positions.
+func weightedMovingAverageTrend(y []float64, period int) ([]float64, error) {
+	cleanData, _ := extractNonNullData(y)
+	if len(cleanData) < 2 {
+		return newNullValues(len(y)), nil
+	}
+	return WMA(y, resolveTrendPeriod(period, len(cleanData))), nil
+}
+
+// hullMovingAverageTrend computes a Hull moving average over the data, preserving null positions.
+func hullMovingAverageTrend(y []float64, period int) ([]float64, error) {
+	cleanData, _ := extractNonNullData(y)
+	if len(cleanData) < 2 {
+		return newNullValues(len(y)), nil
+	}
+	return HMA(y, resolveTrendPeriod(period, len(cleanData))), nil
+}
+
 // bollingerBand computes a Bollinger Band over a trailing period window, the moving average offset by
 // multiplier standard deviations. Positions before the window is filled, and null inputs, are null.
 func bollingerBand(y []float64, period int, multiplier float64) ([]float64, error) {
@@ -473,56 +940,54 @@ func bollingerLowerTrend(y []float64, period int) ([]float64, error) {
 
 // rsiTrend computes the Relative Strength Index momentum oscillator, preserving null positions.
 func rsiTrend(y []float64, period int) ([]float64, error) {
-	cleanData, cleanIndices := extractNonNullData(y)
-	result := newNullValues(len(y))
-
+	cleanData, _ := extractNonNullData(y)
 	if len(cleanData) < 2 {
-		return result, nil // Not enough non-null data
+		return newNullValues(len(y)), nil // Not enough non-null data
 	}
-	period = resolveTrendPeriod(period, len(cleanData))
-	if len(cleanData) < period+1 {
-		return result, nil // Insufficient data for RSI
+	return ComputeRSI(y, resolveTrendPeriod(period, len(cleanData))), nil
+}
+
+// ComputeRSI computes Wilder's Relative Strength Index (0-100) of the closes over the period. The first average gain
+// and loss are the means of the first period changes, after which each is smoothed as (prev*(period-1)+change)/period.
+// Null values are skipped, the first period values (the warm-up region) and null inputs are returned as null.
+func ComputeRSI(closes []float64, period int) []float64 {
+	cleanData, cleanIndices := extractNonNullData(closes)
+	result := newNullValues(len(closes))
+	if period <= 0 || len(cleanData) < period+1 {
+		return result // Insufficient data for RSI
 	}
 
-	// Calculate price changes between consecutive non-null values
-	gains := make([]float64, len(cleanData)-1)
-	losses := make([]float64, len(cleanData)-1)
+	var avgGain, avgLoss float64
 	for i := 1; i < len(cleanData); i++ {
-		change := cleanData[i] - cleanData[i-1]
-		if change > 0 {
-			gains[i-1] = change
-			losses[i-1] = 0
+		var gain, loss float64
+		if change := cleanData[i] - cleanData[i-1]; change > 0 {
+			gain = change
 		} else {
-			gains[i-1] = 0
-			losses[i-1] = -change
+			loss = -change
 		}
-	}
-
-	// Calculate initial averages
-	var avgGain, avgLoss float64
-	for i := 0; i < period && i < len(gains); i++ {
-		avgGain += gains[i]
-		avgLoss += losses[i]
-	}
-	avgGain /= float64(period)
-	avgLoss /= float64(period)
-
-	// Calculate RSI for non-null positions
-	for i := period; i < len(cleanData); i++ {
-		idx := cleanIndices[i]
-		if avgLoss == 0 {
-			result[idx] = 100
+		if i <= period {
+			avgGain += gain / float64(period)
+			avgLoss += loss / float64(period)
+			if i < period {
+				continue
+			}
 		} else {
-			rs := avgGain / avgLoss
-			result[idx] = 100 - (100 / (1 + rs))
+			avgGain = (avgGain*float64(period-1) + gain) / float64(period)
+			avgLoss = (avgLoss*float64(period-1) + loss) / float64(period)
 		}
-
-		// Update averages for next iteration
-		if i < len(gains) {
-			avgGain = ((avgGain * float64(period-1)) + gains[i]) / float64(period)
-			avgLoss = ((avgLoss * float64(period-1)) + losses[i]) / float64(period)
+		if avgLoss == 0 {
+			result[cleanIndices[i]] = 100
+		} else {
+			result[cleanIndices[i]] = 100 - (100 / (1 + avgGain/avgLoss))
 		}
 	}
+	return result
+}
 
-	return result, nil
+// scaledDashArray returns a dash pattern sized relative to the painter dimensions for better visibility.
+func scaledDashArray(p *Painter) []float64 {
+	avgDimension := float64(p.box.Width()+p.box.Height()) / 2
+	dashLength := max(avgDimension*0.02, 4.0) // Minimum 4px, scale with size
+	gapLength := dashLength * 0.8
+	return []float64{dashLength, gapLength}
 }
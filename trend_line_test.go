@@ -3,6 +3,7 @@ package charts
 import (
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -198,6 +199,200 @@ func TestTrendLine(t *testing.T) {
 				return p.Bytes()
 			},
 		},
+		{
+			name: "linear_extend_by",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 10.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trend := SeriesTrendLine{
+					Type:     SeriesTrendTypeLinear,
+					ExtendBy: 1,
+				}
+				nv := GetNullValue()
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlack,
+					xValues:            xValues,
+					seriesValues:       []float64{nv, 2, 3, 4, 5, nv},
+					axisRange:          axisRange,
+					trends:             []SeriesTrendLine{trend},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
+		{
+			name: "linear_clip_to_data",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 10.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trend := SeriesTrendLine{
+					Type:       SeriesTrendTypeLinear,
+					ExtendBy:   1,
+					ClipToData: Ptr(true),
+				}
+				nv := GetNullValue()
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlack,
+					xValues:            xValues,
+					seriesValues:       []float64{nv, 2, 3, 4, 5, nv},
+					axisRange:          axisRange,
+					trends:             []SeriesTrendLine{trend},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
+
+		{
+			name: "wma",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 6.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trend := SeriesTrendLine{
+					Type:   SeriesTrendTypeWMA,
+					Period: 3,
+				}
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlack,
+					xValues:            xValues,
+					seriesValues:       []float64{1, 2, 3, 4, 5, 6},
+					axisRange:          axisRange,
+					trends:             []SeriesTrendLine{trend},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
+		{
+			name: "hma",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 6.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trend := SeriesTrendLine{
+					Type:   SeriesTrendTypeHMA,
+					Period: 3,
+				}
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlack,
+					xValues:            xValues,
+					seriesValues:       []float64{1, 2, 3, 4, 5, 6},
+					axisRange:          axisRange,
+					trends:             []SeriesTrendLine{trend},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
+		{
+			name: "polynomial",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 10.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trend := SeriesTrendLine{
+					Type:                   SeriesTrendTypePolynomial,
+					StrokeSmoothingTension: 0.5,
+				}
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlack,
+					xValues:            xValues,
+					seriesValues:       []float64{8, 4, 2, 1.5, 3, 7},
+					axisRange:          axisRange,
+					trends:             []SeriesTrendLine{trend},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
+		{
+			name: "exponential",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 40.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trend := SeriesTrendLine{
+					Type:                   SeriesTrendTypeExponential,
+					StrokeSmoothingTension: 0.5,
+				}
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlack,
+					xValues:            xValues,
+					seriesValues:       []float64{1, 2.2, 3.9, 8.5, 15, 33},
+					axisRange:          axisRange,
+					trends:             []SeriesTrendLine{trend},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
+		{
+			name: "show_equation",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 10.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlue,
+					xValues:            xValues,
+					seriesValues:       []float64{1, 3, 2, 5, 4, 7},
+					axisRange:          axisRange,
+					trends: []SeriesTrendLine{
+						{Type: SeriesTrendTypeLinear, ShowEquation: Ptr(true)},
+						{Type: SeriesTrendTypeSMA, Period: 2, LineColor: ColorRed, ShowEquation: Ptr(true)},
+					},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
+		{
+			name: "area_gradient",
+			render: func(p *Painter) ([]byte, error) {
+				trendLine := newTrendLinePainter(p)
+				axisRange := newTestRange(p.Height(), 6, 0.0, 10.0, 0.0, 0.0)
+				xValues := []int{50, 150, 250, 350, 450, 550}
+				trendLine.add(trendLineRenderOption{
+					defaultStrokeColor: ColorBlue,
+					xValues:            xValues,
+					seriesValues:       []float64{1, 3, 2, 5, 4, 7},
+					axisRange:          axisRange,
+					trends: []SeriesTrendLine{
+						{Type: SeriesTrendTypeLinear, AreaGradient: &ColorScale{}},
+						{
+							Type:   SeriesTrendTypeSMA,
+							Period: 2,
+							AreaGradient: &ColorScale{
+								Low:           ColorRed.WithAlpha(40),
+								High:          ColorGreen.WithAlpha(160),
+								Interpolation: ColorInterpolationHSL,
+							},
+						},
+					},
+				})
+				if _, err := trendLine.Render(); err != nil {
+					return nil, err
+				}
+				return p.Bytes()
+			},
+		},
 	}
 
 	for i, tt := range tests {
@@ -214,6 +409,31 @@ func TestTrendLine(t *testing.T) {
 	}
 }
 
+func TestTrendLineAreaGradient(t *testing.T) {
+	t.Parallel()
+
+	opt := makeBasicScatterChartOption()
+	opt.SeriesList[0].TrendLine = []SeriesTrendLine{{Type: SeriesTrendTypeLinear, AreaGradient: &ColorScale{}}}
+
+	t.Run("svg", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputSVG, Width: 600, Height: 400})
+		require.NoError(t, p.ScatterChart(opt))
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		svg := string(buf)
+
+		assert.Equal(t, 1, strings.Count(svg, `<linearGradient id="gradient-1" gradientUnits="userSpaceOnUse"`))
+		assert.Contains(t, svg, `stop-opacity="0"/></linearGradient></defs>`)
+		assert.Equal(t, 1, strings.Count(svg, `style="stroke:none;fill:url(#gradient-1)"/>`))
+	})
+	t.Run("png", func(t *testing.T) {
+		p := NewPainter(PainterOptions{OutputFormat: ChartOutputPNG, Width: 600, Height: 400})
+		require.NoError(t, p.ScatterChart(opt))
+		_, err := p.Bytes()
+		require.NoError(t, err)
+	})
+}
+
 func TestTrendLine_WithNullValues(t *testing.T) {
 	t.Parallel()
 
@@ -453,6 +673,142 @@ func TestCubicTrend(t *testing.T) {
 	}
 }
 
+func TestPolynomialTrend(t *testing.T) {
+	t.Parallel()
+
+	// y = x^2 - 3x + 2, with a null gap
+	input := []float64{2, 0, GetNullValue(), 2, 6, 12}
+	result, err := polynomialTrend(input, 0)
+	require.NoError(t, err)
+	require.Len(t, result, len(input))
+	for i, v := range input {
+		if isValidExtent(v) {
+			assert.InDelta(t, v, result[i], 1e-9)
+		} else {
+			assert.False(t, isValidExtent(result[i]))
+		}
+	}
+
+	t.Run("degree_reduced", func(t *testing.T) {
+		result, err := polynomialTrend([]float64{1, 3}, 4)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{1, 3}, result, 1e-9)
+	})
+}
+
+func TestFitPolynomial(t *testing.T) {
+	t.Parallel()
+
+	t.Run("linear", func(t *testing.T) {
+		coeffs, err := FitPolynomial([]float64{1, 3, 5, 7, 9}, 1)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{1, 2}, coeffs, 1e-9)
+	})
+	t.Run("quartic", func(t *testing.T) {
+		values := make([]float64, 8)
+		for i := range values {
+			x := float64(i)
+			values[i] = 0.5*x*x*x*x - 2*x*x + 3
+		}
+		coeffs, err := FitPolynomial(values, 4)
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, []float64{3, 0, -2, 0, 0.5}, coeffs, 1e-6)
+	})
+	t.Run("not_enough_values", func(t *testing.T) {
+		_, err := FitPolynomial([]float64{1, 2, GetNullValue()}, 2)
+		require.Error(t, err)
+	})
+	t.Run("negative_degree", func(t *testing.T) {
+		_, err := FitPolynomial([]float64{1, 2}, -1)
+		require.Error(t, err)
+	})
+}
+
+func TestFitExponential(t *testing.T) {
+	t.Parallel()
+
+	t.Run("exact", func(t *testing.T) {
+		values := make([]float64, 6)
+		for i := range values {
+			values[i] = 3 * math.Exp(0.5*float64(i))
+		}
+		values[2] = GetNullValue()
+		a, b, err := FitExponential(values)
+		require.NoError(t, err)
+		assert.InDelta(t, 3, a, 1e-9)
+		assert.InDelta(t, 0.5, b, 1e-9)
+
+		fitted, err := exponentialTrend(values)
+		require.NoError(t, err)
+		assert.InDelta(t, values[5], fitted[5], 1e-9)
+		assert.False(t, isValidExtent(fitted[2]))
+	})
+	t.Run("non_positive", func(t *testing.T) {
+		_, _, err := FitExponential([]float64{1, 0, 2})
+		require.Error(t, err)
+		_, err = exponentialTrend([]float64{1, -1, 2})
+		require.Error(t, err)
+	})
+	t.Run("all_null", func(t *testing.T) {
+		_, _, err := FitExponential([]float64{GetNullValue()})
+		require.Error(t, err)
+		fitted, err := exponentialTrend([]float64{GetNullValue()})
+		require.NoError(t, err)
+		assert.False(t, isValidExtent(fitted[0]))
+	})
+}
+
+func TestFitTrendLine(t *testing.T) {
+	t.Parallel()
+
+	t.Run("collinear", func(t *testing.T) {
+		fit, err := FitTrendLine([]float64{1, 3, GetNullValue(), 7, 9}, SeriesTrendLine{Type: SeriesTrendTypeLinear})
+		require.NoError(t, err)
+		assert.InDelta(t, 2, fit.Slope, 1e-9)
+		assert.InDelta(t, 1, fit.Intercept, 1e-9)
+		assert.InDelta(t, 1, fit.RSquared, 1e-9)
+	})
+	t.Run("scattered", func(t *testing.T) {
+		values := []float64{1, 6, 2, 8, 3, 9}
+		fit, err := FitTrendLine(values, SeriesTrendLine{Type: SeriesTrendTypeLinear})
+		require.NoError(t, err)
+		assert.Greater(t, fit.RSquared, 0.0)
+		assert.Less(t, fit.RSquared, 0.9)
+
+		cubic, err := FitTrendLine(values, SeriesTrendLine{Type: SeriesTrendTypeCubic})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, cubic.RSquared, fit.RSquared)
+		assert.InDelta(t, fit.Slope, cubic.Slope, 1e-9)
+	})
+	t.Run("moving_average", func(t *testing.T) {
+		fit, err := FitTrendLine([]float64{1, 6, 2, 8, 3, 9}, SeriesTrendLine{Type: SeriesTrendTypeSMA, Period: 3})
+		require.NoError(t, err)
+		assert.Less(t, fit.RSquared, 1.0)
+	})
+	t.Run("constant", func(t *testing.T) {
+		fit, err := FitTrendLine([]float64{4, 4, 4}, SeriesTrendLine{Type: SeriesTrendTypeLinear})
+		require.NoError(t, err)
+		assert.InDelta(t, 0, fit.Slope, 1e-9)
+		assert.InDelta(t, 1, fit.RSquared, 1e-9)
+	})
+	t.Run("single_value", func(t *testing.T) {
+		_, err := FitTrendLine([]float64{4}, SeriesTrendLine{Type: SeriesTrendTypeLinear})
+		require.Error(t, err)
+	})
+	t.Run("unknown_type", func(t *testing.T) {
+		_, err := FitTrendLine([]float64{1, 2}, SeriesTrendLine{Type: "unknown"})
+		require.Error(t, err)
+	})
+}
+
+func TestTrendEquationText(t *testing.T) {
+	t.Parallel()
+
+	fit := TrendFit{Slope: 1.5, Intercept: -2.25, RSquared: 0.9}
+	assert.Equal(t, "y = 1.5x - 2.25, R² = 0.900", trendEquationText(SeriesTrendTypeLinear, fit))
+	assert.Equal(t, "R² = 0.900", trendEquationText(SeriesTrendTypeSMA, fit))
+}
+
 func TestSolveLinearSystem(t *testing.T) {
 	t.Parallel()
 
@@ -574,6 +930,261 @@ func TestExponentialMovingAverageTrend(t *testing.T) {
 	assert.InDelta(t, expected, result[1], 0.001)
 }
 
+func TestSMA(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+
+	t.Run("known_values", func(t *testing.T) {
+		result := SMA([]float64{105, 112, 115, 108, 109}, 3)
+		require.Len(t, result, 5)
+		assert.InDelta(t, nv, result[0], 0) // warm-up
+		assert.InDelta(t, nv, result[1], 0)
+		assert.InDelta(t, 332.0/3, result[2], 1e-9)
+		assert.InDelta(t, 335.0/3, result[3], 1e-9)
+		assert.InDelta(t, 332.0/3, result[4], 1e-9)
+	})
+
+	t.Run("null_values", func(t *testing.T) {
+		result := SMA([]float64{1, nv, 3, 5}, 2)
+		require.Len(t, result, 4)
+		assert.InDelta(t, nv, result[0], 0)
+		assert.InDelta(t, nv, result[1], 0)
+		assert.InDelta(t, 2.0, result[2], 1e-9)
+		assert.InDelta(t, 4.0, result[3], 1e-9)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		for _, v := range SMA([]float64{1, 2, 3}, 0) {
+			assert.InDelta(t, nv, v, 0)
+		}
+	})
+}
+
+func TestEMA(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+
+	t.Run("known_values", func(t *testing.T) {
+		// seeded by SMA(3), then a multiplier of 2/(3+1) = 0.5
+		result := EMA([]float64{105, 112, 115, 108, 109}, 3)
+		require.Len(t, result, 5)
+		assert.InDelta(t, nv, result[0], 0) // warm-up
+		assert.InDelta(t, nv, result[1], 0)
+		assert.InDelta(t, 332.0/3, result[2], 1e-9)
+		assert.InDelta(t, 328.0/3, result[3], 1e-9)
+		assert.InDelta(t, 655.0/6, result[4], 1e-9)
+	})
+
+	t.Run("null_values", func(t *testing.T) {
+		result := EMA([]float64{2, nv, 4, 6}, 2)
+		require.Len(t, result, 4)
+		assert.InDelta(t, nv, result[1], 0)
+		assert.InDelta(t, 3.0, result[2], 1e-9)
+		assert.InDelta(t, 5.0, result[3], 1e-9)
+	})
+
+	t.Run("period_exceeds_data", func(t *testing.T) {
+		for _, v := range EMA([]float64{1, 2}, 3) {
+			assert.InDelta(t, nv, v, 0)
+		}
+	})
+}
+
+func TestWMA(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+
+	t.Run("known_values", func(t *testing.T) {
+		// weights 1,2,3 over a divisor of 6
+		result := WMA([]float64{1, 2, 3, 4, 5}, 3)
+		require.Len(t, result, 5)
+		assert.InDelta(t, nv, result[0], 0) // warm-up
+		assert.InDelta(t, nv, result[1], 0)
+		assert.InDelta(t, 14.0/6, result[2], 1e-9)
+		assert.InDelta(t, 20.0/6, result[3], 1e-9)
+		assert.InDelta(t, 26.0/6, result[4], 1e-9)
+	})
+
+	t.Run("null_values", func(t *testing.T) {
+		result := WMA([]float64{1, nv, 2, 3}, 2)
+		require.Len(t, result, 4)
+		assert.InDelta(t, nv, result[0], 0)
+		assert.InDelta(t, nv, result[1], 0)
+		assert.InDelta(t, 5.0/3, result[2], 1e-9)
+		assert.InDelta(t, 8.0/3, result[3], 1e-9)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		for _, v := range WMA([]float64{1, 2, 3}, 0) {
+			assert.InDelta(t, nv, v, 0)
+		}
+		for _, v := range WMA([]float64{1, 2, 3}, 4) {
+			assert.InDelta(t, nv, v, 0)
+		}
+	})
+}
+
+func TestHMA(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+
+	t.Run("known_values", func(t *testing.T) {
+		// period 4: WMA(2) and WMA(4), smoothed by WMA(2)
+		result := HMA([]float64{1, 2, 4, 8, 16, 32}, 4)
+		require.Len(t, result, 6)
+		for i := 0; i < 4; i++ { // warm-up of period + round(sqrt(period)) - 2
+			assert.InDelta(t, nv, result[i], 0)
+		}
+		diff3 := 2*(20.0/3) - 4.9
+		diff4 := 2*(40.0/3) - 9.8
+		diff5 := 2*(80.0/3) - 19.6
+		assert.InDelta(t, (diff3+2*diff4)/3, result[4], 1e-9)
+		assert.InDelta(t, (diff4+2*diff5)/3, result[5], 1e-9)
+	})
+
+	t.Run("null_values", func(t *testing.T) {
+		withNulls := HMA([]float64{1, 2, nv, 4, 8, 16, 32}, 4)
+		without := HMA([]float64{1, 2, 4, 8, 16, 32}, 4)
+		require.Len(t, withNulls, 7)
+		assert.InDelta(t, nv, withNulls[2], 0)
+		assert.InDelta(t, without[4], withNulls[5], 1e-9)
+		assert.InDelta(t, without[5], withNulls[6], 1e-9)
+	})
+
+	t.Run("insufficient_data", func(t *testing.T) {
+		for _, v := range HMA([]float64{1, 2, 3}, 4) {
+			assert.InDelta(t, nv, v, 0)
+		}
+	})
+}
+
+func TestComputeRSI(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+
+	t.Run("canonical", func(t *testing.T) {
+		// Wilder's RSI(14) example closes as published by StockCharts
+		closes := []float64{
+			44.3389, 44.0902, 44.1497, 43.6124, 44.3278, 44.8264, 45.0955, 45.4245, 45.8433, 46.0826, 45.8931,
+			46.0328, 45.6140, 46.2820, 46.2820, 46.0028, 46.0328, 46.4116, 46.2222, 45.6439, 46.2122, 46.2521,
+			45.7137, 46.4515, 45.7835, 45.3548, 44.0288, 44.1783, 44.2181, 44.5672, 43.4205, 42.6628, 43.1314,
+		}
+		expected := []float64{
+			70.53, 66.32, 66.55, 69.41, 66.36, 57.97, 62.93, 63.26, 56.06, 62.38, 54.71, 50.42, 39.99, 41.46,
+			41.87, 45.46, 37.30, 33.08, 37.77,
+		}
+		result := ComputeRSI(closes, 14)
+		require.Len(t, result, len(closes))
+		for i := 0; i < 14; i++ {
+			assert.InDelta(t, nv, result[i], 0)
+		}
+		for i, v := range expected {
+			assert.InDelta(t, v, result[i+14], 0.01, "index %d", i+14)
+		}
+	})
+
+	t.Run("no_losses", func(t *testing.T) {
+		result := ComputeRSI([]float64{1, 2, 3, 4}, 2)
+		assert.InDelta(t, 100, result[2], 0)
+		assert.InDelta(t, 100, result[3], 0)
+	})
+
+	t.Run("null_values", func(t *testing.T) {
+		withNulls := ComputeRSI([]float64{1, 3, nv, 2, 5, 4}, 2)
+		without := ComputeRSI([]float64{1, 3, 2, 5, 4}, 2)
+		require.Len(t, withNulls, 6)
+		assert.InDelta(t, nv, withNulls[2], 0)
+		assert.InDelta(t, without[2], withNulls[3], 1e-9)
+		assert.InDelta(t, without[4], withNulls[5], 1e-9)
+	})
+
+	t.Run("insufficient_data", func(t *testing.T) {
+		for _, v := range ComputeRSI([]float64{1, 2, 3}, 3) {
+			assert.InDelta(t, nv, v, 0)
+		}
+		for _, v := range ComputeRSI([]float64{1, 2, 3}, 0) {
+			assert.InDelta(t, nv, v, 0)
+		}
+	})
+}
+
+func TestComputeMACD(t *testing.T) {
+	t.Parallel()
+
+	nv := GetNullValue()
+
+	t.Run("known_values", func(t *testing.T) {
+		closes := []float64{
+			44.3389, 44.0902, 44.1497, 43.6124, 44.3278, 44.8264, 45.0955, 45.4245, 45.8433, 46.0826,
+			45.8931, 46.0328, 45.6140, 46.2820, 46.2820, 46.0028, 46.0328, 46.4116, 46.2222, 45.6439,
+		}
+		macd, signal, histogram := ComputeMACD(closes, 3, 6, 4)
+		require.Len(t, macd, len(closes))
+		require.Len(t, signal, len(closes))
+		require.Len(t, histogram, len(closes))
+
+		expectedMACD := []float64{
+			0.2466, 0.3100, 0.3589, 0.4148, 0.4268, 0.3295, 0.2776, 0.1297, 0.2015, 0.1983, 0.1090, 0.0680,
+			0.1248, 0.0867, -0.0632,
+		}
+		expectedSignal := []float64{
+			0.3326, 0.3702, 0.3539, 0.3234, 0.2459, 0.2281, 0.2162, 0.1733, 0.1312, 0.1286, 0.1119, 0.0418,
+		}
+		expectedHistogram := []float64{
+			0.0822, 0.0565, -0.0245, -0.0458, -0.1162, -0.0267, -0.0179, -0.0643, -0.0632, -0.0038, -0.0252, -0.1051,
+		}
+		for i := 0; i < 5; i++ { // slow EMA warm-up
+			assert.InDelta(t, nv, macd[i], 0)
+		}
+		for i := 0; i < 8; i++ { // slow + signal - 1 warm-up
+			assert.InDelta(t, nv, signal[i], 0)
+			assert.InDelta(t, nv, histogram[i], 0)
+		}
+		for i, v := range expectedMACD {
+			assert.InDelta(t, v, macd[i+5], 1e-4, "macd index %d", i+5)
+		}
+		for i, v := range expectedSignal {
+			assert.InDelta(t, v, signal[i+8], 1e-4, "signal index %d", i+8)
+			assert.InDelta(t, expectedHistogram[i], histogram[i+8], 1e-4, "histogram index %d", i+8)
+		}
+	})
+
+	t.Run("linear_ramp", func(t *testing.T) {
+		// each EMA lags a linear ramp by (period-1)/2, so the MACD is constant at (slow-fast)/2
+		macd, signal, histogram := ComputeMACD([]float64{1, 2, 3, 4, 5, 6, 7, 8}, 2, 4, 2)
+		for i := 3; i < 8; i++ {
+			assert.InDelta(t, 1, macd[i], 1e-9)
+		}
+		for i := 4; i < 8; i++ {
+			assert.InDelta(t, 1, signal[i], 1e-9)
+			assert.InDelta(t, 0, histogram[i], 1e-9)
+		}
+	})
+
+	t.Run("null_values", func(t *testing.T) {
+		withNulls, _, withNullsHist := ComputeMACD([]float64{1, 3, nv, 2, 5, 4, 6}, 2, 3, 2)
+		without, _, withoutHist := ComputeMACD([]float64{1, 3, 2, 5, 4, 6}, 2, 3, 2)
+		assert.InDelta(t, nv, withNulls[2], 0)
+		assert.InDelta(t, nv, withNullsHist[2], 0)
+		assert.InDelta(t, without[5], withNulls[6], 1e-9)
+		assert.InDelta(t, withoutHist[5], withNullsHist[6], 1e-9)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		macd, signal, histogram := ComputeMACD([]float64{1, 2, 3, 4}, 0, 3, 2)
+		for i := range macd {
+			assert.InDelta(t, nv, macd[i], 0)
+			assert.InDelta(t, nv, signal[i], 0)
+			assert.InDelta(t, nv, histogram[i], 0)
+		}
+	})
+}
+
 func TestLinearTrendWithNulls(t *testing.T) {
 	t.Parallel()
 
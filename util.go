@@ -150,6 +150,52 @@ func FormatValueHumanizeShort(value float64, decimals int, ensureTrailingZeros b
 	}
 }
 
+// siPrefixes are the SI unit prefixes used by FormatValueSI, ordered from largest to smallest scale.
+var siPrefixes = []struct {
+	scale  float64
+	prefix string
+}{
+	{tValue, "T"},
+	{gValue, "G"},
+	{mValue, "M"},
+	{kValue, "k"},
+	{1, ""},
+	{1e-3, "m"},
+	{1e-6, "µ"},
+	{1e-9, "n"},
+}
+
+// FormatValueSI formats a value with the specified precision using SI unit prefixes, for example 4.5M for
+// 4,500,000 or 12m for 0.012. Unlike FormatValueHumanizeShort, values under 1 are also scaled.
+func FormatValueSI(value float64, decimals int) string {
+	if value < 0 {
+		return "-" + FormatValueSI(-value, decimals)
+	} else if value == 0 {
+		return "0"
+	}
+	decimals = max(decimals, 0)
+	multiplier := math.Pow(10, float64(decimals))
+	for i, si := range siPrefixes {
+		if value < si.scale && i < len(siPrefixes)-1 {
+			continue
+		}
+		scaled := math.Round(value/si.scale*multiplier) / multiplier
+		if scaled >= kValue && i > 0 { // rounding reached the next prefix, for example 999.96 to 1k
+			si = siPrefixes[i-1]
+			scaled = math.Round(value/si.scale*multiplier) / multiplier
+		} else if scaled == 0 {
+			return "0"
+		}
+		return FormatValueHumanize(scaled, decimals, false) + si.prefix
+	}
+	return "0" // unreachable, the smallest prefix always matches
+}
+
+// FormatValuePercent formats a ratio as a percentage with the specified precision, for example 0.123 as 12.3%.
+func FormatValuePercent(value float64, decimals int) string {
+	return FormatValueHumanize(value*100, decimals, false) + "%"
+}
+
 // FormatValueHumanize formats a value with specified precision and comma separators.
 func FormatValueHumanize(value float64, decimals int, ensureTrailingZeros bool) string {
 	if decimals < 0 {
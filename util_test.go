@@ -52,6 +52,49 @@ func TestFormatValueHumanizeShort(t *testing.T) {
 	assert.Equal(t, "-1.2M", FormatValueHumanizeShort(-1200000.121, 1, false))
 }
 
+func TestFormatValueSI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    float64
+		decimals int
+		expected string
+	}{
+		{0, 2, "0"},
+		{1, 2, "1"},
+		{12.345, 1, "12.3"},
+		{999, 0, "999"},
+		{1200, 1, "1.2k"},
+		{4500000, 1, "4.5M"},
+		{7.25e9, 2, "7.25G"},
+		{3e12, 0, "3T"},
+		{2.5e15, 0, "2,500T"},
+		{999.96, 1, "1k"},
+		{0.5, 0, "500m"},
+		{0.012, 2, "12m"},
+		{0.0000042, 1, "4.2µ"},
+		{3.3e-9, 1, "3.3n"},
+		{1e-12, 3, "0.001n"},
+		{1e-15, 2, "0"},
+		{-1200, 1, "-1.2k"},
+		{-0.0025, 1, "-2.5m"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, FormatValueSI(tt.value, tt.decimals), "%v", tt.value)
+	}
+}
+
+func TestFormatValuePercent(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "12.3%", FormatValuePercent(0.123, 1))
+	assert.Equal(t, "12%", FormatValuePercent(0.123, 0))
+	assert.Equal(t, "0%", FormatValuePercent(0, 2))
+	assert.Equal(t, "0.05%", FormatValuePercent(0.0005, 2))
+	assert.Equal(t, "1,250%", FormatValuePercent(12.5, 1))
+	assert.Equal(t, "-4.56%", FormatValuePercent(-0.04561, 2))
+}
+
 func TestFormatValueHumanize(t *testing.T) {
 	t.Parallel()
 
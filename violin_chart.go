@@ -2,6 +2,7 @@ package charts
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"slices"
 	"strconv"
@@ -267,7 +268,7 @@ func (v *violinChart) renderChart(result *defaultRenderResult) (Box, error) {
 	}
 	for i := range opt.SeriesList {
 		if opt.SeriesList[i].YAxisIndex != 0 {
-			return BoxZero, errors.New("violin series specified invalid y-axis index")
+			return BoxZero, fmt.Errorf("violin %w", ErrInvalidYAxisIndex)
 		}
 	}
 	seriesPainter := result.seriesPainter
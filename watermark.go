@@ -0,0 +1,129 @@
+package charts
+
+import (
+	"image"
+
+	"github.com/go-analyze/charts/chartdraw"
+)
+
+const (
+	defaultWatermarkOpacity  = 0.3
+	defaultWatermarkPadding  = 10
+	defaultWatermarkFontSize = 14.0
+)
+
+// WatermarkPosition sets where on the canvas a watermark is drawn.
+type WatermarkPosition int
+
+const (
+	// WatermarkBottomRight draws the watermark in the bottom right corner (default).
+	WatermarkBottomRight WatermarkPosition = iota
+	// WatermarkBottomLeft draws the watermark in the bottom left corner.
+	WatermarkBottomLeft
+	// WatermarkTopRight draws the watermark in the top right corner.
+	WatermarkTopRight
+	// WatermarkTopLeft draws the watermark in the top left corner.
+	WatermarkTopLeft
+	// WatermarkCenter draws the watermark centered over the canvas.
+	WatermarkCenter
+)
+
+// WatermarkOption configures a semi-transparent text or image drawn over the finished chart, for example a logo or
+// copyright notice for branding.
+type WatermarkOption struct {
+	// Text is the watermark text, drawn when Image is not set.
+	Text string
+	// FontStyle sets the text style, defaulting to the theme title text color at size 14.
+	FontStyle FontStyle
+	// Image is the watermark image, taking precedence over Text when set. SVG output embeds the image as a PNG.
+	Image image.Image
+	// ImageWidth and ImageHeight set the drawn image size in pixels. When only one is set the other is scaled to
+	// retain the aspect ratio, when neither is set the image bounds are used.
+	ImageWidth, ImageHeight int
+	// Position sets where the watermark is drawn, defaulting to the bottom right corner.
+	Position WatermarkPosition
+	// Opacity sets the watermark opacity from 0.0 to 1.0 (default 0.3).
+	Opacity float64
+	// Padding is the distance in pixels between the watermark and the canvas edge (default 10).
+	Padding int
+}
+
+// drawWatermark draws the configured watermark once, so it is placed above all the chart content.
+func (p *Painter) drawWatermark() {
+	opt := p.watermark
+	if opt == nil || p.watermarkDrawn {
+		return
+	}
+	p.watermarkDrawn = true
+
+	opacity := opt.Opacity
+	if opacity <= 0 {
+		opacity = defaultWatermarkOpacity
+	} else if opacity > 1 {
+		opacity = 1
+	}
+	padding := opt.Padding
+	if padding <= 0 {
+		padding = defaultWatermarkPadding
+	}
+
+	if opt.Image != nil {
+		ir, ok := p.render.(chartdraw.ImageRenderer)
+		if !ok {
+			return
+		}
+		width, height := watermarkImageSize(opt)
+		if width <= 0 || height <= 0 {
+			return
+		}
+		x, y := p.watermarkOrigin(opt.Position, width, height, padding)
+		ir.DrawImage(opt.Image, p.box.Left+x, p.box.Top+y, width, height, opacity)
+		return
+	} else if opt.Text == "" {
+		return
+	}
+
+	fontStyle := fillFontStyleDefaults(opt.FontStyle, defaultWatermarkFontSize,
+		getPreferredTheme(p.theme).GetTitleTextColor(), p.font)
+	fontStyle.FontColor = fontStyle.FontColor.WithAlpha(uint8(float64(fontStyle.FontColor.A) * opacity))
+	textBox := p.MeasureText(opt.Text, 0, fontStyle)
+	if textBox.Width() == 0 {
+		return
+	}
+	x, y := p.watermarkOrigin(opt.Position, textBox.Width(), textBox.Height(), padding)
+	p.Text(opt.Text, x, y+textBox.Height(), 0, fontStyle)
+}
+
+// watermarkImageSize returns the drawn image dimensions, scaling an unset dimension to retain the aspect ratio.
+func watermarkImageSize(opt *WatermarkOption) (int, int) {
+	bounds := opt.Image.Bounds()
+	width, height := opt.ImageWidth, opt.ImageHeight
+	if bounds.Dx() <= 0 || bounds.Dy() <= 0 {
+		return 0, 0
+	} else if width <= 0 && height <= 0 {
+		return bounds.Dx(), bounds.Dy()
+	} else if width <= 0 {
+		width = max(height*bounds.Dx()/bounds.Dy(), 1)
+	} else if height <= 0 {
+		height = max(width*bounds.Dy()/bounds.Dx(), 1)
+	}
+	return width, height
+}
+
+// watermarkOrigin returns the top left point of a watermark with the given dimensions.
+func (p *Painter) watermarkOrigin(position WatermarkPosition, width, height, padding int) (int, int) {
+	right := p.Width() - width - padding
+	bottom := p.Height() - height - padding
+	switch position {
+	case WatermarkBottomLeft:
+		return padding, bottom
+	case WatermarkTopRight:
+		return right, padding
+	case WatermarkTopLeft:
+		return padding, padding
+	case WatermarkCenter:
+		return (p.Width() - width) / 2, (p.Height() - height) / 2
+	default: // WatermarkBottomRight
+		return right, bottom
+	}
+}
@@ -0,0 +1,153 @@
+package charts
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeWatermarkImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+3] = 255, 255 // opaque red
+	}
+	return img
+}
+
+func renderWatermarkLineChart(t *testing.T, format string, watermark *WatermarkOption) *Painter {
+	t.Helper()
+
+	p := NewPainter(PainterOptions{
+		OutputFormat: format,
+		Width:        600,
+		Height:       400,
+		Watermark:    watermark,
+	})
+	require.NoError(t, p.LineChart(makeMinimalLineChartOption()))
+	return p
+}
+
+func TestWatermark(t *testing.T) {
+	t.Parallel()
+
+	t.Run("text", func(t *testing.T) {
+		p := renderWatermarkLineChart(t, ChartOutputSVG, &WatermarkOption{
+			Text:    "go-analyze",
+			Opacity: 0.5,
+		})
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		assertTestdataSVG(t, buf)
+
+		svg := string(buf)
+		assert.Contains(t, svg, "rgba(70,70,70,0.5)")
+		assert.Greater(t, strings.Index(svg, ">go-analyze</text>"), strings.LastIndex(svg, "<path"),
+			"watermark should be drawn over the chart")
+	})
+	t.Run("text_top_left", func(t *testing.T) {
+		p := renderWatermarkLineChart(t, ChartOutputSVG, &WatermarkOption{
+			Text:      "Confidential",
+			FontStyle: FontStyle{FontSize: 28, FontColor: ColorRed},
+			Position:  WatermarkTopLeft,
+		})
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		assertTestdataSVG(t, buf)
+		assert.Contains(t, string(buf), "rgba(255,0,0,0.3)") // default opacity
+	})
+	t.Run("image_svg", func(t *testing.T) {
+		p := renderWatermarkLineChart(t, ChartOutputSVG, &WatermarkOption{
+			Image:      makeWatermarkImage(40, 20),
+			ImageWidth: 80,
+			Position:   WatermarkCenter,
+			Opacity:    0.4,
+		})
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+
+		match := regexp.MustCompile(`<image x="(\d+)" y="(\d+)" width="80" height="40" preserveAspectRatio="none" opacity="0.4" href="data:image/png;base64,([^"]+)"/>`).
+			FindStringSubmatch(string(buf))
+		require.Len(t, match, 4)
+		assert.Equal(t, "260", match[1])
+		assert.Equal(t, "180", match[2])
+		data, err := base64.StdEncoding.DecodeString(match[3])
+		require.NoError(t, err)
+		img, err := png.Decode(bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 40, 20), img.Bounds())
+	})
+	t.Run("image_png", func(t *testing.T) {
+		p := renderWatermarkLineChart(t, ChartOutputPNG, &WatermarkOption{
+			Image:    makeWatermarkImage(20, 20),
+			Position: WatermarkBottomRight,
+			Opacity:  0.5,
+			Padding:  5,
+		})
+		buf, err := p.Bytes()
+		require.NoError(t, err)
+		img, err := png.Decode(bytes.NewReader(buf))
+		require.NoError(t, err)
+
+		// background is white, blending red at half opacity leaves the red channel full and halves the others
+		r, g, b, _ := img.At(585, 385).RGBA()
+		assert.Equal(t, uint32(0xffff), r)
+		assert.InDelta(t, 0x7fff, g, 0x200)
+		assert.InDelta(t, 0x7fff, b, 0x200)
+		r, g, b, _ = img.At(570, 385).RGBA() // left of the watermark
+		assert.Equal(t, []uint32{0xffff, 0xffff, 0xffff}, []uint32{r, g, b})
+	})
+	t.Run("unset", func(t *testing.T) {
+		expected, err := renderWatermarkLineChart(t, ChartOutputSVG, nil).Bytes()
+		require.NoError(t, err)
+		empty, err := renderWatermarkLineChart(t, ChartOutputSVG, &WatermarkOption{}).Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, expected, empty)
+	})
+	t.Run("reset", func(t *testing.T) {
+		p := renderWatermarkLineChart(t, ChartOutputSVG, &WatermarkOption{Text: "go-analyze"})
+		first, err := p.Bytes()
+		require.NoError(t, err)
+		p.Reset()
+		require.NoError(t, p.LineChart(makeMinimalLineChartOption()))
+		second, err := p.Bytes()
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+		assert.Equal(t, 1, strings.Count(string(second), ">go-analyze</text>"))
+	})
+}
+
+func TestWatermarkImageSize(t *testing.T) {
+	t.Parallel()
+
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	tests := []struct {
+		name           string
+		width, height  int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "image_bounds", expectedWidth: 40, expectedHeight: 20},
+		{name: "width_only", width: 100, expectedWidth: 100, expectedHeight: 50},
+		{name: "height_only", height: 10, expectedWidth: 20, expectedHeight: 10},
+		{name: "both", width: 30, height: 30, expectedWidth: 30, expectedHeight: 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := watermarkImageSize(&WatermarkOption{Image: img, ImageWidth: tt.width, ImageHeight: tt.height})
+			assert.Equal(t, tt.expectedWidth, w)
+			assert.Equal(t, tt.expectedHeight, h)
+		})
+	}
+	t.Run("empty_image", func(t *testing.T) {
+		w, h := watermarkImageSize(&WatermarkOption{Image: image.NewRGBA(image.Rectangle{}), ImageWidth: 10})
+		assert.Zero(t, w)
+		assert.Zero(t, h)
+	})
+}